@@ -5,7 +5,34 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyOne
 
 import (
+	"embed"
+
+	TwentyTwentyOne_day01 "github.com/d1r7y/adventofcode/cmd/2021/day01"
+	TwentyTwentyOne_day02 "github.com/d1r7y/adventofcode/cmd/2021/day02"
+	TwentyTwentyOne_day03 "github.com/d1r7y/adventofcode/cmd/2021/day03"
+	TwentyTwentyOne_day04 "github.com/d1r7y/adventofcode/cmd/2021/day04"
+	TwentyTwentyOne_day05 "github.com/d1r7y/adventofcode/cmd/2021/day05"
+	TwentyTwentyOne_day06 "github.com/d1r7y/adventofcode/cmd/2021/day06"
+	TwentyTwentyOne_day07 "github.com/d1r7y/adventofcode/cmd/2021/day07"
+	TwentyTwentyOne_day08 "github.com/d1r7y/adventofcode/cmd/2021/day08"
+	TwentyTwentyOne_day09 "github.com/d1r7y/adventofcode/cmd/2021/day09"
+	TwentyTwentyOne_day10 "github.com/d1r7y/adventofcode/cmd/2021/day10"
+	TwentyTwentyOne_day11 "github.com/d1r7y/adventofcode/cmd/2021/day11"
+	TwentyTwentyOne_day12 "github.com/d1r7y/adventofcode/cmd/2021/day12"
+	TwentyTwentyOne_day13 "github.com/d1r7y/adventofcode/cmd/2021/day13"
+	TwentyTwentyOne_day14 "github.com/d1r7y/adventofcode/cmd/2021/day14"
 	TwentyTwentyOne_day15 "github.com/d1r7y/adventofcode/cmd/2021/day15"
+	TwentyTwentyOne_day16 "github.com/d1r7y/adventofcode/cmd/2021/day16"
+	TwentyTwentyOne_day17 "github.com/d1r7y/adventofcode/cmd/2021/day17"
+	TwentyTwentyOne_day18 "github.com/d1r7y/adventofcode/cmd/2021/day18"
+	TwentyTwentyOne_day19 "github.com/d1r7y/adventofcode/cmd/2021/day19"
+	TwentyTwentyOne_day20 "github.com/d1r7y/adventofcode/cmd/2021/day20"
+	TwentyTwentyOne_day21 "github.com/d1r7y/adventofcode/cmd/2021/day21"
+	TwentyTwentyOne_day22 "github.com/d1r7y/adventofcode/cmd/2021/day22"
+	TwentyTwentyOne_day23 "github.com/d1r7y/adventofcode/cmd/2021/day23"
+	TwentyTwentyOne_day24 "github.com/d1r7y/adventofcode/cmd/2021/day24"
+	TwentyTwentyOne_day25 "github.com/d1r7y/adventofcode/cmd/2021/day25"
+	"github.com/d1r7y/adventofcode/utilities/metadata"
 	"github.com/spf13/cobra"
 )
 
@@ -16,6 +43,37 @@ var TwentyTwentyOneCmd = &cobra.Command{
 	Long:  ``,
 }
 
+// puzzles holds each day's puzzle.yaml
+//
+//go:embed day*/puzzle.yaml
+var puzzles embed.FS
+
 func init() {
+	metadata.MustRegisterYear(2021, puzzles)
+
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day01.Day01Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day02.Day02Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day03.Day03Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day04.Day04Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day05.Day05Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day06.Day06Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day07.Day07Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day08.Day08Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day09.Day09Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day10.Day10Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day11.Day11Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day12.Day12Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day13.Day13Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day14.Day14Cmd)
 	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day15.Day15Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day16.Day16Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day17.Day17Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day18.Day18Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day19.Day19Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day20.Day20Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day21.Day21Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day22.Day22Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day23.Day23Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day24.Day24Cmd)
+	TwentyTwentyOneCmd.AddCommand(TwentyTwentyOne_day25.Day25Cmd)
 }
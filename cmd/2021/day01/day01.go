@@ -0,0 +1,86 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day01
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day01Cmd represents the day01 command
+var Day01Cmd = &cobra.Command{
+	Use:   "day01",
+	Short: `Sonar Sweep`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func ParseDepths(fileContents string) ([]int, error) {
+	depths := make([]int, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		depth, err := strconv.Atoi(strings.TrimSpace(line))
+		if err != nil {
+			return nil, fmt.Errorf("invalid depth '%s'", line)
+		}
+
+		depths = append(depths, depth)
+	}
+
+	return depths, nil
+}
+
+// CountIncreases counts how often the sum of a sliding window of measurements is
+// larger than the previous window's sum. Consecutive windows share all but one
+// measurement, so only the measurements entering and leaving need comparing.
+func CountIncreases(depths []int, window int) int {
+	increases := 0
+
+	for i := window; i < len(depths); i++ {
+		if depths[i] > depths[i-window] {
+			increases++
+		}
+	}
+
+	return increases
+}
+
+func day(fileContents string) error {
+	depths, err := ParseDepths(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: How many measurements are larger than the previous measurement?
+	utilities.Answer(1, "Measurements larger than the previous measurement", CountIncreases(depths, 1))
+
+	// Part 2: Consider sums of a three-measurement sliding window. How many sums are
+	// larger than the previous sum?
+	utilities.Answer(2, "Sliding window sums larger than the previous sum", CountIncreases(depths, 3))
+
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day01
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleReport = `199
+200
+208
+210
+200
+207
+240
+269
+260
+263`
+
+func TestParseDepths(t *testing.T) {
+	depths, err := ParseDepths(exampleReport)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{199, 200, 208, 210, 200, 207, 240, 269, 260, 263}, depths)
+
+	_, err = ParseDepths("199\nabc")
+	assert.Error(t, err)
+}
+
+func TestCountIncreases(t *testing.T) {
+	type testCase struct {
+		window            int
+		expectedIncreases int
+	}
+
+	testCases := []testCase{
+		{window: 1, expectedIncreases: 7},
+		{window: 3, expectedIncreases: 5},
+	}
+
+	depths, err := ParseDepths(exampleReport)
+	assert.NoError(t, err)
+
+	for _, test := range testCases {
+		assert.Equal(t, test.expectedIncreases, CountIncreases(depths, test.window))
+	}
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
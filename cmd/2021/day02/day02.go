@@ -0,0 +1,152 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day02
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day02Cmd represents the day02 command
+var Day02Cmd = &cobra.Command{
+	Use:   "day02",
+	Short: `Dive!`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type Direction byte
+
+const (
+	Forward Direction = iota
+	Down
+	Up
+)
+
+type Command struct {
+	Direction Direction
+	Units     int
+}
+
+func ParseCommand(line string) (Command, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 2 {
+		return Command{}, fmt.Errorf("invalid command '%s'", line)
+	}
+
+	units, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return Command{}, err
+	}
+
+	switch fields[0] {
+	case "forward":
+		return Command{Direction: Forward, Units: units}, nil
+	case "down":
+		return Command{Direction: Down, Units: units}, nil
+	case "up":
+		return Command{Direction: Up, Units: units}, nil
+	}
+
+	return Command{}, fmt.Errorf("unknown direction '%s'", fields[0])
+}
+
+func ParseCourse(fileContents string) ([]Command, error) {
+	course := make([]Command, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		command, err := ParseCommand(line)
+		if err != nil {
+			return nil, err
+		}
+
+		course = append(course, command)
+	}
+
+	return course, nil
+}
+
+type Submarine struct {
+	Horizontal int
+	Depth      int
+	Aim        int
+}
+
+// Follow moves the submarine through the course. Without aim, down and up change
+// the depth directly; with aim they change the aim and forward dives by it.
+func (s *Submarine) Follow(course []Command, useAim bool) {
+	for _, c := range course {
+		switch c.Direction {
+		case Forward:
+			s.Horizontal += c.Units
+			if useAim {
+				s.Depth += s.Aim * c.Units
+			}
+		case Down:
+			if useAim {
+				s.Aim += c.Units
+			} else {
+				s.Depth += c.Units
+			}
+		case Up:
+			if useAim {
+				s.Aim -= c.Units
+			} else {
+				s.Depth -= c.Units
+			}
+		}
+	}
+}
+
+func (s *Submarine) Product() int {
+	return s.Horizontal * s.Depth
+}
+
+func day(fileContents string) error {
+	course, err := ParseCourse(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Calculate the horizontal position and depth you would have after
+	// following the planned course. What do you get if you multiply your final
+	// horizontal position by your final depth?
+	submarine := &Submarine{}
+	submarine.Follow(course, false)
+
+	utilities.Answer(1, "Product of horizontal position and depth", submarine.Product())
+
+	// Part 2: Using this new interpretation of the commands, calculate the horizontal
+	// position and depth you would have after following the planned course. What do
+	// you get if you multiply your final horizontal position by your final depth?
+	aimedSubmarine := &Submarine{}
+	aimedSubmarine.Follow(course, true)
+
+	utilities.Answer(2, "Product of horizontal position and depth with aim", aimedSubmarine.Product())
+
+	return nil
+}
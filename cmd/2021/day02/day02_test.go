@@ -0,0 +1,63 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day02
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleCourse = `forward 5
+down 5
+forward 8
+up 3
+down 8
+forward 2`
+
+func TestParseCommand(t *testing.T) {
+	type testCase struct {
+		line            string
+		expectedCommand Command
+	}
+
+	testCases := []testCase{
+		{line: "forward 5", expectedCommand: Command{Direction: Forward, Units: 5}},
+		{line: "down 8", expectedCommand: Command{Direction: Down, Units: 8}},
+		{line: "up 3", expectedCommand: Command{Direction: Up, Units: 3}},
+	}
+
+	for _, test := range testCases {
+		command, err := ParseCommand(test.line)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedCommand, command)
+	}
+
+	_, err := ParseCommand("backward 3")
+	assert.Error(t, err)
+
+	_, err = ParseCommand("up")
+	assert.Error(t, err)
+}
+
+func TestFollow(t *testing.T) {
+	course, err := ParseCourse(exampleCourse)
+	assert.NoError(t, err)
+
+	submarine := &Submarine{}
+	submarine.Follow(course, false)
+	assert.Equal(t, Submarine{Horizontal: 15, Depth: 10}, *submarine)
+	assert.Equal(t, 150, submarine.Product())
+
+	aimedSubmarine := &Submarine{}
+	aimedSubmarine.Follow(course, true)
+	assert.Equal(t, Submarine{Horizontal: 15, Depth: 60, Aim: 10}, *aimedSubmarine)
+	assert.Equal(t, 900, aimedSubmarine.Product())
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
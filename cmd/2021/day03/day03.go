@@ -0,0 +1,178 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day03
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day03Cmd represents the day03 command
+var Day03Cmd = &cobra.Command{
+	Use:   "day03",
+	Short: `Binary Diagnostic`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type Report struct {
+	Width   int
+	Numbers []int
+}
+
+func ParseReport(fileContents string) (*Report, error) {
+	report := &Report{}
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		line = strings.TrimSpace(line)
+
+		if report.Width == 0 {
+			report.Width = len(line)
+		} else if len(line) != report.Width {
+			return nil, fmt.Errorf("'%s' is not %d bits wide", line, report.Width)
+		}
+
+		number, err := strconv.ParseInt(line, 2, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Numbers = append(report.Numbers, int(number))
+	}
+
+	return report, nil
+}
+
+// onesAtBit counts how many of the numbers have the bit set.
+func onesAtBit(numbers []int, bit int) int {
+	ones := 0
+
+	for _, n := range numbers {
+		if n&(1<<bit) != 0 {
+			ones++
+		}
+	}
+
+	return ones
+}
+
+func (r *Report) GammaRate() int {
+	gamma := 0
+
+	for bit := 0; bit < r.Width; bit++ {
+		if 2*onesAtBit(r.Numbers, bit) > len(r.Numbers) {
+			gamma |= 1 << bit
+		}
+	}
+
+	return gamma
+}
+
+func (r *Report) EpsilonRate() int {
+	return ^r.GammaRate() & (1<<r.Width - 1)
+}
+
+func (r *Report) PowerConsumption() int {
+	return r.GammaRate() * r.EpsilonRate()
+}
+
+// filterRating keeps narrowing the numbers, from the most significant bit down,
+// to those whose bit matches the bit criteria until only one is left.
+func (r *Report) filterRating(mostCommon bool) (int, error) {
+	candidates := r.Numbers
+
+	for bit := r.Width - 1; bit >= 0 && len(candidates) > 1; bit-- {
+		ones := onesAtBit(candidates, bit)
+		zeros := len(candidates) - ones
+
+		keepOnes := ones >= zeros
+		if !mostCommon {
+			keepOnes = ones < zeros
+		}
+
+		kept := make([]int, 0, len(candidates))
+		for _, n := range candidates {
+			if (n&(1<<bit) != 0) == keepOnes {
+				kept = append(kept, n)
+			}
+		}
+
+		candidates = kept
+	}
+
+	if len(candidates) != 1 {
+		return 0, fmt.Errorf("bit criteria left %d numbers", len(candidates))
+	}
+
+	return candidates[0], nil
+}
+
+func (r *Report) OxygenGeneratorRating() (int, error) {
+	return r.filterRating(true)
+}
+
+func (r *Report) CO2ScrubberRating() (int, error) {
+	return r.filterRating(false)
+}
+
+func (r *Report) LifeSupportRating() (int, error) {
+	oxygen, err := r.OxygenGeneratorRating()
+	if err != nil {
+		return 0, err
+	}
+
+	co2, err := r.CO2ScrubberRating()
+	if err != nil {
+		return 0, err
+	}
+
+	return oxygen * co2, nil
+}
+
+func day(fileContents string) error {
+	report, err := ParseReport(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Use the binary numbers in your diagnostic report to calculate the gamma
+	// rate and epsilon rate, then multiply them together. What is the power consumption
+	// of the submarine?
+	utilities.Answer(1, "Power consumption", report.PowerConsumption())
+
+	// Part 2: Use the binary numbers in your diagnostic report to calculate the oxygen
+	// generator rating and CO2 scrubber rating, then multiply them together. What is
+	// the life support rating of the submarine?
+	lifeSupport, err := report.LifeSupportRating()
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(2, "Life support rating", lifeSupport)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day03
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleReport = `00100
+11110
+10110
+10111
+10101
+01111
+00111
+11100
+10000
+11001
+00010
+01010`
+
+func TestParseReport(t *testing.T) {
+	report, err := ParseReport(exampleReport)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, report.Width)
+	assert.Equal(t, 12, len(report.Numbers))
+	assert.Equal(t, 4, report.Numbers[0])
+
+	_, err = ParseReport("0010\n11110")
+	assert.Error(t, err)
+
+	_, err = ParseReport("00120")
+	assert.Error(t, err)
+}
+
+func TestPowerConsumption(t *testing.T) {
+	report, err := ParseReport(exampleReport)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 22, report.GammaRate())
+	assert.Equal(t, 9, report.EpsilonRate())
+	assert.Equal(t, 198, report.PowerConsumption())
+}
+
+func TestLifeSupportRating(t *testing.T) {
+	report, err := ParseReport(exampleReport)
+	assert.NoError(t, err)
+
+	oxygen, err := report.OxygenGeneratorRating()
+	assert.NoError(t, err)
+	assert.Equal(t, 23, oxygen)
+
+	co2, err := report.CO2ScrubberRating()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, co2)
+
+	lifeSupport, err := report.LifeSupportRating()
+	assert.NoError(t, err)
+	assert.Equal(t, 230, lifeSupport)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
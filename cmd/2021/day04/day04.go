@@ -0,0 +1,163 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day04
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day04Cmd represents the day04 command
+var Day04Cmd = &cobra.Command{
+	Use:   "day04",
+	Short: `Giant Squid`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const BoardSize = 5
+
+type Board struct {
+	Numbers [BoardSize][BoardSize]int
+	Marked  [BoardSize][BoardSize]bool
+	Won     bool
+}
+
+func (b *Board) Mark(number int) {
+	for r := 0; r < BoardSize; r++ {
+		for c := 0; c < BoardSize; c++ {
+			if b.Numbers[r][c] == number {
+				b.Marked[r][c] = true
+			}
+		}
+	}
+}
+
+func (b *Board) HasWon() bool {
+	for i := 0; i < BoardSize; i++ {
+		row, column := true, true
+
+		for j := 0; j < BoardSize; j++ {
+			row = row && b.Marked[i][j]
+			column = column && b.Marked[j][i]
+		}
+
+		if row || column {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *Board) UnmarkedSum() int {
+	sum := 0
+
+	for r := 0; r < BoardSize; r++ {
+		for c := 0; c < BoardSize; c++ {
+			if !b.Marked[r][c] {
+				sum += b.Numbers[r][c]
+			}
+		}
+	}
+
+	return sum
+}
+
+type Bingo struct {
+	Draws  []int
+	Boards []*Board
+}
+
+func ParseBingo(fileContents string) (*Bingo, error) {
+	sections := strings.Split(strings.TrimSpace(fileContents), "\n\n")
+
+	bingo := &Bingo{Draws: utilities.ParseIntList(sections[0])}
+	if len(bingo.Draws) == 0 {
+		return nil, fmt.Errorf("no numbers to draw")
+	}
+
+	for _, section := range sections[1:] {
+		numbers := utilities.ParseIntList(section)
+		if len(numbers) != BoardSize*BoardSize {
+			return nil, fmt.Errorf("board has %d numbers, expected %d", len(numbers), BoardSize*BoardSize)
+		}
+
+		board := &Board{}
+		for i, n := range numbers {
+			board.Numbers[i/BoardSize][i%BoardSize] = n
+		}
+
+		bingo.Boards = append(bingo.Boards, board)
+	}
+
+	return bingo, nil
+}
+
+// Play draws numbers until every board has won and returns the final scores in
+// the order the boards won.
+func (b *Bingo) Play() []int {
+	scores := make([]int, 0, len(b.Boards))
+
+	for _, number := range b.Draws {
+		for _, board := range b.Boards {
+			if board.Won {
+				continue
+			}
+
+			board.Mark(number)
+
+			if board.HasWon() {
+				board.Won = true
+				scores = append(scores, board.UnmarkedSum()*number)
+			}
+		}
+	}
+
+	return scores
+}
+
+func day(fileContents string) error {
+	bingo, err := ParseBingo(fileContents)
+	if err != nil {
+		return err
+	}
+
+	scores := bingo.Play()
+	if len(scores) == 0 {
+		return fmt.Errorf("no board ever wins")
+	}
+
+	// Part 1: To guarantee victory against the giant squid, figure out which board
+	// will win first. What will your final score be if you choose that board?
+	utilities.Answer(1, "Final score of first winning board", scores[0])
+
+	// Part 2: Figure out which board will win last. Once it wins, what would its
+	// final score be?
+	utilities.Answer(2, "Final score of last winning board", scores[len(scores)-1])
+
+	return nil
+}
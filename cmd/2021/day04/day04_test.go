@@ -0,0 +1,75 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day04
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleBingo = `7,4,9,5,11,17,23,2,0,14,21,24,10,16,13,6,15,25,12,22,18,20,8,19,3,26,1
+
+22 13 17 11  0
+ 8  2 23  4 24
+21  9 14 16  7
+ 6 10  3 18  5
+ 1 12 20 15 19
+
+ 3 15  0  2 22
+ 9 18 13 17  5
+19  8  7 25 23
+20 11 10 24  4
+14 21 16 12  6
+
+14 21 17 24  4
+10 16 15  9 19
+18  8 23 26 20
+22 11 13  6  5
+ 2  0 12  3  7`
+
+func TestParseBingo(t *testing.T) {
+	bingo, err := ParseBingo(exampleBingo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 27, len(bingo.Draws))
+	assert.Equal(t, 3, len(bingo.Boards))
+	assert.Equal(t, [BoardSize]int{22, 13, 17, 11, 0}, bingo.Boards[0].Numbers[0])
+	assert.Equal(t, [BoardSize]int{2, 0, 12, 3, 7}, bingo.Boards[2].Numbers[4])
+
+	_, err = ParseBingo("1,2,3\n\n1 2 3")
+	assert.Error(t, err)
+}
+
+func TestHasWon(t *testing.T) {
+	board := &Board{}
+	assert.False(t, board.HasWon())
+
+	for r := 0; r < BoardSize; r++ {
+		board.Marked[r][2] = true
+	}
+	assert.True(t, board.HasWon())
+
+	diagonal := &Board{}
+	for i := 0; i < BoardSize; i++ {
+		diagonal.Marked[i][i] = true
+	}
+	assert.False(t, diagonal.HasWon())
+}
+
+func TestPlay(t *testing.T) {
+	bingo, err := ParseBingo(exampleBingo)
+	assert.NoError(t, err)
+
+	scores := bingo.Play()
+	assert.Equal(t, 3, len(scores))
+	assert.Equal(t, 4512, scores[0])
+	assert.Equal(t, 1924, scores[2])
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day05
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day05Cmd represents the day05 command
+var Day05Cmd = &cobra.Command{
+	Use:   "day05",
+	Short: `Hydrothermal Venture`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type Line struct {
+	Start utilities.Point2D
+	End   utilities.Point2D
+}
+
+func (l Line) IsHorizontal() bool {
+	return l.Start.Y == l.End.Y
+}
+
+func (l Line) IsVertical() bool {
+	return l.Start.X == l.End.X
+}
+
+func (l Line) IsDiagonal() bool {
+	return utilities.AbsoluteDifference(l.Start.X, l.End.X) == utilities.AbsoluteDifference(l.Start.Y, l.End.Y)
+}
+
+// Points returns every point covered by a horizontal, vertical or 45 degree line.
+func (l Line) Points() []utilities.Point2D {
+	sign := func(a, b int) int {
+		switch {
+		case a < b:
+			return 1
+		case a > b:
+			return -1
+		}
+
+		return 0
+	}
+
+	dx := sign(l.Start.X, l.End.X)
+	dy := sign(l.Start.Y, l.End.Y)
+	length := max(utilities.AbsoluteDifference(l.Start.X, l.End.X), utilities.AbsoluteDifference(l.Start.Y, l.End.Y))
+
+	points := make([]utilities.Point2D, 0, length+1)
+	for i := 0; i <= length; i++ {
+		points = append(points, utilities.NewPoint2D(l.Start.X+i*dx, l.Start.Y+i*dy))
+	}
+
+	return points
+}
+
+func ParseLine(line string) (Line, error) {
+	numbers := utilities.ParseIntList(line)
+	if len(numbers) != 4 || !strings.Contains(line, "->") {
+		return Line{}, fmt.Errorf("invalid line '%s'", line)
+	}
+
+	l := Line{Start: utilities.NewPoint2D(numbers[0], numbers[1]), End: utilities.NewPoint2D(numbers[2], numbers[3])}
+
+	if !l.IsHorizontal() && !l.IsVertical() && !l.IsDiagonal() {
+		return Line{}, fmt.Errorf("line '%s' is not horizontal, vertical or diagonal", line)
+	}
+
+	return l, nil
+}
+
+func ParseLines(fileContents string) ([]Line, error) {
+	lines := make([]Line, 0)
+
+	for _, text := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		l, err := ParseLine(text)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, l)
+	}
+
+	return lines, nil
+}
+
+func CountOverlaps(lines []Line, includeDiagonals bool) int {
+	coverage := make(map[utilities.Point2D]int)
+
+	for _, l := range lines {
+		if !includeDiagonals && !l.IsHorizontal() && !l.IsVertical() {
+			continue
+		}
+
+		for _, p := range l.Points() {
+			coverage[p]++
+		}
+	}
+
+	overlaps := 0
+	for _, c := range coverage {
+		if c >= 2 {
+			overlaps++
+		}
+	}
+
+	return overlaps
+}
+
+func day(fileContents string) error {
+	lines, err := ParseLines(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Consider only horizontal and vertical lines. At how many points do at
+	// least two lines overlap?
+	utilities.Answer(1, "Points where horizontal and vertical lines overlap", CountOverlaps(lines, false))
+
+	// Part 2: Consider all of the lines. At how many points do at least two lines
+	// overlap?
+	utilities.Answer(2, "Points where lines overlap", CountOverlaps(lines, true))
+
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day05
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleLines = `0,9 -> 5,9
+8,0 -> 0,8
+9,4 -> 3,4
+2,2 -> 2,1
+7,0 -> 7,4
+6,4 -> 2,0
+0,9 -> 2,9
+3,4 -> 1,4
+0,0 -> 8,8
+5,5 -> 8,2`
+
+func TestParseLine(t *testing.T) {
+	l, err := ParseLine("0,9 -> 5,9")
+	assert.NoError(t, err)
+	assert.Equal(t, Line{Start: utilities.NewPoint2D(0, 9), End: utilities.NewPoint2D(5, 9)}, l)
+	assert.True(t, l.IsHorizontal())
+
+	_, err = ParseLine("0,9 -> 5,7")
+	assert.Error(t, err)
+
+	_, err = ParseLine("0,9 5,9")
+	assert.Error(t, err)
+}
+
+func TestPoints(t *testing.T) {
+	type testCase struct {
+		line           Line
+		expectedPoints []utilities.Point2D
+	}
+
+	testCases := []testCase{
+		{
+			line:           Line{Start: utilities.NewPoint2D(1, 1), End: utilities.NewPoint2D(1, 3)},
+			expectedPoints: []utilities.Point2D{{X: 1, Y: 1}, {X: 1, Y: 2}, {X: 1, Y: 3}},
+		},
+		{
+			line:           Line{Start: utilities.NewPoint2D(9, 7), End: utilities.NewPoint2D(7, 7)},
+			expectedPoints: []utilities.Point2D{{X: 9, Y: 7}, {X: 8, Y: 7}, {X: 7, Y: 7}},
+		},
+		{
+			line:           Line{Start: utilities.NewPoint2D(9, 7), End: utilities.NewPoint2D(7, 9)},
+			expectedPoints: []utilities.Point2D{{X: 9, Y: 7}, {X: 8, Y: 8}, {X: 7, Y: 9}},
+		},
+	}
+
+	for _, test := range testCases {
+		assert.Equal(t, test.expectedPoints, test.line.Points())
+	}
+}
+
+func TestCountOverlaps(t *testing.T) {
+	lines, err := ParseLines(exampleLines)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 5, CountOverlaps(lines, false))
+	assert.Equal(t, 12, CountOverlaps(lines, true))
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
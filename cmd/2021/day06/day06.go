@@ -0,0 +1,105 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day06
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day06Cmd represents the day06 command
+var Day06Cmd = &cobra.Command{
+	Use:   "day06",
+	Short: `Lanternfish`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	ResetTimer   = 6
+	NewbornTimer = 8
+)
+
+// School counts lanternfish by the number of days left on their timers, since
+// fish with the same timer behave identically.
+type School [NewbornTimer + 1]int
+
+func ParseSchool(fileContents string) (School, error) {
+	school := School{}
+
+	timers := utilities.ParseIntList(fileContents)
+	if len(timers) == 0 {
+		return school, fmt.Errorf("no lanternfish")
+	}
+
+	for _, t := range timers {
+		if t < 0 || t > NewbornTimer {
+			return school, fmt.Errorf("invalid timer %d", t)
+		}
+
+		school[t]++
+	}
+
+	return school, nil
+}
+
+func (s School) Simulate(days int) School {
+	for d := 0; d < days; d++ {
+		spawning := s[0]
+
+		copy(s[:], s[1:])
+
+		s[ResetTimer] += spawning
+		s[NewbornTimer] = spawning
+	}
+
+	return s
+}
+
+func (s School) Size() int {
+	size := 0
+
+	for _, count := range s {
+		size += count
+	}
+
+	return size
+}
+
+func day(fileContents string) error {
+	school, err := ParseSchool(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Find a way to simulate lanternfish. How many lanternfish would there be
+	// after 80 days?
+	utilities.Answer(1, "Lanternfish after 80 days", school.Simulate(80).Size())
+
+	// Part 2: How many lanternfish would there be after 256 days?
+	utilities.Answer(2, "Lanternfish after 256 days", school.Simulate(256).Size())
+
+	return nil
+}
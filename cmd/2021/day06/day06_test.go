@@ -0,0 +1,50 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day06
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSchool(t *testing.T) {
+	school, err := ParseSchool("3,4,3,1,2")
+	assert.NoError(t, err)
+	assert.Equal(t, School{0, 1, 1, 2, 1, 0, 0, 0, 0}, school)
+
+	_, err = ParseSchool("3,4,9")
+	assert.Error(t, err)
+
+	_, err = ParseSchool("")
+	assert.Error(t, err)
+}
+
+func TestSimulate(t *testing.T) {
+	type testCase struct {
+		days         int
+		expectedSize int
+	}
+
+	testCases := []testCase{
+		{days: 1, expectedSize: 5},
+		{days: 2, expectedSize: 6},
+		{days: 18, expectedSize: 26},
+		{days: 80, expectedSize: 5934},
+		{days: 256, expectedSize: 26984457539},
+	}
+
+	school, err := ParseSchool("3,4,3,1,2")
+	assert.NoError(t, err)
+
+	for _, test := range testCases {
+		assert.Equal(t, test.expectedSize, school.Simulate(test.days).Size())
+	}
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
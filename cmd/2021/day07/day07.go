@@ -0,0 +1,105 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day07
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"slices"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day07Cmd represents the day07 command
+var Day07Cmd = &cobra.Command{
+	Use:   "day07",
+	Short: `The Treachery of Whales`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func ParsePositions(fileContents string) ([]int, error) {
+	positions := utilities.ParseIntList(fileContents)
+	if len(positions) == 0 {
+		return nil, fmt.Errorf("no crab positions")
+	}
+
+	return positions, nil
+}
+
+func ConstantFuel(distance int) int {
+	return distance
+}
+
+// IncreasingFuel is the cost when each step costs one more than the last.
+func IncreasingFuel(distance int) int {
+	return distance * (distance + 1) / 2
+}
+
+func AlignmentCost(positions []int, target int, fuel func(distance int) int) int {
+	cost := 0
+
+	for _, p := range positions {
+		cost += fuel(utilities.AbsoluteDifference(p, target))
+	}
+
+	return cost
+}
+
+// CheapestAlignment tries every position between the outermost crabs. The cost
+// is convex in the target, but the range is small enough not to need a search.
+func CheapestAlignment(positions []int, fuel func(distance int) int) (int, int) {
+	bestPosition, bestCost := 0, -1
+
+	for target := slices.Min(positions); target <= slices.Max(positions); target++ {
+		cost := AlignmentCost(positions, target, fuel)
+
+		if bestCost < 0 || cost < bestCost {
+			bestPosition, bestCost = target, cost
+		}
+	}
+
+	return bestPosition, bestCost
+}
+
+func day(fileContents string) error {
+	positions, err := ParsePositions(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Determine the horizontal position that the crabs can align to using the
+	// least fuel possible. How much fuel must they spend to align to that position?
+	position, cost := CheapestAlignment(positions, ConstantFuel)
+
+	utilities.Answer(1, fmt.Sprintf("Fuel to align at %d", position), cost)
+
+	// Part 2: Determine the horizontal position that the crabs can align to using the
+	// least fuel possible so they can make you an escape route! How much fuel must
+	// they spend to align to that position?
+	position, cost = CheapestAlignment(positions, IncreasingFuel)
+
+	utilities.Answer(2, fmt.Sprintf("Fuel to align at %d with crab engineering", position), cost)
+
+	return nil
+}
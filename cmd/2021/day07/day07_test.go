@@ -0,0 +1,41 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day07
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const examplePositions = `16,1,2,0,4,2,7,1,2,14`
+
+func TestAlignmentCost(t *testing.T) {
+	positions, err := ParsePositions(examplePositions)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 41, AlignmentCost(positions, 1, ConstantFuel))
+	assert.Equal(t, 39, AlignmentCost(positions, 3, ConstantFuel))
+	assert.Equal(t, 71, AlignmentCost(positions, 10, ConstantFuel))
+	assert.Equal(t, 206, AlignmentCost(positions, 2, IncreasingFuel))
+}
+
+func TestCheapestAlignment(t *testing.T) {
+	positions, err := ParsePositions(examplePositions)
+	assert.NoError(t, err)
+
+	position, cost := CheapestAlignment(positions, ConstantFuel)
+	assert.Equal(t, 2, position)
+	assert.Equal(t, 37, cost)
+
+	position, cost = CheapestAlignment(positions, IncreasingFuel)
+	assert.Equal(t, 5, position)
+	assert.Equal(t, 168, cost)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
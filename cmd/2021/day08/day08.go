@@ -0,0 +1,263 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day08
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math/bits"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day08Cmd represents the day08 command
+var Day08Cmd = &cobra.Command{
+	Use:   "day08",
+	Short: `Seven Segment Search`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+// Pattern is a set of lit segments, one bit per wire a through g.
+type Pattern uint8
+
+func ParsePattern(s string) (Pattern, error) {
+	p := Pattern(0)
+
+	for _, c := range s {
+		if c < 'a' || c > 'g' {
+			return 0, fmt.Errorf("invalid segment '%c'", c)
+		}
+
+		p |= 1 << (c - 'a')
+	}
+
+	return p, nil
+}
+
+func (p Pattern) Segments() int {
+	return bits.OnesCount8(uint8(p))
+}
+
+func (p Pattern) Contains(o Pattern) bool {
+	return p&o == o
+}
+
+type Entry struct {
+	Signals [10]Pattern
+	Output  [4]Pattern
+}
+
+func ParseEntry(line string) (Entry, error) {
+	entry := Entry{}
+
+	halves := strings.Split(line, "|")
+	if len(halves) != 2 {
+		return entry, fmt.Errorf("invalid entry '%s'", line)
+	}
+
+	signals := strings.Fields(halves[0])
+	output := strings.Fields(halves[1])
+
+	if len(signals) != len(entry.Signals) || len(output) != len(entry.Output) {
+		return entry, fmt.Errorf("invalid entry '%s'", line)
+	}
+
+	for i, s := range signals {
+		p, err := ParsePattern(s)
+		if err != nil {
+			return entry, err
+		}
+		entry.Signals[i] = p
+	}
+
+	for i, s := range output {
+		p, err := ParsePattern(s)
+		if err != nil {
+			return entry, err
+		}
+		entry.Output[i] = p
+	}
+
+	return entry, nil
+}
+
+func ParseEntries(fileContents string) ([]Entry, error) {
+	entries := make([]Entry, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		entry, err := ParseEntry(line)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// IsUniqueLength reports whether the pattern can only be a 1, 4, 7 or 8.
+func IsUniqueLength(p Pattern) bool {
+	switch p.Segments() {
+	case 2, 3, 4, 7:
+		return true
+	}
+
+	return false
+}
+
+func CountUniqueOutputDigits(entries []Entry) int {
+	count := 0
+
+	for _, e := range entries {
+		for _, p := range e.Output {
+			if IsUniqueLength(p) {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+// Deduce works out which signal pattern is which digit. The digits with a unique
+// number of segments are found first, and the rest are told apart by which of
+// those they contain.
+func (e Entry) Deduce() (map[Pattern]int, error) {
+	digits := [10]Pattern{}
+
+	for _, p := range e.Signals {
+		switch p.Segments() {
+		case 2:
+			digits[1] = p
+		case 3:
+			digits[7] = p
+		case 4:
+			digits[4] = p
+		case 7:
+			digits[8] = p
+		}
+	}
+
+	for _, p := range e.Signals {
+		switch p.Segments() {
+		case 6:
+			switch {
+			case p.Contains(digits[4]):
+				digits[9] = p
+			case p.Contains(digits[1]):
+				digits[0] = p
+			default:
+				digits[6] = p
+			}
+		}
+	}
+
+	for _, p := range e.Signals {
+		switch p.Segments() {
+		case 5:
+			switch {
+			case p.Contains(digits[1]):
+				digits[3] = p
+			case digits[6].Contains(p):
+				digits[5] = p
+			default:
+				digits[2] = p
+			}
+		}
+	}
+
+	lookup := make(map[Pattern]int, len(digits))
+	for d, p := range digits {
+		if p == 0 {
+			return nil, fmt.Errorf("could not deduce digit %d", d)
+		}
+
+		lookup[p] = d
+	}
+
+	if len(lookup) != len(digits) {
+		return nil, fmt.Errorf("signal patterns are not distinct")
+	}
+
+	return lookup, nil
+}
+
+func (e Entry) OutputValue() (int, error) {
+	lookup, err := e.Deduce()
+	if err != nil {
+		return 0, err
+	}
+
+	value := 0
+
+	for _, p := range e.Output {
+		d, ok := lookup[p]
+		if !ok {
+			return 0, fmt.Errorf("output pattern %07b is not a known digit", p)
+		}
+
+		value = value*10 + d
+	}
+
+	return value, nil
+}
+
+func OutputValueSum(entries []Entry) (int, error) {
+	sum := 0
+
+	for _, e := range entries {
+		value, err := e.OutputValue()
+		if err != nil {
+			return 0, err
+		}
+
+		sum += value
+	}
+
+	return sum, nil
+}
+
+func day(fileContents string) error {
+	entries, err := ParseEntries(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: In the output values, how many times do digits 1, 4, 7, or 8 appear?
+	utilities.Answer(1, "Output digits that are 1, 4, 7 or 8", CountUniqueOutputDigits(entries))
+
+	// Part 2: For each entry, determine all of the wire/segment connections and decode
+	// the four-digit output values. What do you get if you add up all of the output
+	// values?
+	sum, err := OutputValueSum(entries)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(2, "Sum of output values", sum)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day08
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleEntries = `be cfbegad cbdgef fgaecd cgeb fdcge agebfd fecdb fabcd edb | fdgacbe cefdb cefbgd gcbe
+edbfga begcd cbg gc gcadebf fbgde acbgfd abcde gfcbed gfec | fcgedb cgb dgebacf gc
+fgaebd cg bdaec gdafb agbcfd gdcbef bgcad gfac gcb cdgabef | cg cg fdcagb cbg
+fbegcd cbd adcefb dageb afcb bc aefdc ecdab fgdeca fcdbega | efabcd cedba gadfec cb
+aecbfdg fbg gf bafeg dbefa fcge gcbea fcaegb dgceab fcbdga | gecf egdcabf bgf bfgea
+fgeab ca afcebg bdacfeg cfaedg gcfdb baec bfadeg bafgc acf | gebdcfa ecba ca fadegcb
+dbcfg fgd bdegcaf fgec aegbdf ecdfab fbedc dacgb gdcebf gf | cefg dcbef fcge gbcadfe
+bdfegc cbegaf gecbf dfcage bdacg ed bedf ced adcbefg gebcd | ed bcgafe cdgba cbgef
+egadfb cdbfeg cegd fecab cgb gbdefca cg fgcdab egfdb bfceg | gbdfcae bgc cg cgb
+gcafb gcf dcaebfg ecagb gf abcdeg gaef cafbge fdbac fegbdc | fgae cfgab fg bagce`
+
+func TestParsePattern(t *testing.T) {
+	p, err := ParsePattern("acf")
+	assert.NoError(t, err)
+	assert.Equal(t, Pattern(0b100101), p)
+	assert.Equal(t, 3, p.Segments())
+
+	_, err = ParsePattern("abz")
+	assert.Error(t, err)
+}
+
+func TestCountUniqueOutputDigits(t *testing.T) {
+	entries, err := ParseEntries(exampleEntries)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 26, CountUniqueOutputDigits(entries))
+}
+
+func TestOutputValue(t *testing.T) {
+	entry, err := ParseEntry("acedgfb cdfbe gcdfa fbcad dab cefabd cdfgeb eafb cagedb ab | cdfeb fcadb cdfeb cdbaf")
+	assert.NoError(t, err)
+
+	value, err := entry.OutputValue()
+	assert.NoError(t, err)
+	assert.Equal(t, 5353, value)
+
+	entries, err := ParseEntries(exampleEntries)
+	assert.NoError(t, err)
+
+	expected := []int{8394, 9781, 1197, 9361, 4873, 8418, 4548, 1625, 8717, 4315}
+	for i, e := range entries {
+		value, err := e.OutputValue()
+		assert.NoError(t, err)
+		assert.Equal(t, expected[i], value)
+	}
+}
+
+func TestOutputValueSum(t *testing.T) {
+	entries, err := ParseEntries(exampleEntries)
+	assert.NoError(t, err)
+
+	sum, err := OutputValueSum(entries)
+	assert.NoError(t, err)
+	assert.Equal(t, 61229, sum)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day09
+
+import (
+	"io"
+	"log"
+	"os"
+	"slices"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day09Cmd represents the day09 command
+var Day09Cmd = &cobra.Command{
+	Use:   "day09",
+	Short: `Smoke Basin`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const MaximumHeight = 9
+
+type HeightMap struct {
+	Bounds  utilities.Size2D
+	Heights [][]int
+}
+
+func ParseHeightMap(fileContents string) (*HeightMap, error) {
+	heights, err := utilities.ParseDigitGrid(fileContents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HeightMap{Bounds: utilities.NewSize2D(len(heights[0]), len(heights)), Heights: heights}, nil
+}
+
+func (h *HeightMap) Contains(p utilities.Point2D) bool {
+	return p.X >= 0 && p.X < h.Bounds.Width && p.Y >= 0 && p.Y < h.Bounds.Height
+}
+
+func (h *HeightMap) Height(p utilities.Point2D) int {
+	return h.Heights[p.Y][p.X]
+}
+
+func (h *HeightMap) Neighbors(p utilities.Point2D) []utilities.Point2D {
+	neighbors := make([]utilities.Point2D, 0, 4)
+
+	for _, n := range []utilities.Point2D{p.Up(), p.Down(), p.Left(), p.Right()} {
+		if h.Contains(n) {
+			neighbors = append(neighbors, n)
+		}
+	}
+
+	return neighbors
+}
+
+func (h *HeightMap) LowPoints() []utilities.Point2D {
+	lowPoints := make([]utilities.Point2D, 0)
+
+	for y := 0; y < h.Bounds.Height; y++ {
+		for x := 0; x < h.Bounds.Width; x++ {
+			p := utilities.NewPoint2D(x, y)
+			low := true
+
+			for _, n := range h.Neighbors(p) {
+				if h.Height(n) <= h.Height(p) {
+					low = false
+					break
+				}
+			}
+
+			if low {
+				lowPoints = append(lowPoints, p)
+			}
+		}
+	}
+
+	return lowPoints
+}
+
+func (h *HeightMap) RiskLevelSum() int {
+	sum := 0
+
+	for _, p := range h.LowPoints() {
+		sum += h.Height(p) + 1
+	}
+
+	return sum
+}
+
+// BasinSize floods out from a low point until it hits locations of height 9,
+// which are never part of a basin.
+func (h *HeightMap) BasinSize(lowPoint utilities.Point2D) int {
+	basin := utilities.NewSetPoint2D()
+	basin.Add(lowPoint)
+
+	pending := utilities.NewFIFO[utilities.Point2D]()
+	pending.Push(lowPoint)
+
+	for !pending.IsEmpty() {
+		p := pending.Pop()
+
+		for _, n := range h.Neighbors(p) {
+			if h.Height(n) == MaximumHeight || basin.Exists(n) {
+				continue
+			}
+
+			basin.Add(n)
+			pending.Push(n)
+		}
+	}
+
+	return basin.Size()
+}
+
+func (h *HeightMap) LargestBasinsProduct(count int) int {
+	sizes := make([]int, 0)
+
+	for _, p := range h.LowPoints() {
+		sizes = append(sizes, h.BasinSize(p))
+	}
+
+	slices.Sort(sizes)
+	slices.Reverse(sizes)
+
+	product := 1
+	for i := 0; i < count && i < len(sizes); i++ {
+		product *= sizes[i]
+	}
+
+	return product
+}
+
+func day(fileContents string) error {
+	heightMap, err := ParseHeightMap(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Find all of the low points on your heightmap. What is the sum of the risk
+	// levels of all low points on your heightmap?
+	utilities.Answer(1, "Sum of low point risk levels", heightMap.RiskLevelSum())
+
+	// Part 2: What do you get if you multiply together the sizes of the three largest
+	// basins?
+	utilities.Answer(2, "Product of three largest basin sizes", heightMap.LargestBasinsProduct(3))
+
+	return nil
+}
@@ -0,0 +1,42 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day09
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleHeightMap = `2199943210
+3987894921
+9856789892
+8767896789
+9899965678`
+
+func TestLowPoints(t *testing.T) {
+	heightMap, err := ParseHeightMap(exampleHeightMap)
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, []utilities.Point2D{{X: 1, Y: 0}, {X: 9, Y: 0}, {X: 2, Y: 2}, {X: 6, Y: 4}}, heightMap.LowPoints())
+	assert.Equal(t, 15, heightMap.RiskLevelSum())
+}
+
+func TestBasinSize(t *testing.T) {
+	heightMap, err := ParseHeightMap(exampleHeightMap)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, heightMap.BasinSize(utilities.NewPoint2D(1, 0)))
+	assert.Equal(t, 9, heightMap.BasinSize(utilities.NewPoint2D(9, 0)))
+	assert.Equal(t, 14, heightMap.BasinSize(utilities.NewPoint2D(2, 2)))
+	assert.Equal(t, 9, heightMap.BasinSize(utilities.NewPoint2D(6, 4)))
+	assert.Equal(t, 1134, heightMap.LargestBasinsProduct(3))
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
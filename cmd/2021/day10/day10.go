@@ -0,0 +1,142 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day10
+
+import (
+	"io"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day10Cmd represents the day10 command
+var Day10Cmd = &cobra.Command{
+	Use:   "day10",
+	Short: `Syntax Scoring`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+var closers = map[rune]rune{'(': ')', '[': ']', '{': '}', '<': '>'}
+
+var syntaxErrorScores = map[rune]int{')': 3, ']': 57, '}': 1197, '>': 25137}
+
+var completionScores = map[rune]int{')': 1, ']': 2, '}': 3, '>': 4}
+
+type Status byte
+
+const (
+	Complete Status = iota
+	Corrupted
+	Incomplete
+)
+
+type Result struct {
+	Status     Status
+	Illegal    rune
+	Completion string
+}
+
+// Check walks the line with a stack of expected closing characters. A mismatched
+// closer makes the line corrupted; anything left on the stack at the end is what
+// an incomplete line is missing.
+func Check(line string) Result {
+	expected := utilities.Stack[rune]{}
+
+	for _, c := range line {
+		if closer, ok := closers[c]; ok {
+			expected.Push(closer)
+			continue
+		}
+
+		if expected.IsEmpty() || expected.Pop() != c {
+			return Result{Status: Corrupted, Illegal: c}
+		}
+	}
+
+	if expected.IsEmpty() {
+		return Result{Status: Complete}
+	}
+
+	completion := ""
+	for !expected.IsEmpty() {
+		completion += string(expected.Pop())
+	}
+
+	return Result{Status: Incomplete, Completion: completion}
+}
+
+func (r Result) CompletionScore() int {
+	score := 0
+
+	for _, c := range r.Completion {
+		score = score*5 + completionScores[c]
+	}
+
+	return score
+}
+
+func SyntaxErrorScore(lines []string) int {
+	score := 0
+
+	for _, l := range lines {
+		if r := Check(l); r.Status == Corrupted {
+			score += syntaxErrorScores[r.Illegal]
+		}
+	}
+
+	return score
+}
+
+func MiddleCompletionScore(lines []string) int {
+	scores := make([]int, 0)
+
+	for _, l := range lines {
+		if r := Check(l); r.Status == Incomplete {
+			scores = append(scores, r.CompletionScore())
+		}
+	}
+
+	if len(scores) == 0 {
+		return 0
+	}
+
+	slices.Sort(scores)
+
+	return scores[len(scores)/2]
+}
+
+func day(fileContents string) error {
+	lines := strings.Fields(fileContents)
+
+	// Part 1: Find the first illegal character in each corrupted line of the navigation
+	// subsystem. What is the total syntax error score for those errors?
+	utilities.Answer(1, "Total syntax error score", SyntaxErrorScore(lines))
+
+	// Part 2: Find the completion string for each incomplete line, score the completion
+	// strings, and sort the scores. What is the middle score?
+	utilities.Answer(2, "Middle completion score", MiddleCompletionScore(lines))
+
+	return nil
+}
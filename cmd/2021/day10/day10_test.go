@@ -0,0 +1,60 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day10
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleSubsystem = `[({(<(())[]>[[{[]{<()<>>
+[(()[<>])]({[<{<<[]>>(
+{([(<{}[<>[]}>{[]{[(<()>
+(((({<>}<{<{<>}{[]{[]{}
+[[<[([]))<([[{}[[()]]]
+[{[{({}]{}}([{[{{{}}([]
+{<[[]]>}<{[{[{[]{()[[[]
+[<(<(<(<{}))><([]([]()
+<{([([[(<>()){}]>(<<{{
+<{([{{}}[<[[[<>{}]]]>[]]`
+
+func TestCheck(t *testing.T) {
+	type testCase struct {
+		line           string
+		expectedResult Result
+	}
+
+	testCases := []testCase{
+		{line: "([])", expectedResult: Result{Status: Complete}},
+		{line: "(]", expectedResult: Result{Status: Corrupted, Illegal: ']'}},
+		{line: "{([(<{}[<>[]}>{[]{[(<()>", expectedResult: Result{Status: Corrupted, Illegal: '}'}},
+		{line: "[[<[([]))<([[{}[[()]]]", expectedResult: Result{Status: Corrupted, Illegal: ')'}},
+		{line: "[({(<(())[]>[[{[]{<()<>>", expectedResult: Result{Status: Incomplete, Completion: "}}]])})]"}},
+		{line: "<{([{{}}[<[[[<>{}]]]>[]]", expectedResult: Result{Status: Incomplete, Completion: "])}>"}},
+	}
+
+	for _, test := range testCases {
+		assert.Equal(t, test.expectedResult, Check(test.line))
+	}
+}
+
+func TestCompletionScore(t *testing.T) {
+	assert.Equal(t, 288957, Result{Completion: "}}]])})]"}.CompletionScore())
+	assert.Equal(t, 294, Result{Completion: "])}>"}.CompletionScore())
+}
+
+func TestScores(t *testing.T) {
+	lines := strings.Fields(exampleSubsystem)
+
+	assert.Equal(t, 26397, SyntaxErrorScore(lines))
+	assert.Equal(t, 288957, MiddleCompletionScore(lines))
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day11
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day11Cmd represents the day11 command
+var Day11Cmd = &cobra.Command{
+	Use:   "day11",
+	Short: `Dumbo Octopus`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const FlashThreshold = 9
+
+type Cavern struct {
+	Bounds  utilities.Size2D
+	Energy  [][]int
+	Flashes int
+	Steps   int
+}
+
+func ParseCavern(fileContents string) (*Cavern, error) {
+	energy, err := utilities.ParseDigitGrid(fileContents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cavern{Bounds: utilities.NewSize2D(len(energy[0]), len(energy)), Energy: energy}, nil
+}
+
+func (c *Cavern) Describe() string {
+	rows := make([]string, 0, c.Bounds.Height)
+
+	for _, row := range c.Energy {
+		s := ""
+		for _, e := range row {
+			s += fmt.Sprint(e)
+		}
+		rows = append(rows, s)
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func (c *Cavern) neighbors(p utilities.Point2D) []utilities.Point2D {
+	neighbors := make([]utilities.Point2D, 0, 8)
+
+	for _, n := range []utilities.Point2D{p.Up(), p.UpRight(), p.Right(), p.DownRight(), p.Down(), p.DownLeft(), p.Left(), p.UpLeft()} {
+		if n.X >= 0 && n.X < c.Bounds.Width && n.Y >= 0 && n.Y < c.Bounds.Height {
+			neighbors = append(neighbors, n)
+		}
+	}
+
+	return neighbors
+}
+
+// Step raises every octopus's energy, lets flashes cascade to neighbors, and
+// resets the flashed octopuses. It returns how many flashed.
+func (c *Cavern) Step() int {
+	flashing := utilities.NewFIFO[utilities.Point2D]()
+
+	for y := range c.Energy {
+		for x := range c.Energy[y] {
+			c.Energy[y][x]++
+
+			if c.Energy[y][x] == FlashThreshold+1 {
+				flashing.Push(utilities.NewPoint2D(x, y))
+			}
+		}
+	}
+
+	flashed := make([]utilities.Point2D, 0)
+
+	for !flashing.IsEmpty() {
+		p := flashing.Pop()
+		flashed = append(flashed, p)
+
+		for _, n := range c.neighbors(p) {
+			c.Energy[n.Y][n.X]++
+
+			if c.Energy[n.Y][n.X] == FlashThreshold+1 {
+				flashing.Push(n)
+			}
+		}
+	}
+
+	for _, p := range flashed {
+		c.Energy[p.Y][p.X] = 0
+	}
+
+	c.Flashes += len(flashed)
+	c.Steps++
+
+	return len(flashed)
+}
+
+func (c *Cavern) Run(steps int) int {
+	for i := 0; i < steps; i++ {
+		c.Step()
+	}
+
+	return c.Flashes
+}
+
+func (c *Cavern) FirstSynchronizedStep() int {
+	for c.Step() != c.Bounds.Width*c.Bounds.Height {
+	}
+
+	return c.Steps
+}
+
+func day(fileContents string) error {
+	cavern, err := ParseCavern(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Given the starting energy levels of the dumbo octopuses in your cavern,
+	// simulate 100 steps. How many total flashes are there after 100 steps?
+	utilities.Answer(1, "Total flashes after 100 steps", cavern.Run(100))
+
+	// Part 2: What is the first step during which all octopuses flash?
+	cavern, err = ParseCavern(fileContents)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(2, "First step where all octopuses flash", cavern.FirstSynchronizedStep())
+
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day11
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleCavern = `5483143223
+2745854711
+5264556173
+6141336146
+6357385478
+4167524645
+2176841721
+6882881134
+4846848554
+5283751526`
+
+func TestStep(t *testing.T) {
+	cavern, err := ParseCavern(`11111
+19991
+19191
+19991
+11111`)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 9, cavern.Step())
+	assert.Equal(t, `34543
+40004
+50005
+40004
+34543`, cavern.Describe())
+
+	assert.Equal(t, 0, cavern.Step())
+	assert.Equal(t, `45654
+51115
+61116
+51115
+45654`, cavern.Describe())
+}
+
+func TestRun(t *testing.T) {
+	cavern, err := ParseCavern(exampleCavern)
+	assert.NoError(t, err)
+	assert.Equal(t, 204, cavern.Run(10))
+
+	cavern, err = ParseCavern(exampleCavern)
+	assert.NoError(t, err)
+	assert.Equal(t, 1656, cavern.Run(100))
+}
+
+func TestFirstSynchronizedStep(t *testing.T) {
+	cavern, err := ParseCavern(exampleCavern)
+	assert.NoError(t, err)
+	assert.Equal(t, 195, cavern.FirstSynchronizedStep())
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
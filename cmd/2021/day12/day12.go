@@ -0,0 +1,138 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day12
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day12Cmd represents the day12 command
+var Day12Cmd = &cobra.Command{
+	Use:   "day12",
+	Short: `Passage Pathing`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	StartCave = "start"
+	EndCave   = "end"
+)
+
+type CaveSystem struct {
+	Connections map[string][]string
+}
+
+func ParseCaveSystem(fileContents string) (*CaveSystem, error) {
+	system := &CaveSystem{Connections: make(map[string][]string)}
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		caves := strings.Split(strings.TrimSpace(line), "-")
+		if len(caves) != 2 || caves[0] == "" || caves[1] == "" {
+			return nil, fmt.Errorf("invalid connection '%s'", line)
+		}
+
+		system.Connections[caves[0]] = append(system.Connections[caves[0]], caves[1])
+		system.Connections[caves[1]] = append(system.Connections[caves[1]], caves[0])
+	}
+
+	if _, ok := system.Connections[StartCave]; !ok {
+		return nil, fmt.Errorf("no %s cave", StartCave)
+	}
+
+	return system, nil
+}
+
+func IsSmall(cave string) bool {
+	for _, r := range cave {
+		if !unicode.IsLower(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CountPaths counts the paths from start to end that visit small caves at most
+// once, except that a single small cave may be visited twice when allowRevisit
+// is set. The start cave can never be returned to.
+func (s *CaveSystem) CountPaths(allowRevisit bool) int {
+	visits := make(map[string]int)
+
+	var explore func(cave string, revisitUsed bool) int
+
+	explore = func(cave string, revisitUsed bool) int {
+		if cave == EndCave {
+			return 1
+		}
+
+		paths := 0
+
+		for _, next := range s.Connections[cave] {
+			if next == StartCave {
+				continue
+			}
+
+			revisit := revisitUsed
+
+			if IsSmall(next) && visits[next] > 0 {
+				if !allowRevisit || revisitUsed {
+					continue
+				}
+
+				revisit = true
+			}
+
+			visits[next]++
+			paths += explore(next, revisit)
+			visits[next]--
+		}
+
+		return paths
+	}
+
+	visits[StartCave] = 1
+
+	return explore(StartCave, false)
+}
+
+func day(fileContents string) error {
+	system, err := ParseCaveSystem(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: How many paths through this cave system are there that visit small caves
+	// at most once?
+	utilities.Answer(1, "Paths visiting small caves at most once", system.CountPaths(false))
+
+	// Part 2: Given these new rules, how many paths through this cave system are there?
+	utilities.Answer(2, "Paths visiting one small cave twice", system.CountPaths(true))
+
+	return nil
+}
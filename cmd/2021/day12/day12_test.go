@@ -0,0 +1,90 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day12
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSmall(t *testing.T) {
+	assert.True(t, IsSmall("start"))
+	assert.True(t, IsSmall("dc"))
+	assert.False(t, IsSmall("HN"))
+}
+
+func TestCountPaths(t *testing.T) {
+	type testCase struct {
+		text                 string
+		expectedPaths        int
+		expectedRevisitPaths int
+	}
+
+	testCases := []testCase{
+		{
+			text: `start-A
+start-b
+A-c
+A-b
+b-d
+A-end
+b-end`,
+			expectedPaths:        10,
+			expectedRevisitPaths: 36,
+		},
+		{
+			text: `dc-end
+HN-start
+start-kj
+dc-start
+dc-HN
+LN-dc
+HN-end
+kj-sj
+kj-HN
+kj-dc`,
+			expectedPaths:        19,
+			expectedRevisitPaths: 103,
+		},
+		{
+			text: `fs-end
+he-DX
+fs-he
+start-DX
+pj-DX
+end-zg
+zg-sl
+zg-pj
+pj-he
+RW-he
+fs-DX
+pj-RW
+zg-RW
+start-pj
+he-WI
+zg-he
+pj-fs
+start-RW`,
+			expectedPaths:        226,
+			expectedRevisitPaths: 3509,
+		},
+	}
+
+	for _, test := range testCases {
+		system, err := ParseCaveSystem(test.text)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedPaths, system.CountPaths(false))
+		assert.Equal(t, test.expectedRevisitPaths, system.CountPaths(true))
+	}
+
+	_, err := ParseCaveSystem("start-A-b")
+	assert.Error(t, err)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day13
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day13Cmd represents the day13 command
+var Day13Cmd = &cobra.Command{
+	Use:   "day13",
+	Short: `Transparent Origami`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type Fold struct {
+	AlongX bool
+	Line   int
+}
+
+type Paper struct {
+	Dots  *utilities.SetPoint2D
+	Folds []Fold
+}
+
+func ParsePaper(fileContents string) (*Paper, error) {
+	sections := strings.Split(strings.TrimSpace(fileContents), "\n\n")
+	if len(sections) != 2 {
+		return nil, fmt.Errorf("expected dots and folds separated by a blank line")
+	}
+
+	paper := &Paper{Dots: utilities.NewSetPoint2D()}
+
+	for _, line := range strings.Split(sections[0], "\n") {
+		coordinates := utilities.ParseIntList(line)
+		if len(coordinates) != 2 {
+			return nil, fmt.Errorf("invalid dot '%s'", line)
+		}
+
+		paper.Dots.Add(utilities.NewPoint2D(coordinates[0], coordinates[1]))
+	}
+
+	for _, line := range strings.Split(sections[1], "\n") {
+		instruction, found := strings.CutPrefix(strings.TrimSpace(line), "fold along ")
+		if !found || len(instruction) < 3 || instruction[1] != '=' || (instruction[0] != 'x' && instruction[0] != 'y') {
+			return nil, fmt.Errorf("invalid fold '%s'", line)
+		}
+
+		position, err := strconv.Atoi(instruction[2:])
+		if err != nil {
+			return nil, err
+		}
+
+		paper.Folds = append(paper.Folds, Fold{AlongX: instruction[0] == 'x', Line: position})
+	}
+
+	return paper, nil
+}
+
+// Apply folds the paper, reflecting every dot past the fold line back over it.
+func (p *Paper) Apply(f Fold) {
+	folded := utilities.NewSetPoint2D()
+
+	for dot := range p.Dots.All() {
+		if f.AlongX && dot.X > f.Line {
+			dot.X = 2*f.Line - dot.X
+		} else if !f.AlongX && dot.Y > f.Line {
+			dot.Y = 2*f.Line - dot.Y
+		}
+
+		folded.Add(dot)
+	}
+
+	p.Dots = folded
+}
+
+func (p *Paper) Describe() string {
+	width, height := 0, 0
+
+	for dot := range p.Dots.All() {
+		width = max(width, dot.X+1)
+		height = max(height, dot.Y+1)
+	}
+
+	rows := make([]string, 0, height)
+
+	for y := 0; y < height; y++ {
+		row := ""
+		for x := 0; x < width; x++ {
+			if p.Dots.Exists(utilities.NewPoint2D(x, y)) {
+				row += "#"
+			} else {
+				row += "."
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func day(fileContents string) error {
+	paper, err := ParsePaper(fileContents)
+	if err != nil {
+		return err
+	}
+
+	if len(paper.Folds) == 0 {
+		return fmt.Errorf("no fold instructions")
+	}
+
+	// Part 1: How many dots are visible after completing just the first fold
+	// instruction on your transparent paper?
+	paper.Apply(paper.Folds[0])
+
+	utilities.Answer(1, "Dots visible after the first fold", paper.Dots.Size())
+
+	// Part 2: Finish folding the transparent paper according to the instructions. The
+	// manual says the code is always eight capital letters. What code do you use to
+	// activate the infrared thermal imaging camera system?
+	for _, f := range paper.Folds[1:] {
+		paper.Apply(f)
+	}
+
+	picture := paper.Describe()
+
+	code, err := utilities.RecognizeLetters(strings.Split(picture, "\n"))
+	if err != nil {
+		log.Printf("Activation code:\n%s\n", picture)
+		return err
+	}
+
+	utilities.Answer(2, "Activation code", code)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day13
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const examplePaper = `6,10
+0,14
+9,10
+0,3
+10,4
+4,11
+6,0
+6,12
+4,1
+0,13
+10,12
+3,4
+3,0
+8,4
+1,10
+2,14
+8,10
+9,0
+
+fold along y=7
+fold along x=5`
+
+func TestParsePaper(t *testing.T) {
+	paper, err := ParsePaper(examplePaper)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 18, paper.Dots.Size())
+	assert.Equal(t, []Fold{{AlongX: false, Line: 7}, {AlongX: true, Line: 5}}, paper.Folds)
+
+	_, err = ParsePaper("6,10\n\nfold along z=7")
+	assert.Error(t, err)
+
+	_, err = ParsePaper("6,10,3\n\nfold along y=7")
+	assert.Error(t, err)
+}
+
+func TestApply(t *testing.T) {
+	paper, err := ParsePaper(examplePaper)
+	assert.NoError(t, err)
+
+	paper.Apply(paper.Folds[0])
+	assert.Equal(t, 17, paper.Dots.Size())
+	assert.Equal(t, `#.##..#..#.
+#...#......
+......#...#
+#...#......
+.#.#..#.###`, paper.Describe())
+
+	paper.Apply(paper.Folds[1])
+	assert.Equal(t, 16, paper.Dots.Size())
+	assert.Equal(t, `#####
+#...#
+#...#
+#...#
+#####`, paper.Describe())
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
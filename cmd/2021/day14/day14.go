@@ -0,0 +1,142 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day14
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day14Cmd represents the day14 command
+var Day14Cmd = &cobra.Command{
+	Use:   "day14",
+	Short: `Extended Polymerization`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type Pair [2]byte
+
+type Manual struct {
+	Template string
+	Rules    map[Pair]byte
+}
+
+func ParseManual(fileContents string) (*Manual, error) {
+	sections := strings.Split(strings.TrimSpace(fileContents), "\n\n")
+	if len(sections) != 2 {
+		return nil, fmt.Errorf("expected template and rules separated by a blank line")
+	}
+
+	manual := &Manual{Template: strings.TrimSpace(sections[0]), Rules: make(map[Pair]byte)}
+	if len(manual.Template) < 2 {
+		return nil, fmt.Errorf("template '%s' is too short", manual.Template)
+	}
+
+	for _, line := range strings.Split(sections[1], "\n") {
+		var from string
+		var to string
+
+		if _, err := fmt.Sscanf(strings.TrimSpace(line), "%s -> %s", &from, &to); err != nil || len(from) != 2 || len(to) != 1 {
+			return nil, fmt.Errorf("invalid rule '%s'", line)
+		}
+
+		manual.Rules[Pair{from[0], from[1]}] = to[0]
+	}
+
+	return manual, nil
+}
+
+// Grow applies the insertion rules for the number of steps. The polymer doubles in
+// length each step, so only the count of each adjacent pair is tracked; every pair
+// with a rule becomes two pairs around the inserted element.
+func (m *Manual) Grow(steps int) map[byte]int {
+	pairs := make(map[Pair]int)
+
+	for i := 0; i < len(m.Template)-1; i++ {
+		pairs[Pair{m.Template[i], m.Template[i+1]}]++
+	}
+
+	for s := 0; s < steps; s++ {
+		next := make(map[Pair]int, len(pairs))
+
+		for p, count := range pairs {
+			if inserted, ok := m.Rules[p]; ok {
+				next[Pair{p[0], inserted}] += count
+				next[Pair{inserted, p[1]}] += count
+			} else {
+				next[p] += count
+			}
+		}
+
+		pairs = next
+	}
+
+	// Every element is the first of exactly one pair, except the last element,
+	// which never changes.
+	elements := make(map[byte]int)
+	for p, count := range pairs {
+		elements[p[0]] += count
+	}
+	elements[m.Template[len(m.Template)-1]]++
+
+	return elements
+}
+
+func (m *Manual) QuantityDifference(steps int) int {
+	elements := m.Grow(steps)
+
+	mostCommon, leastCommon := 0, -1
+
+	for _, count := range elements {
+		mostCommon = max(mostCommon, count)
+
+		if leastCommon < 0 || count < leastCommon {
+			leastCommon = count
+		}
+	}
+
+	return mostCommon - leastCommon
+}
+
+func day(fileContents string) error {
+	manual, err := ParseManual(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Apply 10 steps of pair insertion to the polymer template and find the most
+	// and least common elements in the result. What do you get if you take the quantity
+	// of the most common element and subtract the quantity of the least common element?
+	utilities.Answer(1, "Quantity difference after 10 steps", manual.QuantityDifference(10))
+
+	// Part 2: Apply 40 steps of pair insertion to the polymer template and find the most
+	// and least common elements in the result. What do you get if you take the quantity
+	// of the most common element and subtract the quantity of the least common element?
+	utilities.Answer(2, "Quantity difference after 40 steps", manual.QuantityDifference(40))
+
+	return nil
+}
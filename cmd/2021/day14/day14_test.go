@@ -0,0 +1,69 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day14
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleManual = `NNCB
+
+CH -> B
+HH -> N
+CB -> H
+NH -> C
+HB -> C
+HC -> B
+HN -> C
+NN -> C
+BH -> H
+NC -> B
+NB -> B
+BN -> B
+BB -> N
+BC -> B
+CC -> N
+CN -> C`
+
+func TestParseManual(t *testing.T) {
+	manual, err := ParseManual(exampleManual)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "NNCB", manual.Template)
+	assert.Equal(t, 16, len(manual.Rules))
+	assert.Equal(t, byte('B'), manual.Rules[Pair{'C', 'H'}])
+
+	_, err = ParseManual("NNCB\n\nCHX -> B")
+	assert.Error(t, err)
+}
+
+func TestGrow(t *testing.T) {
+	manual, err := ParseManual(exampleManual)
+	assert.NoError(t, err)
+
+	// NCNBCHB
+	assert.Equal(t, map[byte]int{'N': 2, 'C': 2, 'B': 2, 'H': 1}, manual.Grow(1))
+
+	elements := manual.Grow(10)
+	assert.Equal(t, 1749, elements['B'])
+	assert.Equal(t, 298, elements['C'])
+	assert.Equal(t, 161, elements['H'])
+	assert.Equal(t, 865, elements['N'])
+}
+
+func TestQuantityDifference(t *testing.T) {
+	manual, err := ParseManual(exampleManual)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1588, manual.QuantityDifference(10))
+	assert.Equal(t, 2188189693529, manual.QuantityDifference(40))
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -0,0 +1,270 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day16
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day16Cmd represents the day16 command
+var Day16Cmd = &cobra.Command{
+	Use:   "day16",
+	Short: `Packet Decoder`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type PacketType int
+
+const (
+	Sum         PacketType = 0
+	Product     PacketType = 1
+	Minimum     PacketType = 2
+	Maximum     PacketType = 3
+	Literal     PacketType = 4
+	GreaterThan PacketType = 5
+	LessThan    PacketType = 6
+	EqualTo     PacketType = 7
+)
+
+type Packet struct {
+	Version    int
+	Type       PacketType
+	Value      int
+	SubPackets []*Packet
+}
+
+type bitReader struct {
+	bits     string
+	position int
+}
+
+func (r *bitReader) read(count int) (int, error) {
+	if r.position+count > len(r.bits) {
+		return 0, fmt.Errorf("transmission ended after %d bits", len(r.bits))
+	}
+
+	value, err := strconv.ParseInt(r.bits[r.position:r.position+count], 2, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	r.position += count
+
+	return int(value), nil
+}
+
+func (r *bitReader) readPacket() (*Packet, error) {
+	version, err := r.read(3)
+	if err != nil {
+		return nil, err
+	}
+
+	packetType, err := r.read(3)
+	if err != nil {
+		return nil, err
+	}
+
+	packet := &Packet{Version: version, Type: PacketType(packetType)}
+
+	if packet.Type == Literal {
+		for {
+			group, err := r.read(5)
+			if err != nil {
+				return nil, err
+			}
+
+			packet.Value = packet.Value<<4 | group&0xF
+
+			if group&0x10 == 0 {
+				return packet, nil
+			}
+		}
+	}
+
+	lengthType, err := r.read(1)
+	if err != nil {
+		return nil, err
+	}
+
+	if lengthType == 0 {
+		length, err := r.read(15)
+		if err != nil {
+			return nil, err
+		}
+
+		end := r.position + length
+
+		for r.position < end {
+			sub, err := r.readPacket()
+			if err != nil {
+				return nil, err
+			}
+
+			packet.SubPackets = append(packet.SubPackets, sub)
+		}
+
+		if r.position != end {
+			return nil, fmt.Errorf("sub-packets overran their length of %d bits", length)
+		}
+	} else {
+		count, err := r.read(11)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < count; i++ {
+			sub, err := r.readPacket()
+			if err != nil {
+				return nil, err
+			}
+
+			packet.SubPackets = append(packet.SubPackets, sub)
+		}
+	}
+
+	return packet, nil
+}
+
+// ParseTransmission decodes the outermost packet of a hexadecimal transmission.
+// Trailing zero bits used for padding are ignored.
+func ParseTransmission(transmission string) (*Packet, error) {
+	var bits strings.Builder
+
+	for _, r := range strings.TrimSpace(transmission) {
+		nibble, err := strconv.ParseUint(string(r), 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hexadecimal digit '%c'", r)
+		}
+
+		bits.WriteString(fmt.Sprintf("%04b", nibble))
+	}
+
+	reader := &bitReader{bits: bits.String()}
+
+	return reader.readPacket()
+}
+
+func (p *Packet) VersionSum() int {
+	sum := p.Version
+
+	for _, sub := range p.SubPackets {
+		sum += sub.VersionSum()
+	}
+
+	return sum
+}
+
+func (p *Packet) Evaluate() (int, error) {
+	if p.Type == Literal {
+		return p.Value, nil
+	}
+
+	values := make([]int, 0, len(p.SubPackets))
+
+	for _, sub := range p.SubPackets {
+		v, err := sub.Evaluate()
+		if err != nil {
+			return 0, err
+		}
+
+		values = append(values, v)
+	}
+
+	if len(values) == 0 {
+		return 0, fmt.Errorf("operator packet %d has no sub-packets", p.Type)
+	}
+
+	switch p.Type {
+	case Sum:
+		total := 0
+		for _, v := range values {
+			total += v
+		}
+		return total, nil
+
+	case Product:
+		total := 1
+		for _, v := range values {
+			total *= v
+		}
+		return total, nil
+
+	case Minimum:
+		return slices.Min(values), nil
+
+	case Maximum:
+		return slices.Max(values), nil
+	}
+
+	if len(values) != 2 {
+		return 0, fmt.Errorf("comparison packet %d has %d sub-packets", p.Type, len(values))
+	}
+
+	result := false
+
+	switch p.Type {
+	case GreaterThan:
+		result = values[0] > values[1]
+	case LessThan:
+		result = values[0] < values[1]
+	case EqualTo:
+		result = values[0] == values[1]
+	default:
+		return 0, fmt.Errorf("unknown packet type %d", p.Type)
+	}
+
+	if result {
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+func day(fileContents string) error {
+	packet, err := ParseTransmission(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Decode the structure of your hexadecimal-encoded BITS transmission; what
+	// do you get if you add up the version numbers in all packets?
+	utilities.Answer(1, "Sum of version numbers", packet.VersionSum())
+
+	// Part 2: What do you get if you evaluate the expression represented by your
+	// hexadecimal-encoded BITS transmission?
+	value, err := packet.Evaluate()
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(2, "Transmission value", value)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day16
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTransmission(t *testing.T) {
+	packet, err := ParseTransmission("D2FE28")
+	assert.NoError(t, err)
+	assert.Equal(t, &Packet{Version: 6, Type: Literal, Value: 2021}, packet)
+
+	packet, err = ParseTransmission("38006F45291200")
+	assert.NoError(t, err)
+	assert.Equal(t, &Packet{Version: 1, Type: LessThan, SubPackets: []*Packet{
+		{Version: 6, Type: Literal, Value: 10},
+		{Version: 2, Type: Literal, Value: 20},
+	}}, packet)
+
+	packet, err = ParseTransmission("EE00D40C823060")
+	assert.NoError(t, err)
+	assert.Equal(t, &Packet{Version: 7, Type: Maximum, SubPackets: []*Packet{
+		{Version: 2, Type: Literal, Value: 1},
+		{Version: 4, Type: Literal, Value: 2},
+		{Version: 1, Type: Literal, Value: 3},
+	}}, packet)
+
+	_, err = ParseTransmission("D2FG28")
+	assert.Error(t, err)
+
+	_, err = ParseTransmission("D2")
+	assert.Error(t, err)
+}
+
+func TestVersionSum(t *testing.T) {
+	type testCase struct {
+		transmission string
+		expected     int
+	}
+
+	testCases := []testCase{
+		{transmission: "8A004A801A8002F478", expected: 16},
+		{transmission: "620080001611562C8802118E34", expected: 12},
+		{transmission: "C0015000016115A2E0802F182340", expected: 23},
+		{transmission: "A0016C880162017C3686B18A3D4780", expected: 31},
+	}
+
+	for _, test := range testCases {
+		packet, err := ParseTransmission(test.transmission)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, packet.VersionSum(), test.transmission)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	type testCase struct {
+		transmission string
+		expected     int
+	}
+
+	testCases := []testCase{
+		{transmission: "C200B40A82", expected: 3},
+		{transmission: "04005AC33890", expected: 54},
+		{transmission: "880086C3E88112", expected: 7},
+		{transmission: "CE00C43D881120", expected: 9},
+		{transmission: "D8005AC2A8F0", expected: 1},
+		{transmission: "F600BC2D8F", expected: 0},
+		{transmission: "9C005AC2F8F0", expected: 0},
+		{transmission: "9C0141080250320F1802104A08", expected: 1},
+	}
+
+	for _, test := range testCases {
+		packet, err := ParseTransmission(test.transmission)
+		assert.NoError(t, err)
+
+		value, err := packet.Evaluate()
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, value, test.transmission)
+	}
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day17
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day17Cmd represents the day17 command
+var Day17Cmd = &cobra.Command{
+	Use:   "day17",
+	Short: `Trick Shot`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type TargetArea struct {
+	MinimumX, MaximumX int
+	MinimumY, MaximumY int
+}
+
+func ParseTargetArea(fileContents string) (TargetArea, error) {
+	var area TargetArea
+
+	if _, err := fmt.Sscanf(strings.TrimSpace(fileContents), "target area: x=%d..%d, y=%d..%d", &area.MinimumX, &area.MaximumX, &area.MinimumY, &area.MaximumY); err != nil {
+		return TargetArea{}, fmt.Errorf("invalid target area '%s': %w", fileContents, err)
+	}
+
+	if area.MinimumX > area.MaximumX || area.MinimumY > area.MaximumY {
+		return TargetArea{}, fmt.Errorf("target area '%s' has empty ranges", fileContents)
+	}
+
+	// The probe is launched from the origin and falls, so the trench has to be
+	// below and in front of it for the search bounds to hold.
+	if area.MinimumX <= 0 || area.MaximumY >= 0 {
+		return TargetArea{}, fmt.Errorf("target area must be below and to the right of the launcher")
+	}
+
+	return area, nil
+}
+
+func (a TargetArea) Contains(p utilities.Point2D) bool {
+	return p.X >= a.MinimumX && p.X <= a.MaximumX && p.Y >= a.MinimumY && p.Y <= a.MaximumY
+}
+
+// Launch fires the probe with the initial velocity and reports whether it ever
+// lands in the target area, along with the highest y position it reached.
+func (a TargetArea) Launch(velocity utilities.Point2D) (bool, int) {
+	position := utilities.NewPoint2D(0, 0)
+	highest := 0
+
+	for position.X <= a.MaximumX && position.Y >= a.MinimumY {
+		position.X += velocity.X
+		position.Y += velocity.Y
+
+		highest = max(highest, position.Y)
+
+		if a.Contains(position) {
+			return true, highest
+		}
+
+		if velocity.X > 0 {
+			velocity.X--
+		}
+		velocity.Y--
+	}
+
+	return false, highest
+}
+
+// Velocities tries every initial velocity that could land in the target area.
+// Anything faster than the far edge overshoots on the first step, and a probe
+// fired upward comes back through y=0 with its launch speed plus one, so it must
+// not be faster than the bottom edge.
+func (a TargetArea) Velocities() (int, int) {
+	highest := 0
+	count := 0
+
+	for vx := 1; vx <= a.MaximumX; vx++ {
+		for vy := a.MinimumY; vy <= -a.MinimumY; vy++ {
+			if hit, h := a.Launch(utilities.NewPoint2D(vx, vy)); hit {
+				highest = max(highest, h)
+				count++
+			}
+		}
+	}
+
+	return highest, count
+}
+
+func day(fileContents string) error {
+	area, err := ParseTargetArea(fileContents)
+	if err != nil {
+		return err
+	}
+
+	highest, count := area.Velocities()
+
+	// Part 1: Find the initial velocity that causes the probe to reach the highest y
+	// position and still eventually be within the target area after any step. What is
+	// the highest y position it reaches on this trajectory?
+	utilities.Answer(1, "Highest y position", highest)
+
+	// Part 2: How many distinct initial velocity values cause the probe to be within
+	// the target area after any step?
+	utilities.Answer(2, "Distinct initial velocities", count)
+
+	return nil
+}
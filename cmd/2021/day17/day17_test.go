@@ -0,0 +1,68 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day17
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleTargetArea = "target area: x=20..30, y=-10..-5"
+
+func TestParseTargetArea(t *testing.T) {
+	area, err := ParseTargetArea(exampleTargetArea)
+	assert.NoError(t, err)
+	assert.Equal(t, TargetArea{MinimumX: 20, MaximumX: 30, MinimumY: -10, MaximumY: -5}, area)
+
+	_, err = ParseTargetArea("target area: x=20..30")
+	assert.Error(t, err)
+
+	_, err = ParseTargetArea("target area: x=20..30, y=5..10")
+	assert.Error(t, err)
+}
+
+func TestLaunch(t *testing.T) {
+	area, err := ParseTargetArea(exampleTargetArea)
+	assert.NoError(t, err)
+
+	type testCase struct {
+		velocity        utilities.Point2D
+		expectedHit     bool
+		expectedHighest int
+	}
+
+	testCases := []testCase{
+		{velocity: utilities.NewPoint2D(7, 2), expectedHit: true, expectedHighest: 3},
+		{velocity: utilities.NewPoint2D(6, 3), expectedHit: true, expectedHighest: 6},
+		{velocity: utilities.NewPoint2D(9, 0), expectedHit: true, expectedHighest: 0},
+		{velocity: utilities.NewPoint2D(17, -4), expectedHit: false},
+		{velocity: utilities.NewPoint2D(6, 9), expectedHit: true, expectedHighest: 45},
+	}
+
+	for _, test := range testCases {
+		hit, highest := area.Launch(test.velocity)
+		assert.Equal(t, test.expectedHit, hit)
+
+		if hit {
+			assert.Equal(t, test.expectedHighest, highest)
+		}
+	}
+}
+
+func TestVelocities(t *testing.T) {
+	area, err := ParseTargetArea(exampleTargetArea)
+	assert.NoError(t, err)
+
+	highest, count := area.Velocities()
+	assert.Equal(t, 45, highest)
+	assert.Equal(t, 112, count)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -0,0 +1,280 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day18
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day18Cmd represents the day18 command
+var Day18Cmd = &cobra.Command{
+	Use:   "day18",
+	Short: `Snailfish`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+// Number is a snailfish number: either a regular number or a pair of numbers.
+type Number struct {
+	Value       int
+	Left, Right *Number
+}
+
+func (n *Number) IsPair() bool {
+	return n.Left != nil
+}
+
+func ParseNumber(line string) (*Number, error) {
+	line = strings.TrimSpace(line)
+
+	number, rest, err := parseElement(line)
+	if err != nil {
+		return nil, err
+	}
+
+	if rest != "" {
+		return nil, fmt.Errorf("unexpected '%s' after snailfish number", rest)
+	}
+
+	return number, nil
+}
+
+func parseElement(s string) (*Number, string, error) {
+	if s == "" {
+		return nil, "", fmt.Errorf("unexpected end of snailfish number")
+	}
+
+	if s[0] != '[' {
+		value := 0
+		i := 0
+
+		for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+			value = value*10 + int(s[i]-'0')
+		}
+
+		if i == 0 {
+			return nil, "", fmt.Errorf("unexpected '%c' in snailfish number", s[0])
+		}
+
+		return &Number{Value: value}, s[i:], nil
+	}
+
+	left, rest, err := parseElement(s[1:])
+	if err != nil {
+		return nil, "", err
+	}
+
+	if rest == "" || rest[0] != ',' {
+		return nil, "", fmt.Errorf("expected ',' in snailfish pair")
+	}
+
+	right, rest, err := parseElement(rest[1:])
+	if err != nil {
+		return nil, "", err
+	}
+
+	if rest == "" || rest[0] != ']' {
+		return nil, "", fmt.Errorf("expected ']' in snailfish pair")
+	}
+
+	return &Number{Left: left, Right: right}, rest[1:], nil
+}
+
+func ParseHomework(fileContents string) ([]*Number, error) {
+	numbers := make([]*Number, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		n, err := ParseNumber(line)
+		if err != nil {
+			return nil, err
+		}
+
+		numbers = append(numbers, n)
+	}
+
+	return numbers, nil
+}
+
+func (n *Number) Clone() *Number {
+	if !n.IsPair() {
+		return &Number{Value: n.Value}
+	}
+
+	return &Number{Left: n.Left.Clone(), Right: n.Right.Clone()}
+}
+
+func (n *Number) Describe() string {
+	if !n.IsPair() {
+		return fmt.Sprintf("%d", n.Value)
+	}
+
+	return fmt.Sprintf("[%s,%s]", n.Left.Describe(), n.Right.Describe())
+}
+
+func (n *Number) Magnitude() int {
+	if !n.IsPair() {
+		return n.Value
+	}
+
+	return 3*n.Left.Magnitude() + 2*n.Right.Magnitude()
+}
+
+func (n *Number) addLeftmost(value int) {
+	for n.IsPair() {
+		n = n.Left
+	}
+
+	n.Value += value
+}
+
+func (n *Number) addRightmost(value int) {
+	for n.IsPair() {
+		n = n.Right
+	}
+
+	n.Value += value
+}
+
+// explode finds the leftmost pair nested inside four pairs and replaces it with 0.
+// The pair's values that still need a home to the left or right are returned to
+// the caller, which hands them to the nearest regular number on that side.
+func (n *Number) explode(depth int) (bool, int, int) {
+	if !n.IsPair() {
+		return false, 0, 0
+	}
+
+	if depth >= 4 && !n.Left.IsPair() && !n.Right.IsPair() {
+		left, right := n.Left.Value, n.Right.Value
+		*n = Number{}
+
+		return true, left, right
+	}
+
+	if exploded, left, right := n.Left.explode(depth + 1); exploded {
+		if right != 0 {
+			n.Right.addLeftmost(right)
+		}
+
+		return true, left, 0
+	}
+
+	if exploded, left, right := n.Right.explode(depth + 1); exploded {
+		if left != 0 {
+			n.Left.addRightmost(left)
+		}
+
+		return true, 0, right
+	}
+
+	return false, 0, 0
+}
+
+func (n *Number) split() bool {
+	if !n.IsPair() {
+		if n.Value < 10 {
+			return false
+		}
+
+		n.Left = &Number{Value: n.Value / 2}
+		n.Right = &Number{Value: (n.Value + 1) / 2}
+		n.Value = 0
+
+		return true
+	}
+
+	return n.Left.split() || n.Right.split()
+}
+
+func (n *Number) Reduce() {
+	for {
+		if exploded, _, _ := n.explode(0); exploded {
+			continue
+		}
+
+		if !n.split() {
+			return
+		}
+	}
+}
+
+// Add returns the reduced sum of two snailfish numbers without modifying either.
+func Add(a, b *Number) *Number {
+	sum := &Number{Left: a.Clone(), Right: b.Clone()}
+	sum.Reduce()
+
+	return sum
+}
+
+func Sum(numbers []*Number) (*Number, error) {
+	if len(numbers) == 0 {
+		return nil, fmt.Errorf("no snailfish numbers to add")
+	}
+
+	sum := numbers[0]
+
+	for _, n := range numbers[1:] {
+		sum = Add(sum, n)
+	}
+
+	return sum, nil
+}
+
+func LargestPairMagnitude(numbers []*Number) int {
+	largest := 0
+
+	for i := range numbers {
+		for j := range numbers {
+			if i != j {
+				largest = max(largest, Add(numbers[i], numbers[j]).Magnitude())
+			}
+		}
+	}
+
+	return largest
+}
+
+func day(fileContents string) error {
+	numbers, err := ParseHomework(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Add up all of the snailfish numbers from the homework assignment in the
+	// order they appear. What is the magnitude of the final sum?
+	sum, err := Sum(numbers)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(1, "Magnitude of the final sum", sum.Magnitude())
+
+	// Part 2: What is the largest magnitude of any sum of two different snailfish
+	// numbers from the homework assignment?
+	utilities.Answer(2, "Largest magnitude of two numbers", LargestPairMagnitude(numbers))
+
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day18
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleHomework = `[[[0,[5,8]],[[1,7],[9,6]]],[[4,[1,2]],[[1,4],2]]]
+[[[5,[2,8]],4],[5,[[9,9],0]]]
+[6,[[[6,2],[5,6]],[[7,6],[4,7]]]]
+[[[6,[0,7]],[0,9]],[4,[9,[9,0]]]]
+[[[7,[6,4]],[3,[1,3]]],[[[5,5],1],9]]
+[[6,[[7,3],[3,2]]],[[[3,8],[5,7]],4]]
+[[[[5,4],[7,7]],8],[[8,3],8]]
+[[9,3],[[9,9],[6,[4,9]]]]
+[[2,[[7,7],7]],[[5,8],[[9,3],[0,2]]]]
+[[[[5,2],5],[8,[3,7]]],[[5,[7,5]],[4,4]]]`
+
+func TestParseNumber(t *testing.T) {
+	n, err := ParseNumber("[[1,2],3]")
+	assert.NoError(t, err)
+	assert.Equal(t, &Number{Left: &Number{Left: &Number{Value: 1}, Right: &Number{Value: 2}}, Right: &Number{Value: 3}}, n)
+	assert.Equal(t, "[[1,2],3]", n.Describe())
+
+	for _, bad := range []string{"[1,2", "[1;2]", "[1,2]]", "[,2]", ""} {
+		_, err = ParseNumber(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	type testCase struct {
+		number   string
+		expected string
+	}
+
+	testCases := []testCase{
+		{number: "[[[[[9,8],1],2],3],4]", expected: "[[[[0,9],2],3],4]"},
+		{number: "[7,[6,[5,[4,[3,2]]]]]", expected: "[7,[6,[5,[7,0]]]]"},
+		{number: "[[6,[5,[4,[3,2]]]],1]", expected: "[[6,[5,[7,0]]],3]"},
+		{number: "[[3,[2,[1,[7,3]]]],[6,[5,[4,[3,2]]]]]", expected: "[[3,[2,[8,0]]],[9,[5,[7,0]]]]"},
+		{number: "[[[[[4,3],4],4],[7,[[8,4],9]]],[1,1]]", expected: "[[[[0,7],4],[[7,8],[6,0]]],[8,1]]"},
+	}
+
+	for _, test := range testCases {
+		n, err := ParseNumber(test.number)
+		assert.NoError(t, err)
+
+		n.Reduce()
+		assert.Equal(t, test.expected, n.Describe())
+	}
+}
+
+func TestSum(t *testing.T) {
+	numbers, err := ParseHomework(`[1,1]
+[2,2]
+[3,3]
+[4,4]
+[5,5]
+[6,6]`)
+	assert.NoError(t, err)
+
+	sum, err := Sum(numbers)
+	assert.NoError(t, err)
+	assert.Equal(t, "[[[[5,0],[7,4]],[5,5]],[6,6]]", sum.Describe())
+
+	numbers, err = ParseHomework(exampleHomework)
+	assert.NoError(t, err)
+
+	sum, err = Sum(numbers)
+	assert.NoError(t, err)
+	assert.Equal(t, "[[[[6,6],[7,6]],[[7,7],[7,0]]],[[[7,7],[7,7]],[[7,8],[9,9]]]]", sum.Describe())
+	assert.Equal(t, 4140, sum.Magnitude())
+
+	_, err = Sum(nil)
+	assert.Error(t, err)
+}
+
+func TestMagnitude(t *testing.T) {
+	type testCase struct {
+		number   string
+		expected int
+	}
+
+	testCases := []testCase{
+		{number: "[[1,2],[[3,4],5]]", expected: 143},
+		{number: "[[[[0,7],4],[[7,8],[6,0]]],[8,1]]", expected: 1384},
+		{number: "[[[[8,7],[7,7]],[[8,6],[7,7]]],[[[0,7],[6,6]],[8,7]]]", expected: 3488},
+	}
+
+	for _, test := range testCases {
+		n, err := ParseNumber(test.number)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, n.Magnitude())
+	}
+}
+
+func TestLargestPairMagnitude(t *testing.T) {
+	numbers, err := ParseHomework(exampleHomework)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3993, LargestPairMagnitude(numbers))
+	assert.Equal(t, "[[[0,[5,8]],[[1,7],[9,6]]],[[4,[1,2]],[[1,4],2]]]", numbers[0].Describe())
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
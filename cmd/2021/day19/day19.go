@@ -0,0 +1,246 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day19
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day19Cmd represents the day19 command
+var Day19Cmd = &cobra.Command{
+	Use:   "day19",
+	Short: `Beacon Scanner`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+// MinimumOverlap is how many beacons two scanners must agree on before their
+// relative position is trusted.
+const MinimumOverlap = 12
+
+type Vector3D struct {
+	X, Y, Z int
+}
+
+func (v Vector3D) Add(o Vector3D) Vector3D {
+	return Vector3D{X: v.X + o.X, Y: v.Y + o.Y, Z: v.Z + o.Z}
+}
+
+func (v Vector3D) Sub(o Vector3D) Vector3D {
+	return Vector3D{X: v.X - o.X, Y: v.Y - o.Y, Z: v.Z - o.Z}
+}
+
+func ManhattanDistance(a, b Vector3D) int {
+	return utilities.Abs(a.X-b.X) + utilities.Abs(a.Y-b.Y) + utilities.Abs(a.Z-b.Z)
+}
+
+type Rotation [3][3]int
+
+func (r Rotation) Apply(v Vector3D) Vector3D {
+	return Vector3D{
+		X: r[0][0]*v.X + r[0][1]*v.Y + r[0][2]*v.Z,
+		Y: r[1][0]*v.X + r[1][1]*v.Y + r[1][2]*v.Z,
+		Z: r[2][0]*v.X + r[2][1]*v.Y + r[2][2]*v.Z,
+	}
+}
+
+// Rotations returns the 24 orientations a scanner can have. They are the signed
+// permutation matrices with a determinant of +1; the other 24 are reflections.
+func Rotations() []Rotation {
+	permutations := [][3]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+	permutationSign := []int{1, -1, -1, 1, 1, -1}
+
+	rotations := make([]Rotation, 0, 24)
+
+	for i, p := range permutations {
+		for signs := 0; signs < 8; signs++ {
+			var r Rotation
+			determinant := permutationSign[i]
+
+			for row := 0; row < 3; row++ {
+				sign := 1
+				if signs&(1<<row) != 0 {
+					sign = -1
+				}
+
+				r[row][p[row]] = sign
+				determinant *= sign
+			}
+
+			if determinant == 1 {
+				rotations = append(rotations, r)
+			}
+		}
+	}
+
+	return rotations
+}
+
+type Scanner struct {
+	Beacons []Vector3D
+}
+
+func ParseScanners(fileContents string) ([]Scanner, error) {
+	scanners := make([]Scanner, 0)
+
+	for _, section := range strings.Split(strings.TrimSpace(fileContents), "\n\n") {
+		lines := strings.Split(strings.TrimSpace(section), "\n")
+
+		var id int
+		if _, err := fmt.Sscanf(lines[0], "--- scanner %d ---", &id); err != nil {
+			return nil, fmt.Errorf("invalid scanner header '%s'", lines[0])
+		}
+
+		scanner := Scanner{}
+
+		for _, line := range lines[1:] {
+			coordinates := utilities.ParseIntList(line)
+			if len(coordinates) != 3 {
+				return nil, fmt.Errorf("invalid beacon '%s'", line)
+			}
+
+			scanner.Beacons = append(scanner.Beacons, Vector3D{X: coordinates[0], Y: coordinates[1], Z: coordinates[2]})
+		}
+
+		scanners = append(scanners, scanner)
+	}
+
+	return scanners, nil
+}
+
+// Align tries to place the scanner relative to beacons whose positions are already
+// known. For every orientation, each pairing of a known beacon with a scanned one
+// votes for the scanner position that would make them the same beacon. If enough
+// votes agree, the scanner's beacons are returned translated into the known frame.
+func Align(known []Vector3D, scanner Scanner) ([]Vector3D, Vector3D, bool) {
+	for _, r := range Rotations() {
+		rotated := make([]Vector3D, len(scanner.Beacons))
+		for i, b := range scanner.Beacons {
+			rotated[i] = r.Apply(b)
+		}
+
+		votes := make(map[Vector3D]int)
+
+		for _, k := range known {
+			for _, b := range rotated {
+				offset := k.Sub(b)
+				votes[offset]++
+
+				if votes[offset] < MinimumOverlap {
+					continue
+				}
+
+				for i := range rotated {
+					rotated[i] = rotated[i].Add(offset)
+				}
+
+				return rotated, offset, true
+			}
+		}
+	}
+
+	return nil, Vector3D{}, false
+}
+
+// Assemble aligns every scanner to scanner 0, returning the set of distinct beacons
+// and each scanner's position.
+func Assemble(scanners []Scanner) (map[Vector3D]bool, []Vector3D, error) {
+	if len(scanners) == 0 {
+		return nil, nil, fmt.Errorf("no scanners")
+	}
+
+	aligned := make([][]Vector3D, len(scanners))
+	positions := make([]Vector3D, len(scanners))
+
+	aligned[0] = scanners[0].Beacons
+
+	// Each newly placed scanner is compared against the remaining unplaced ones.
+	queue := utilities.NewFIFO[int]()
+	queue.Push(0)
+
+	for !queue.IsEmpty() {
+		reference := queue.Pop()
+
+		for i := range scanners {
+			if aligned[i] != nil {
+				continue
+			}
+
+			if beacons, position, ok := Align(aligned[reference], scanners[i]); ok {
+				aligned[i] = beacons
+				positions[i] = position
+				queue.Push(i)
+			}
+		}
+	}
+
+	beacons := make(map[Vector3D]bool)
+
+	for i, a := range aligned {
+		if a == nil {
+			return nil, nil, fmt.Errorf("scanner %d could not be aligned", i)
+		}
+
+		for _, b := range a {
+			beacons[b] = true
+		}
+	}
+
+	return beacons, positions, nil
+}
+
+func LargestScannerDistance(positions []Vector3D) int {
+	largest := 0
+
+	for i := range positions {
+		for j := i + 1; j < len(positions); j++ {
+			largest = max(largest, ManhattanDistance(positions[i], positions[j]))
+		}
+	}
+
+	return largest
+}
+
+func day(fileContents string) error {
+	scanners, err := ParseScanners(fileContents)
+	if err != nil {
+		return err
+	}
+
+	beacons, positions, err := Assemble(scanners)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Assemble the full map of beacons. How many beacons are there?
+	utilities.Answer(1, "Beacons", len(beacons))
+
+	// Part 2: What is the largest Manhattan distance between any two scanners?
+	utilities.Answer(2, "Largest distance between scanners", LargestScannerDistance(positions))
+
+	return nil
+}
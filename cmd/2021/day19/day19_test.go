@@ -0,0 +1,123 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day19
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotations(t *testing.T) {
+	rotations := Rotations()
+	assert.Len(t, rotations, 24)
+
+	v := Vector3D{X: 1, Y: 2, Z: 3}
+	seen := make(map[Vector3D]bool)
+
+	for _, r := range rotations {
+		seen[r.Apply(v)] = true
+	}
+
+	assert.Len(t, seen, 24)
+
+	// A rotation keeps the handedness of the axes.
+	for _, r := range rotations {
+		x := r.Apply(Vector3D{X: 1})
+		y := r.Apply(Vector3D{Y: 1})
+		z := r.Apply(Vector3D{Z: 1})
+
+		cross := Vector3D{X: x.Y*y.Z - x.Z*y.Y, Y: x.Z*y.X - x.X*y.Z, Z: x.X*y.Y - x.Y*y.X}
+		assert.Equal(t, z, cross)
+	}
+}
+
+func TestParseScanners(t *testing.T) {
+	scanners, err := ParseScanners(`--- scanner 0 ---
+404,-588,-901
+528,-643,409
+
+--- scanner 1 ---
+686,422,578`)
+	assert.NoError(t, err)
+	assert.Equal(t, []Scanner{
+		{Beacons: []Vector3D{{404, -588, -901}, {528, -643, 409}}},
+		{Beacons: []Vector3D{{686, 422, 578}}},
+	}, scanners)
+
+	_, err = ParseScanners("--- scanner 0 ---\n404,-588")
+	assert.Error(t, err)
+
+	_, err = ParseScanners("scanner 0\n404,-588,-901")
+	assert.Error(t, err)
+}
+
+// generateReport places beacons at random and reports what each scanner sees from
+// its position and orientation, as the puzzle input would.
+func generateReport(seed int64, positions []Vector3D, beaconCount int) (string, int) {
+	random := rand.New(rand.NewSource(seed))
+	rotations := Rotations()
+
+	beacons := make(map[Vector3D]bool)
+	for len(beacons) < beaconCount {
+		beacons[Vector3D{X: random.Intn(4000) - 1000, Y: random.Intn(2000) - 1000, Z: random.Intn(2000) - 1000}] = true
+	}
+
+	visible := make(map[Vector3D]bool)
+	sections := make([]string, 0, len(positions))
+
+	for i, p := range positions {
+		r := rotations[random.Intn(len(rotations))]
+		lines := []string{fmt.Sprintf("--- scanner %d ---", i)}
+
+		for b := range beacons {
+			relative := b.Sub(p)
+			if utilities.Abs(relative.X) > 1000 || utilities.Abs(relative.Y) > 1000 || utilities.Abs(relative.Z) > 1000 {
+				continue
+			}
+
+			// Scanner 0 defines the frame everything else is aligned to.
+			if i != 0 {
+				relative = r.Apply(relative)
+			}
+
+			visible[b] = true
+			lines = append(lines, fmt.Sprintf("%d,%d,%d", relative.X, relative.Y, relative.Z))
+		}
+
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+
+	return strings.Join(sections, "\n\n"), len(visible)
+}
+
+func TestAssemble(t *testing.T) {
+	positions := []Vector3D{{0, 0, 0}, {600, 100, -50}, {1200, -80, 40}, {1900, 30, 90}}
+
+	report, visible := generateReport(2021, positions, 120)
+
+	scanners, err := ParseScanners(report)
+	assert.NoError(t, err)
+
+	beacons, found, err := Assemble(scanners)
+	assert.NoError(t, err)
+	assert.Len(t, beacons, visible)
+	assert.Equal(t, positions, found)
+	assert.Equal(t, 1900+30+90, LargestScannerDistance(found))
+}
+
+func TestAssembleDisconnected(t *testing.T) {
+	report, _ := generateReport(7, []Vector3D{{0, 0, 0}, {2500, 0, 0}}, 120)
+
+	scanners, err := ParseScanners(report)
+	assert.NoError(t, err)
+
+	_, _, err = Assemble(scanners)
+	assert.Error(t, err)
+}
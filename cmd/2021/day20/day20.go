@@ -0,0 +1,234 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day20
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day20Cmd represents the day20 command
+var Day20Cmd = &cobra.Command{
+	Use:   "day20",
+	Short: `Trench Map`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const AlgorithmLength = 512
+
+type Image struct {
+	Bounds utilities.Size2D
+	Pixels [][]bool
+	// Background is the value of every pixel outside Bounds, which stretches out
+	// forever.
+	Background bool
+}
+
+type Enhancer struct {
+	Algorithm [AlgorithmLength]bool
+	Image     *Image
+}
+
+func parsePixel(c rune) (bool, error) {
+	switch c {
+	case '#':
+		return true, nil
+	case '.':
+		return false, nil
+	}
+
+	return false, fmt.Errorf("invalid pixel '%c'", c)
+}
+
+func ParseEnhancer(fileContents string) (*Enhancer, error) {
+	sections := strings.Split(strings.TrimSpace(fileContents), "\n\n")
+	if len(sections) != 2 {
+		return nil, fmt.Errorf("expected algorithm and image separated by a blank line")
+	}
+
+	algorithm := strings.Join(strings.Fields(sections[0]), "")
+	if len(algorithm) != AlgorithmLength {
+		return nil, fmt.Errorf("algorithm has %d entries, expected %d", len(algorithm), AlgorithmLength)
+	}
+
+	enhancer := &Enhancer{Image: &Image{}}
+
+	for i, c := range algorithm {
+		lit, err := parsePixel(c)
+		if err != nil {
+			return nil, err
+		}
+
+		enhancer.Algorithm[i] = lit
+	}
+
+	rows := strings.Split(sections[1], "\n")
+
+	for y, row := range rows {
+		row = strings.TrimSpace(row)
+		if y > 0 && len(row) != len(enhancer.Image.Pixels[0]) {
+			return nil, fmt.Errorf("image row %d has length %d, expected %d", y, len(row), len(enhancer.Image.Pixels[0]))
+		}
+
+		pixels := make([]bool, 0, len(row))
+
+		for _, c := range row {
+			lit, err := parsePixel(c)
+			if err != nil {
+				return nil, err
+			}
+
+			pixels = append(pixels, lit)
+		}
+
+		enhancer.Image.Pixels = append(enhancer.Image.Pixels, pixels)
+	}
+
+	enhancer.Image.Bounds = utilities.NewSize2D(len(enhancer.Image.Pixels[0]), len(enhancer.Image.Pixels))
+
+	return enhancer, nil
+}
+
+func (i *Image) Get(x, y int) bool {
+	if x < 0 || x >= i.Bounds.Width || y < 0 || y >= i.Bounds.Height {
+		return i.Background
+	}
+
+	return i.Pixels[y][x]
+}
+
+// Enhance applies the algorithm once. The image grows by one pixel on every side,
+// since those are the only pixels outside it that can see into it. Everything
+// further out sees nine background pixels, so the new background is the first or
+// last algorithm entry.
+func (e *Enhancer) Enhance() {
+	image := e.Image
+	enhanced := &Image{Bounds: utilities.NewSize2D(image.Bounds.Width+2, image.Bounds.Height+2)}
+
+	for y := 0; y < enhanced.Bounds.Height; y++ {
+		row := make([]bool, enhanced.Bounds.Width)
+
+		for x := 0; x < enhanced.Bounds.Width; x++ {
+			index := 0
+
+			for dy := -1; dy <= 1; dy++ {
+				for dx := -1; dx <= 1; dx++ {
+					index <<= 1
+					if image.Get(x-1+dx, y-1+dy) {
+						index |= 1
+					}
+				}
+			}
+
+			row[x] = e.Algorithm[index]
+		}
+
+		enhanced.Pixels = append(enhanced.Pixels, row)
+	}
+
+	if image.Background {
+		enhanced.Background = e.Algorithm[AlgorithmLength-1]
+	} else {
+		enhanced.Background = e.Algorithm[0]
+	}
+
+	e.Image = enhanced
+}
+
+func (i *Image) LitPixels() (int, error) {
+	if i.Background {
+		return 0, fmt.Errorf("infinitely many pixels are lit")
+	}
+
+	count := 0
+
+	for _, row := range i.Pixels {
+		for _, lit := range row {
+			if lit {
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
+
+func (i *Image) Describe() string {
+	rows := make([]string, 0, i.Bounds.Height)
+
+	for _, row := range i.Pixels {
+		var b strings.Builder
+
+		for _, lit := range row {
+			if lit {
+				b.WriteRune('#')
+			} else {
+				b.WriteRune('.')
+			}
+		}
+
+		rows = append(rows, b.String())
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func (e *Enhancer) LitPixelsAfter(steps int) (int, error) {
+	for s := 0; s < steps; s++ {
+		e.Enhance()
+	}
+
+	return e.Image.LitPixels()
+}
+
+func day(fileContents string) error {
+	enhancer, err := ParseEnhancer(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Start with the original input image and apply the image enhancement
+	// algorithm twice, being careful to account for the infinite size of the images.
+	// How many pixels are lit in the resulting image?
+	lit, err := enhancer.LitPixelsAfter(2)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(1, "Lit pixels after 2 enhancements", lit)
+
+	// Part 2: Start again with the original input image and apply the image
+	// enhancement algorithm 50 times. How many pixels are lit in the resulting image?
+	lit, err = enhancer.LitPixelsAfter(48)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(2, "Lit pixels after 50 enhancements", lit)
+
+	return nil
+}
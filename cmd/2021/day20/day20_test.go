@@ -0,0 +1,100 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day20
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/stretchr/testify/assert"
+)
+
+// makeAlgorithm builds an algorithm that lights a pixel whenever lit reports true
+// for its 9 bit index.
+func makeAlgorithm(lit func(index int) bool) string {
+	var b strings.Builder
+
+	for i := 0; i < AlgorithmLength; i++ {
+		if lit(i) {
+			b.WriteRune('#')
+		} else {
+			b.WriteRune('.')
+		}
+	}
+
+	return b.String()
+}
+
+func TestParseEnhancer(t *testing.T) {
+	algorithm := makeAlgorithm(func(index int) bool { return index%3 == 0 })
+
+	enhancer, err := ParseEnhancer(algorithm + "\n\n#..\n.#.\n..#")
+	assert.NoError(t, err)
+	assert.True(t, enhancer.Algorithm[0])
+	assert.False(t, enhancer.Algorithm[1])
+	assert.Equal(t, utilities.NewSize2D(3, 3), enhancer.Image.Bounds)
+	assert.Equal(t, "#..\n.#.\n..#", enhancer.Image.Describe())
+
+	_, err = ParseEnhancer(algorithm[1:] + "\n\n#..")
+	assert.Error(t, err)
+
+	_, err = ParseEnhancer(algorithm + "\n\n#..\n.#")
+	assert.Error(t, err)
+
+	_, err = ParseEnhancer(algorithm + "\n\n#x.")
+	assert.Error(t, err)
+}
+
+func TestEnhanceIdentity(t *testing.T) {
+	// Bit 4 of the index is the pixel itself.
+	identity := makeAlgorithm(func(index int) bool { return index&(1<<4) != 0 })
+
+	enhancer, err := ParseEnhancer(identity + "\n\n#..#.\n#....\n##..#\n..#..\n..###")
+	assert.NoError(t, err)
+
+	lit, err := enhancer.LitPixelsAfter(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, lit)
+	assert.Equal(t, 9, enhancer.Image.Bounds.Width)
+}
+
+func TestEnhanceGrow(t *testing.T) {
+	// Any lit neighbour lights the pixel, so a single pixel grows into a square.
+	grow := makeAlgorithm(func(index int) bool { return index != 0 })
+
+	enhancer, err := ParseEnhancer(grow + "\n\n#")
+	assert.NoError(t, err)
+
+	enhancer.Enhance()
+	assert.Equal(t, "###\n###\n###", enhancer.Image.Describe())
+
+	lit, err := enhancer.LitPixelsAfter(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 25, lit)
+}
+
+func TestEnhanceFlashingBackground(t *testing.T) {
+	// An empty neighbourhood lights the pixel and a full one darkens it, so the
+	// infinite background flips on every step. A pixel alone in its neighbourhood
+	// stays lit.
+	flash := makeAlgorithm(func(index int) bool { return index == 0 || index == 1<<4 })
+
+	enhancer, err := ParseEnhancer(flash + "\n\n.....\n.....\n..#..\n.....\n.....")
+	assert.NoError(t, err)
+
+	enhancer.Enhance()
+	assert.True(t, enhancer.Image.Background)
+
+	_, err = enhancer.Image.LitPixels()
+	assert.Error(t, err)
+
+	enhancer.Enhance()
+	assert.False(t, enhancer.Image.Background)
+
+	lit, err := enhancer.Image.LitPixels()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, lit)
+}
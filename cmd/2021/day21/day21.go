@@ -0,0 +1,169 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day21
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day21Cmd represents the day21 command
+var Day21Cmd = &cobra.Command{
+	Use:   "day21",
+	Short: `Dirac Dice`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	BoardSpaces          = 10
+	PracticeWinningScore = 1000
+	DiracWinningScore    = 21
+)
+
+type Player struct {
+	Position int
+	Score    int
+}
+
+func ParseStartingPositions(fileContents string) ([2]int, error) {
+	lines := strings.Split(strings.TrimSpace(fileContents), "\n")
+	if len(lines) != 2 {
+		return [2]int{}, fmt.Errorf("expected 2 players, found %d", len(lines))
+	}
+
+	var positions [2]int
+
+	for i, line := range lines {
+		var player int
+
+		if _, err := fmt.Sscanf(strings.TrimSpace(line), "Player %d starting position: %d", &player, &positions[i]); err != nil {
+			return [2]int{}, fmt.Errorf("invalid starting position '%s'", line)
+		}
+
+		if positions[i] < 1 || positions[i] > BoardSpaces {
+			return [2]int{}, fmt.Errorf("starting position %d is off the board", positions[i])
+		}
+	}
+
+	return positions, nil
+}
+
+func move(position, spaces int) int {
+	return (position+spaces-1)%BoardSpaces + 1
+}
+
+// PlayPractice plays with the deterministic 100-sided die and returns the losing
+// score multiplied by the number of times the die was rolled.
+func PlayPractice(positions [2]int) int {
+	players := [2]Player{{Position: positions[0]}, {Position: positions[1]}}
+	rolls := 0
+
+	for turn := 0; ; turn = 1 - turn {
+		total := 0
+
+		for i := 0; i < 3; i++ {
+			total += rolls%100 + 1
+			rolls++
+		}
+
+		p := &players[turn]
+		p.Position = move(p.Position, total)
+		p.Score += p.Position
+
+		if p.Score >= PracticeWinningScore {
+			return players[1-turn].Score * rolls
+		}
+	}
+}
+
+// diracRollFrequencies counts how many of the 27 universes produced by three rolls
+// of the Dirac die share each total.
+var diracRollFrequencies = map[int]int{3: 1, 4: 3, 5: 6, 6: 7, 7: 6, 8: 3, 9: 1}
+
+// PlayDirac counts the universes each player wins in. The game state is small, so
+// the wins from each state of the player about to move and their opponent are
+// memoized.
+func PlayDirac(positions [2]int) [2]int {
+	type state struct {
+		Current, Other Player
+	}
+
+	cache := make(map[state][2]int)
+
+	var wins func(s state) [2]int
+
+	wins = func(s state) [2]int {
+		if w, ok := cache[s]; ok {
+			return w
+		}
+
+		var total [2]int
+
+		for roll, frequency := range diracRollFrequencies {
+			current := s.Current
+			current.Position = move(current.Position, roll)
+			current.Score += current.Position
+
+			if current.Score >= DiracWinningScore {
+				total[0] += frequency
+				continue
+			}
+
+			// The players swap roles for the next turn.
+			next := wins(state{Current: s.Other, Other: current})
+			total[0] += next[1] * frequency
+			total[1] += next[0] * frequency
+		}
+
+		cache[s] = total
+
+		return total
+	}
+
+	return wins(state{Current: Player{Position: positions[0]}, Other: Player{Position: positions[1]}})
+}
+
+func day(fileContents string) error {
+	positions, err := ParseStartingPositions(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Play a practice game using the deterministic 100-sided die. The moment
+	// either player wins, what do you get if you multiply the score of the losing
+	// player by the number of times the die was rolled during the game?
+	utilities.Answer(1, "Practice game result", PlayPractice(positions))
+
+	// Part 2: Using your given starting positions, determine every possible outcome.
+	// Find the player that wins in more universes; in how many universes does that
+	// player win?
+	wins := PlayDirac(positions)
+
+	utilities.Answer(2, "Universes won by the best player", max(wins[0], wins[1]))
+
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day21
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleStartingPositions = `Player 1 starting position: 4
+Player 2 starting position: 8`
+
+func TestParseStartingPositions(t *testing.T) {
+	positions, err := ParseStartingPositions(exampleStartingPositions)
+	assert.NoError(t, err)
+	assert.Equal(t, [2]int{4, 8}, positions)
+
+	_, err = ParseStartingPositions("Player 1 starting position: 4")
+	assert.Error(t, err)
+
+	_, err = ParseStartingPositions("Player 1 starting position: 4\nPlayer 2 starting position: 11")
+	assert.Error(t, err)
+}
+
+func TestPlayPractice(t *testing.T) {
+	assert.Equal(t, 739785, PlayPractice([2]int{4, 8}))
+}
+
+func TestDiracRollFrequencies(t *testing.T) {
+	frequencies := make(map[int]int)
+
+	for a := 1; a <= 3; a++ {
+		for b := 1; b <= 3; b++ {
+			for c := 1; c <= 3; c++ {
+				frequencies[a+b+c]++
+			}
+		}
+	}
+
+	assert.Equal(t, frequencies, diracRollFrequencies)
+}
+
+func TestPlayDirac(t *testing.T) {
+	assert.Equal(t, [2]int{444356092776315, 341960390180808}, PlayDirac([2]int{4, 8}))
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -0,0 +1,179 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day22
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day22Cmd represents the day22 command
+var Day22Cmd = &cobra.Command{
+	Use:   "day22",
+	Short: `Reactor Reboot`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const InitializationLimit = 50
+
+type Cuboid struct {
+	MinimumX, MaximumX int
+	MinimumY, MaximumY int
+	MinimumZ, MaximumZ int
+}
+
+func (c Cuboid) Volume() int {
+	return (c.MaximumX - c.MinimumX + 1) * (c.MaximumY - c.MinimumY + 1) * (c.MaximumZ - c.MinimumZ + 1)
+}
+
+func (c Cuboid) Intersect(o Cuboid) (Cuboid, bool) {
+	i := Cuboid{
+		MinimumX: max(c.MinimumX, o.MinimumX), MaximumX: min(c.MaximumX, o.MaximumX),
+		MinimumY: max(c.MinimumY, o.MinimumY), MaximumY: min(c.MaximumY, o.MaximumY),
+		MinimumZ: max(c.MinimumZ, o.MinimumZ), MaximumZ: min(c.MaximumZ, o.MaximumZ),
+	}
+
+	if i.MinimumX > i.MaximumX || i.MinimumY > i.MaximumY || i.MinimumZ > i.MaximumZ {
+		return Cuboid{}, false
+	}
+
+	return i, true
+}
+
+type Step struct {
+	On     bool
+	Cuboid Cuboid
+}
+
+func ParseStep(line string) (Step, error) {
+	stepRE := regexp.MustCompile(`^(on|off) x=(-?[0-9]+)\.\.(-?[0-9]+),y=(-?[0-9]+)\.\.(-?[0-9]+),z=(-?[0-9]+)\.\.(-?[0-9]+)$`)
+
+	matches := stepRE.FindStringSubmatch(strings.TrimSpace(line))
+	if matches == nil {
+		return Step{}, fmt.Errorf("invalid reboot step '%s'", line)
+	}
+
+	numbers := utilities.ParseIntList(strings.Join(matches[2:], " "))
+
+	step := Step{
+		On: matches[1] == "on",
+		Cuboid: Cuboid{
+			MinimumX: numbers[0], MaximumX: numbers[1],
+			MinimumY: numbers[2], MaximumY: numbers[3],
+			MinimumZ: numbers[4], MaximumZ: numbers[5],
+		},
+	}
+
+	if _, ok := step.Cuboid.Intersect(step.Cuboid); !ok {
+		return Step{}, fmt.Errorf("reboot step '%s' has an empty cuboid", line)
+	}
+
+	return step, nil
+}
+
+func ParseSteps(fileContents string) ([]Step, error) {
+	steps := make([]Step, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		s, err := ParseStep(line)
+		if err != nil {
+			return nil, err
+		}
+
+		steps = append(steps, s)
+	}
+
+	return steps, nil
+}
+
+// CubesOn counts the cubes left on after every step. It keeps a list of signed
+// cuboids whose volumes sum to the answer: each new step cancels its overlap with
+// every cuboid already in the list, then "on" steps add themselves.
+func CubesOn(steps []Step) int {
+	type signedCuboid struct {
+		Cuboid Cuboid
+		Sign   int
+	}
+
+	cuboids := make([]signedCuboid, 0)
+
+	for _, s := range steps {
+		for _, existing := range cuboids {
+			if overlap, ok := existing.Cuboid.Intersect(s.Cuboid); ok {
+				cuboids = append(cuboids, signedCuboid{Cuboid: overlap, Sign: -existing.Sign})
+			}
+		}
+
+		if s.On {
+			cuboids = append(cuboids, signedCuboid{Cuboid: s.Cuboid, Sign: 1})
+		}
+	}
+
+	total := 0
+	for _, c := range cuboids {
+		total += c.Sign * c.Cuboid.Volume()
+	}
+
+	return total
+}
+
+// InitializationCubesOn only considers cubes within the initialization region.
+func InitializationCubesOn(steps []Step) int {
+	region := Cuboid{
+		MinimumX: -InitializationLimit, MaximumX: InitializationLimit,
+		MinimumY: -InitializationLimit, MaximumY: InitializationLimit,
+		MinimumZ: -InitializationLimit, MaximumZ: InitializationLimit,
+	}
+
+	clipped := make([]Step, 0, len(steps))
+
+	for _, s := range steps {
+		if c, ok := s.Cuboid.Intersect(region); ok {
+			clipped = append(clipped, Step{On: s.On, Cuboid: c})
+		}
+	}
+
+	return CubesOn(clipped)
+}
+
+func day(fileContents string) error {
+	steps, err := ParseSteps(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Execute the reboot steps. Afterward, considering only cubes in the region
+	// x=-50..50,y=-50..50,z=-50..50, how many cubes are on?
+	utilities.Answer(1, "Cubes on in the initialization region", InitializationCubesOn(steps))
+
+	// Part 2: Starting again with all cubes off, run all of the reboot steps. Afterward,
+	// considering all cubes, how many cubes are on?
+	utilities.Answer(2, "Cubes on", CubesOn(steps))
+
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day22
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleSteps = `on x=10..12,y=10..12,z=10..12
+on x=11..13,y=11..13,z=11..13
+off x=9..11,y=9..11,z=9..11
+on x=10..10,y=10..10,z=10..10`
+
+func TestParseStep(t *testing.T) {
+	s, err := ParseStep("off x=-54112..-39298,y=-85059..-49293,z=-27449..7877")
+	assert.NoError(t, err)
+	assert.Equal(t, Step{On: false, Cuboid: Cuboid{-54112, -39298, -85059, -49293, -27449, 7877}}, s)
+
+	_, err = ParseStep("toggle x=1..2,y=1..2,z=1..2")
+	assert.Error(t, err)
+
+	_, err = ParseStep("on x=2..1,y=1..2,z=1..2")
+	assert.Error(t, err)
+}
+
+func TestCubesOn(t *testing.T) {
+	steps, err := ParseSteps(exampleSteps)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 27, CubesOn(steps[:1]))
+	assert.Equal(t, 27+19, CubesOn(steps[:2]))
+	assert.Equal(t, 27+19-8, CubesOn(steps[:3]))
+	assert.Equal(t, 39, CubesOn(steps))
+}
+
+func TestInitializationCubesOn(t *testing.T) {
+	steps, err := ParseSteps(exampleSteps + "\non x=-100..100,y=0..0,z=0..0\noff x=60..70,y=60..70,z=60..70")
+	assert.NoError(t, err)
+
+	// The new line only adds 100 cubes inside the region and misses the
+	// existing cubes.
+	assert.Equal(t, 39+101, InitializationCubesOn(steps))
+	assert.Equal(t, 39+201, CubesOn(steps))
+}
+
+func TestCubesOnBruteForce(t *testing.T) {
+	random := rand.New(rand.NewSource(22))
+
+	for trial := 0; trial < 20; trial++ {
+		steps := make([]Step, 0)
+		grid := make(map[[3]int]bool)
+
+		for i := 0; i < 12; i++ {
+			c := Cuboid{}
+			c.MinimumX, c.MinimumY, c.MinimumZ = random.Intn(10)-5, random.Intn(10)-5, random.Intn(10)-5
+			c.MaximumX, c.MaximumY, c.MaximumZ = c.MinimumX+random.Intn(6), c.MinimumY+random.Intn(6), c.MinimumZ+random.Intn(6)
+
+			s := Step{On: random.Intn(3) != 0, Cuboid: c}
+			steps = append(steps, s)
+
+			for x := c.MinimumX; x <= c.MaximumX; x++ {
+				for y := c.MinimumY; y <= c.MaximumY; y++ {
+					for z := c.MinimumZ; z <= c.MaximumZ; z++ {
+						if s.On {
+							grid[[3]int{x, y, z}] = true
+						} else {
+							delete(grid, [3]int{x, y, z})
+						}
+					}
+				}
+			}
+		}
+
+		assert.Equal(t, len(grid), CubesOn(steps))
+	}
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
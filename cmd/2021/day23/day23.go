@@ -0,0 +1,291 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day23
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day23Cmd represents the day23 command
+var Day23Cmd = &cobra.Command{
+	Use:   "day23",
+	Short: `Amphipod`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	HallwayLength = 11
+	RoomCount     = 4
+	Empty         = '.'
+)
+
+// UnfoldedRows are the two lines hidden in the folded diagram.
+var UnfoldedRows = []string{"  #D#C#B#A#", "  #D#B#A#C#"}
+
+var stepEnergy = map[byte]int{'A': 1, 'B': 10, 'C': 100, 'D': 1000}
+
+// roomEntrance is the hallway position directly above each room. Amphipods never
+// stop there.
+func roomEntrance(room int) int {
+	return 2 + 2*room
+}
+
+// Burrow is a diagram as a string: the hallway followed by each room from top to
+// bottom. Keeping it a string makes it usable as a map key.
+type Burrow string
+
+func ParseBurrow(fileContents string) (Burrow, error) {
+	lines := strings.Split(strings.TrimRight(fileContents, "\n"), "\n")
+	if len(lines) < 5 {
+		return "", fmt.Errorf("burrow diagram is too short")
+	}
+
+	if len(lines[1]) < HallwayLength+2 {
+		return "", fmt.Errorf("invalid hallway '%s'", lines[1])
+	}
+
+	hallway := lines[1][1 : HallwayLength+1]
+	if strings.Trim(hallway, string(Empty)) != "" {
+		return "", fmt.Errorf("hallway must start empty")
+	}
+
+	roomRows := lines[2 : len(lines)-1]
+	rooms := make([][]byte, RoomCount)
+
+	for _, row := range roomRows {
+		for r := 0; r < RoomCount; r++ {
+			column := roomEntrance(r) + 1
+			if column >= len(row) || stepEnergy[row[column]] == 0 {
+				return "", fmt.Errorf("invalid room row '%s'", row)
+			}
+
+			rooms[r] = append(rooms[r], row[column])
+		}
+	}
+
+	burrow := hallway
+	for _, room := range rooms {
+		burrow += string(room)
+	}
+
+	counts := make(map[rune]int)
+	for _, c := range burrow[HallwayLength:] {
+		counts[c]++
+	}
+
+	for c := range stepEnergy {
+		if counts[rune(c)] != len(roomRows) {
+			return "", fmt.Errorf("expected %d amphipods of type %c, found %d", len(roomRows), c, counts[rune(c)])
+		}
+	}
+
+	return Burrow(burrow), nil
+}
+
+// Unfold inserts the hidden rows after the first row of each room.
+func Unfold(fileContents string) string {
+	lines := strings.Split(strings.TrimRight(fileContents, "\n"), "\n")
+
+	unfolded := append([]string{}, lines[:3]...)
+	unfolded = append(unfolded, UnfoldedRows...)
+	unfolded = append(unfolded, lines[3:]...)
+
+	return strings.Join(unfolded, "\n")
+}
+
+func (b Burrow) depth() int {
+	return (len(b) - HallwayLength) / RoomCount
+}
+
+func (b Burrow) room(r int) string {
+	d := b.depth()
+	start := HallwayLength + r*d
+
+	return string(b[start : start+d])
+}
+
+func (b Burrow) set(i int, c byte) Burrow {
+	bytes := []byte(b)
+	bytes[i] = c
+
+	return Burrow(bytes)
+}
+
+func (b Burrow) IsOrganized() bool {
+	for r := 0; r < RoomCount; r++ {
+		if strings.Trim(b.room(r), string(rune('A'+r))) != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hallwayClear reports whether every hallway position between from and to is
+// empty, not counting from itself.
+func (b Burrow) hallwayClear(from, to int) bool {
+	step := 1
+	if to < from {
+		step = -1
+	}
+
+	for p := from + step; p != to+step; p += step {
+		if b[p] != Empty {
+			return false
+		}
+	}
+
+	return true
+}
+
+type move struct {
+	Burrow Burrow
+	Energy int
+}
+
+// moves lists every burrow reachable with a single legal move: an amphipod leaving
+// a room for a hallway position, or an amphipod in the hallway entering its own
+// room once that room only holds its own type.
+func (b Burrow) moves() []move {
+	d := b.depth()
+	moves := make([]move, 0)
+
+	for h := 0; h < HallwayLength; h++ {
+		c := b[h]
+		if c == Empty {
+			continue
+		}
+
+		r := int(c - 'A')
+		room := b.room(r)
+
+		if strings.Trim(room, string(Empty)+string(c)) != "" {
+			continue
+		}
+
+		entrance := roomEntrance(r)
+		if !b.hallwayClear(h, entrance) {
+			continue
+		}
+
+		slot := strings.LastIndexByte(room, Empty)
+		steps := utilities.Abs(h-entrance) + slot + 1
+
+		next := b.set(h, Empty).set(HallwayLength+r*d+slot, c)
+		moves = append(moves, move{Burrow: next, Energy: steps * stepEnergy[c]})
+	}
+
+	for r := 0; r < RoomCount; r++ {
+		room := b.room(r)
+		home := byte('A' + r)
+
+		slot := strings.IndexFunc(room, func(c rune) bool { return c != Empty })
+		if slot < 0 || strings.Trim(room[slot:], string(home)) == "" {
+			continue
+		}
+
+		c := room[slot]
+		entrance := roomEntrance(r)
+
+		for h := 0; h < HallwayLength; h++ {
+			if h == 2 || h == 4 || h == 6 || h == 8 || !b.hallwayClear(entrance, h) || b[entrance] != Empty {
+				continue
+			}
+
+			steps := utilities.Abs(h-entrance) + slot + 1
+
+			next := b.set(HallwayLength+r*d+slot, Empty).set(h, c)
+			moves = append(moves, move{Burrow: next, Energy: steps * stepEnergy[c]})
+		}
+	}
+
+	return moves
+}
+
+// LeastEnergy runs Dijkstra's algorithm over burrow states.
+func (b Burrow) LeastEnergy() (int, error) {
+	best := map[Burrow]int{b: 0}
+
+	queue := utilities.NewPriorityQueue(func(a, b move) bool {
+		return a.Energy < b.Energy
+	})
+	queue.Push(move{Burrow: b, Energy: 0})
+
+	for !queue.IsEmpty() {
+		current := queue.Pop()
+		if current.Energy > best[current.Burrow] {
+			continue
+		}
+
+		if current.Burrow.IsOrganized() {
+			return current.Energy, nil
+		}
+
+		for _, m := range current.Burrow.moves() {
+			energy := current.Energy + m.Energy
+
+			if e, ok := best[m.Burrow]; !ok || energy < e {
+				best[m.Burrow] = energy
+				queue.Push(move{Burrow: m.Burrow, Energy: energy})
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("amphipods cannot be organized")
+}
+
+func day(fileContents string) error {
+	burrow, err := ParseBurrow(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: What is the least energy required to organize the amphipods?
+	energy, err := burrow.LeastEnergy()
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(1, "Least energy", energy)
+
+	// Part 2: Using the initial configuration from the full diagram, what is the least
+	// energy required to organize the amphipods?
+	burrow, err = ParseBurrow(Unfold(fileContents))
+	if err != nil {
+		return err
+	}
+
+	energy, err = burrow.LeastEnergy()
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(2, "Least energy with the full diagram", energy)
+
+	return nil
+}
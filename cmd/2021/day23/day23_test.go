@@ -0,0 +1,81 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day23
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleBurrow = `#############
+#...........#
+###B#C#B#D###
+  #A#D#C#A#
+  #########`
+
+func TestParseBurrow(t *testing.T) {
+	burrow, err := ParseBurrow(exampleBurrow)
+	assert.NoError(t, err)
+	assert.Equal(t, Burrow("...........BACDBCDA"), burrow)
+	assert.Equal(t, "BA", burrow.room(0))
+	assert.Equal(t, "DA", burrow.room(3))
+	assert.False(t, burrow.IsOrganized())
+
+	_, err = ParseBurrow(`#############
+#...........#
+###B#C#B#D###
+  #A#D#C#C#
+  #########`)
+	assert.Error(t, err)
+
+	_, err = ParseBurrow(`#############
+#...........#
+###B#C#B#X###
+  #A#D#C#A#
+  #########`)
+	assert.Error(t, err)
+}
+
+func TestUnfold(t *testing.T) {
+	burrow, err := ParseBurrow(Unfold(exampleBurrow))
+	assert.NoError(t, err)
+	assert.Equal(t, "BDDA", burrow.room(0))
+	assert.Equal(t, "DACA", burrow.room(3))
+}
+
+func TestMoves(t *testing.T) {
+	// Only the A needs to move home, and nothing else can move.
+	burrow := Burrow("A.........." + ".ABBCCDD")
+	moves := burrow.moves()
+
+	assert.Equal(t, []move{{Burrow: Burrow("...........AABBCCDD"), Energy: 3}}, moves)
+	assert.True(t, moves[0].Burrow.IsOrganized())
+
+	// An amphipod can't walk past another in the hallway.
+	burrow = Burrow("AB........." + ".A.BCCDD")
+	assert.Equal(t, []move{{Burrow: Burrow("A.........." + ".ABBCCDD"), Energy: 40}}, burrow.moves())
+}
+
+func TestLeastEnergy(t *testing.T) {
+	burrow, err := ParseBurrow(exampleBurrow)
+	assert.NoError(t, err)
+
+	energy, err := burrow.LeastEnergy()
+	assert.NoError(t, err)
+	assert.Equal(t, 12521, energy)
+
+	burrow, err = ParseBurrow(Unfold(exampleBurrow))
+	assert.NoError(t, err)
+
+	energy, err = burrow.LeastEnergy()
+	assert.NoError(t, err)
+	assert.Equal(t, 44169, energy)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -0,0 +1,347 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day24
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day24Cmd represents the day24 command
+var Day24Cmd = &cobra.Command{
+	Use:   "day24",
+	Short: `Arithmetic Logic Unit`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	ModelNumberDigits = 14
+	BlockLength       = 18
+)
+
+type Operation string
+
+const (
+	Input    Operation = "inp"
+	Add      Operation = "add"
+	Multiply Operation = "mul"
+	Divide   Operation = "div"
+	Modulo   Operation = "mod"
+	Equal    Operation = "eql"
+)
+
+type Operand struct {
+	Register  int
+	Literal   int
+	IsLiteral bool
+}
+
+type Instruction struct {
+	Operation Operation
+	Register  int
+	Operand   Operand
+}
+
+type Registers [4]int
+
+func parseRegister(s string) (int, bool) {
+	if len(s) != 1 || s[0] < 'w' || s[0] > 'z' {
+		return 0, false
+	}
+
+	return int(s[0] - 'w'), true
+}
+
+func ParseInstruction(line string) (Instruction, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return Instruction{}, fmt.Errorf("invalid instruction '%s'", line)
+	}
+
+	instruction := Instruction{Operation: Operation(fields[0])}
+
+	register, ok := parseRegister(fields[1])
+	if !ok {
+		return Instruction{}, fmt.Errorf("invalid register '%s'", fields[1])
+	}
+
+	instruction.Register = register
+
+	switch instruction.Operation {
+	case Input:
+		if len(fields) != 2 {
+			return Instruction{}, fmt.Errorf("invalid instruction '%s'", line)
+		}
+
+		return instruction, nil
+
+	case Add, Multiply, Divide, Modulo, Equal:
+		if len(fields) != 3 {
+			return Instruction{}, fmt.Errorf("invalid instruction '%s'", line)
+		}
+
+		if register, ok := parseRegister(fields[2]); ok {
+			instruction.Operand = Operand{Register: register}
+		} else {
+			literal, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return Instruction{}, fmt.Errorf("invalid operand '%s'", fields[2])
+			}
+
+			instruction.Operand = Operand{Literal: literal, IsLiteral: true}
+		}
+
+		return instruction, nil
+	}
+
+	return Instruction{}, fmt.Errorf("unknown operation '%s'", fields[0])
+}
+
+func ParseProgram(fileContents string) ([]Instruction, error) {
+	program := make([]Instruction, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		i, err := ParseInstruction(line)
+		if err != nil {
+			return nil, err
+		}
+
+		program = append(program, i)
+	}
+
+	return program, nil
+}
+
+// Run executes the program on the ALU, reading from input for each inp.
+func Run(program []Instruction, input []int) (Registers, error) {
+	var registers Registers
+
+	for _, instruction := range program {
+		a := &registers[instruction.Register]
+
+		b := instruction.Operand.Literal
+		if !instruction.Operand.IsLiteral {
+			b = registers[instruction.Operand.Register]
+		}
+
+		switch instruction.Operation {
+		case Input:
+			if len(input) == 0 {
+				return registers, fmt.Errorf("ran out of input")
+			}
+
+			*a = input[0]
+			input = input[1:]
+
+		case Add:
+			*a += b
+
+		case Multiply:
+			*a *= b
+
+		case Divide:
+			if b == 0 {
+				return registers, fmt.Errorf("division by zero")
+			}
+
+			*a /= b
+
+		case Modulo:
+			if *a < 0 || b <= 0 {
+				return registers, fmt.Errorf("invalid modulo %d %% %d", *a, b)
+			}
+
+			*a %= b
+
+		case Equal:
+			if *a == b {
+				*a = 1
+			} else {
+				*a = 0
+			}
+		}
+	}
+
+	return registers, nil
+}
+
+func Digits(number int) []int {
+	digits := make([]int, ModelNumberDigits)
+
+	for i := ModelNumberDigits - 1; i >= 0; i-- {
+		digits[i] = number % 10
+		number /= 10
+	}
+
+	return digits
+}
+
+// IsValid runs MONAD on a model number, which is valid when z ends up 0.
+func IsValid(program []Instruction, number int) (bool, error) {
+	digits := Digits(number)
+
+	for _, d := range digits {
+		if d == 0 {
+			return false, nil
+		}
+	}
+
+	registers, err := Run(program, digits)
+	if err != nil {
+		return false, err
+	}
+
+	return registers[3] == 0, nil
+}
+
+// Block holds the three values that differ between the 14 otherwise identical
+// blocks of MONAD: the divisor of z on line 5 and the constants added to x on
+// line 6 and to y on line 16.
+type Block struct {
+	DivideZ int
+	AddX    int
+	AddY    int
+}
+
+func ExtractBlocks(program []Instruction) ([]Block, error) {
+	if len(program) != ModelNumberDigits*BlockLength {
+		return nil, fmt.Errorf("expected %d instructions, found %d", ModelNumberDigits*BlockLength, len(program))
+	}
+
+	blocks := make([]Block, 0, ModelNumberDigits)
+
+	for i := 0; i < ModelNumberDigits; i++ {
+		block := program[i*BlockLength : (i+1)*BlockLength]
+
+		if block[0].Operation != Input || !block[4].Operand.IsLiteral || !block[5].Operand.IsLiteral || !block[15].Operand.IsLiteral {
+			return nil, fmt.Errorf("block %d does not look like MONAD", i)
+		}
+
+		blocks = append(blocks, Block{DivideZ: block[4].Operand.Literal, AddX: block[5].Operand.Literal, AddY: block[15].Operand.Literal})
+	}
+
+	return blocks, nil
+}
+
+// FindModelNumbers works out the largest and smallest valid model numbers. MONAD
+// treats z as a stack of base 26 digits. A block that divides z by 1 always pushes
+// its digit plus AddY. A block that divides by 26 pops, and only avoids pushing
+// again when its digit equals the popped value plus AddX. Pairing every push with
+// its pop gives a constraint digit[pop] = digit[push] + difference for each pair,
+// and each pair can be maximized or minimized on its own.
+func FindModelNumbers(program []Instruction) (int, int, error) {
+	blocks, err := ExtractBlocks(program)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	largest := make([]int, ModelNumberDigits)
+	smallest := make([]int, ModelNumberDigits)
+
+	pushed := utilities.Stack[int]{}
+
+	for i, b := range blocks {
+		switch b.DivideZ {
+		case 1:
+			if b.AddX <= 9 {
+				return 0, 0, fmt.Errorf("block %d could match its digit without popping", i)
+			}
+
+			pushed.Push(i)
+
+		case 26:
+			if pushed.IsEmpty() {
+				return 0, 0, fmt.Errorf("block %d pops an empty stack", i)
+			}
+
+			j := pushed.Pop()
+			difference := blocks[j].AddY + b.AddX
+
+			largest[j] = min(9, 9-difference)
+			smallest[j] = max(1, 1-difference)
+			largest[i] = largest[j] + difference
+			smallest[i] = smallest[j] + difference
+
+			if largest[j] < 1 || smallest[j] > 9 {
+				return 0, 0, fmt.Errorf("digits %d and %d can never match", j, i)
+			}
+
+		default:
+			return 0, 0, fmt.Errorf("block %d divides z by %d", i, b.DivideZ)
+		}
+	}
+
+	if !pushed.IsEmpty() {
+		return 0, 0, fmt.Errorf("z never returns to 0")
+	}
+
+	toNumber := func(digits []int) int {
+		n := 0
+		for _, d := range digits {
+			n = n*10 + d
+		}
+		return n
+	}
+
+	numbers := []int{toNumber(largest), toNumber(smallest)}
+
+	for _, n := range numbers {
+		valid, err := IsValid(program, n)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if !valid {
+			return 0, 0, fmt.Errorf("MONAD rejected %d", n)
+		}
+	}
+
+	return numbers[0], numbers[1], nil
+}
+
+func day(fileContents string) error {
+	program, err := ParseProgram(fileContents)
+	if err != nil {
+		return err
+	}
+
+	largest, smallest, err := FindModelNumbers(program)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: To enable as many submarine features as possible, find the largest valid
+	// fourteen-digit model number that contains no 0 digits. What is the largest model
+	// number accepted by MONAD?
+	utilities.Answer(1, "Largest model number", largest)
+
+	// Part 2: What is the smallest model number accepted by MONAD?
+	utilities.Answer(2, "Smallest model number", smallest)
+
+	return nil
+}
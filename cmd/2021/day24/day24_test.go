@@ -0,0 +1,136 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day24
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInstruction(t *testing.T) {
+	i, err := ParseInstruction("add z -12")
+	assert.NoError(t, err)
+	assert.Equal(t, Instruction{Operation: Add, Register: 3, Operand: Operand{Literal: -12, IsLiteral: true}}, i)
+
+	i, err = ParseInstruction("eql x w")
+	assert.NoError(t, err)
+	assert.Equal(t, Instruction{Operation: Equal, Register: 1, Operand: Operand{Register: 0}}, i)
+
+	for _, bad := range []string{"inp a", "inp w x", "add x", "sub x 1", "mul x q"} {
+		_, err = ParseInstruction(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestRun(t *testing.T) {
+	negate, err := ParseProgram("inp x\nmul x -1")
+	assert.NoError(t, err)
+
+	registers, err := Run(negate, []int{7})
+	assert.NoError(t, err)
+	assert.Equal(t, -7, registers[1])
+
+	threeTimes, err := ParseProgram("inp z\ninp x\nmul z 3\neql z x")
+	assert.NoError(t, err)
+
+	registers, err = Run(threeTimes, []int{2, 6})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, registers[3])
+
+	registers, err = Run(threeTimes, []int{2, 5})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, registers[3])
+
+	binary, err := ParseProgram(`inp w
+add z w
+mod z 2
+div w 2
+add y w
+mod y 2
+div w 2
+add x w
+mod x 2
+div w 2
+mod w 2`)
+	assert.NoError(t, err)
+
+	registers, err = Run(binary, []int{11})
+	assert.NoError(t, err)
+	assert.Equal(t, Registers{1, 0, 1, 1}, registers)
+
+	_, err = Run(threeTimes, []int{2})
+	assert.Error(t, err)
+
+	divide, err := ParseProgram("inp x\ndiv x 0")
+	assert.NoError(t, err)
+
+	_, err = Run(divide, []int{1})
+	assert.Error(t, err)
+}
+
+// generateMONAD writes out a MONAD program using the given block parameters.
+func generateMONAD(blocks []Block) string {
+	lines := make([]string, 0)
+
+	for _, b := range blocks {
+		lines = append(lines,
+			"inp w", "mul x 0", "add x z", "mod x 26",
+			fmt.Sprintf("div z %d", b.DivideZ),
+			fmt.Sprintf("add x %d", b.AddX),
+			"eql x w", "eql x 0", "mul y 0", "add y 25", "mul y x", "add y 1", "mul z y",
+			"mul y 0", "add y w",
+			fmt.Sprintf("add y %d", b.AddY),
+			"mul y x", "add z y")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+var exampleBlocks = []Block{
+	{1, 12, 4}, {1, 11, 10}, {1, 14, 12}, {26, -6, 14},
+	{1, 15, 6}, {1, 12, 16}, {26, -9, 1}, {1, 14, 7},
+	{26, -5, 8}, {26, -9, 8}, {26, -5, 14}, {26, -2, 15},
+	{1, 11, 1}, {26, -1, 1},
+}
+
+func TestExtractBlocks(t *testing.T) {
+	program, err := ParseProgram(generateMONAD(exampleBlocks))
+	assert.NoError(t, err)
+
+	blocks, err := ExtractBlocks(program)
+	assert.NoError(t, err)
+	assert.Equal(t, exampleBlocks, blocks)
+
+	_, err = ExtractBlocks(program[:len(program)-1])
+	assert.Error(t, err)
+}
+
+func TestFindModelNumbers(t *testing.T) {
+	program, err := ParseProgram(generateMONAD(exampleBlocks))
+	assert.NoError(t, err)
+
+	largest, smallest, err := FindModelNumbers(program)
+	assert.NoError(t, err)
+	assert.Equal(t, 74399297969999, largest)
+	assert.Equal(t, 11174181316311, smallest)
+
+	for _, n := range []int{largest + 1, smallest - 1, 99999999999999} {
+		valid, err := IsValid(program, n)
+		assert.NoError(t, err)
+		assert.False(t, valid, n)
+	}
+
+	unbalanced := append([]Block{}, exampleBlocks...)
+	unbalanced[13] = Block{1, 11, 1}
+
+	program, err = ParseProgram(generateMONAD(unbalanced))
+	assert.NoError(t, err)
+
+	_, _, err = FindModelNumbers(program)
+	assert.Error(t, err)
+}
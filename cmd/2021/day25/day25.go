@@ -0,0 +1,143 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day25
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day25Cmd represents the day25 command
+var Day25Cmd = &cobra.Command{
+	Use:   "day25",
+	Short: `Sea Cucumber`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	EmptySpace = '.'
+	EastHerd   = '>'
+	SouthHerd  = 'v'
+)
+
+type Seafloor struct {
+	Bounds utilities.Size2D
+	Rows   [][]byte
+}
+
+func ParseSeafloor(fileContents string) (*Seafloor, error) {
+	seafloor := &Seafloor{}
+
+	for y, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		row := []byte(strings.TrimSpace(line))
+
+		if y > 0 && len(row) != len(seafloor.Rows[0]) {
+			return nil, fmt.Errorf("row %d has length %d, expected %d", y, len(row), len(seafloor.Rows[0]))
+		}
+
+		for _, c := range row {
+			if c != EmptySpace && c != EastHerd && c != SouthHerd {
+				return nil, fmt.Errorf("invalid seafloor location '%c'", c)
+			}
+		}
+
+		seafloor.Rows = append(seafloor.Rows, row)
+	}
+
+	seafloor.Bounds = utilities.NewSize2D(len(seafloor.Rows[0]), len(seafloor.Rows))
+
+	return seafloor, nil
+}
+
+func (s *Seafloor) Describe() string {
+	rows := make([]string, 0, len(s.Rows))
+
+	for _, row := range s.Rows {
+		rows = append(rows, string(row))
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// moveHerd moves every member of the herd that faces an empty location at the
+// same time, wrapping around the edges, and returns how many moved.
+func (s *Seafloor) moveHerd(herd byte, dx, dy int) int {
+	type move struct {
+		From, To utilities.Point2D
+	}
+
+	moves := make([]move, 0)
+
+	for y, row := range s.Rows {
+		for x, c := range row {
+			if c != herd {
+				continue
+			}
+
+			to := utilities.NewPoint2D((x+dx)%s.Bounds.Width, (y+dy)%s.Bounds.Height)
+			if s.Rows[to.Y][to.X] == EmptySpace {
+				moves = append(moves, move{From: utilities.NewPoint2D(x, y), To: to})
+			}
+		}
+	}
+
+	for _, m := range moves {
+		s.Rows[m.From.Y][m.From.X] = EmptySpace
+		s.Rows[m.To.Y][m.To.X] = herd
+	}
+
+	return len(moves)
+}
+
+// Step moves the east-facing herd and then the south-facing herd, returning the
+// number of sea cucumbers that moved.
+func (s *Seafloor) Step() int {
+	return s.moveHerd(EastHerd, 1, 0) + s.moveHerd(SouthHerd, 0, 1)
+}
+
+func (s *Seafloor) FirstStillStep() int {
+	step := 1
+
+	for s.Step() != 0 {
+		step++
+	}
+
+	return step
+}
+
+func day(fileContents string) error {
+	seafloor, err := ParseSeafloor(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Find somewhere safe to land your submarine. What is the first step on
+	// which no sea cucumbers move?
+	utilities.Answer(1, "First step with no movement", seafloor.FirstStillStep())
+
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyOne_day25
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleSeafloor = `v...>>.vv>
+.vv>>.vv..
+>>.>v>...v
+>>v>>.>.v.
+v>v.vv.v..
+>.>>..v...
+.vv..>.>v.
+v.v..>>v.v
+....v..v.>`
+
+func TestParseSeafloor(t *testing.T) {
+	seafloor, err := ParseSeafloor(exampleSeafloor)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, seafloor.Bounds.Width)
+	assert.Equal(t, 9, seafloor.Bounds.Height)
+	assert.Equal(t, exampleSeafloor, seafloor.Describe())
+
+	_, err = ParseSeafloor("v..\n>x.")
+	assert.Error(t, err)
+
+	_, err = ParseSeafloor("v..\n>.")
+	assert.Error(t, err)
+}
+
+func TestStep(t *testing.T) {
+	seafloor, err := ParseSeafloor("...>>>>>...")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, seafloor.Step())
+	assert.Equal(t, "...>>>>.>..", seafloor.Describe())
+
+	assert.Equal(t, 2, seafloor.Step())
+	assert.Equal(t, "...>>>.>.>.", seafloor.Describe())
+
+	// East-facing sea cucumbers move first, and everything wraps around.
+	seafloor, err = ParseSeafloor(`..........
+.>v....v..
+.......>..
+..........`)
+	assert.NoError(t, err)
+
+	seafloor.Step()
+	assert.Equal(t, `..........
+.>........
+..v....v>.
+..........`, seafloor.Describe())
+}
+
+func TestFirstStillStep(t *testing.T) {
+	seafloor, err := ParseSeafloor(exampleSeafloor)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 58, seafloor.FirstStillStep())
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -5,6 +5,8 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyTwo
 
 import (
+	"embed"
+
 	TwentyTwentyTwo_day01 "github.com/d1r7y/adventofcode/cmd/2022/day01"
 	TwentyTwentyTwo_day02 "github.com/d1r7y/adventofcode/cmd/2022/day02"
 	TwentyTwentyTwo_day03 "github.com/d1r7y/adventofcode/cmd/2022/day03"
@@ -25,6 +27,7 @@ import (
 	TwentyTwentyTwo_day18 "github.com/d1r7y/adventofcode/cmd/2022/day18"
 	TwentyTwentyTwo_day20 "github.com/d1r7y/adventofcode/cmd/2022/day20"
 	TwentyTwentyTwo_day21 "github.com/d1r7y/adventofcode/cmd/2022/day21"
+	"github.com/d1r7y/adventofcode/utilities/metadata"
 	"github.com/spf13/cobra"
 )
 
@@ -35,7 +38,14 @@ var TwentyTwentyTwoCmd = &cobra.Command{
 	Long:  ``,
 }
 
+// puzzles holds each day's puzzle.yaml
+//
+//go:embed day*/puzzle.yaml
+var puzzles embed.FS
+
 func init() {
+	metadata.MustRegisterYear(2022, puzzles)
+
 	TwentyTwentyTwoCmd.AddCommand(TwentyTwentyTwo_day01.Day01Cmd)
 	TwentyTwentyTwoCmd.AddCommand(TwentyTwentyTwo_day02.Day02Cmd)
 	TwentyTwentyTwoCmd.AddCommand(TwentyTwentyTwo_day03.Day03Cmd)
@@ -90,7 +90,7 @@ func day(fileContent string) error {
 
 	// Part 1: What's the most calories a single elf is carrying?
 	if len(calorieList) > 0 {
-		log.Printf("Maximum elf calories: %d\n", calorieList[0])
+		utilities.Answer(1, "Maximum elf calories", calorieList[0])
 	} else {
 		log.Println("No elf calories in input file.")
 		return nil
@@ -98,7 +98,7 @@ func day(fileContent string) error {
 
 	// Part 2: How many calories are the top three elves carrying?
 	if len(calorieList) >= 3 {
-		log.Printf("Maximum calories from top 3 elves: %d\n", calorieList[0]+calorieList[1]+calorieList[2])
+		utilities.Answer(2, "Maximum calories from top 3 elves", calorieList[0]+calorieList[1]+calorieList[2])
 	} else {
 		log.Println("Not enough elves in input file.")
 	}
@@ -7,6 +7,7 @@ package TwentyTwentyTwo_day01
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,3 +56,7 @@ func TestParseElfCalorieList_TwoElves_TwoItems(t *testing.T) {
 	assert.Equal(t, list[0], 4000)
 	assert.Equal(t, list[1], 9000)
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
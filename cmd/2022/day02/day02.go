@@ -273,7 +273,7 @@ func day(fileContents string) error {
 		totalScore += r.Score()
 	}
 
-	log.Printf("Total score: %d\n", totalScore)
+	utilities.Answer(1, "Total score", totalScore)
 
 	// Part 2: What is the total score if you followed the strategy, where the second item in each round
 	// is the result?
@@ -288,6 +288,6 @@ func day(fileContents string) error {
 		totalScore += r.Score()
 	}
 
-	log.Printf("Total score: %d\n", totalScore)
+	utilities.Answer(2, "Total score", totalScore)
 	return nil
 }
@@ -7,6 +7,7 @@ package TwentyTwentyTwo_day02
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -286,3 +287,7 @@ func TestParseRounds_InvalidRound_InvalidShape(t *testing.T) {
 	assert.Error(t, err)
 	assert.Len(t, rounds, 0)
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -241,7 +241,7 @@ func day(fileContents string) error {
 		totalPriority += commonItem.Priority().Value()
 	}
 
-	log.Printf("Total priority: %d\n", totalPriority)
+	utilities.Answer(1, "Total priority", totalPriority)
 
 	// Part 2: What is the total priority of all the badges for a given elf group?
 	groups, err := ParseGroups(string(fileContents))
@@ -256,6 +256,6 @@ func day(fileContents string) error {
 		totalPriority += badget.Priority().Value()
 	}
 
-	log.Printf("Total badge priority: %d\n", totalPriority)
+	utilities.Answer(2, "Total badge priority", totalPriority)
 	return nil
 }
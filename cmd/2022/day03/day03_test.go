@@ -7,6 +7,7 @@ package TwentyTwentyTwo_day03
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -106,3 +107,7 @@ func TestGetGroupBadge(t *testing.T) {
 		assert.Equal(t, getGroupBadge(g), test.expectedBadge)
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -169,7 +169,7 @@ func day(fileContents string) error {
 		}
 	}
 
-	log.Printf("%d assignments fully contained\n", totalFullyContained)
+	utilities.Answer(1, "Assignments fully contained", totalFullyContained)
 
 	// Part 2: In how many cleaning assignments is there any overlap between the SectionRanges?
 
@@ -180,6 +180,6 @@ func day(fileContents string) error {
 		}
 	}
 
-	log.Printf("%d assignments intersect\n", totalIntersect)
+	utilities.Answer(2, "Assignments intersecting", totalIntersect)
 	return err
 }
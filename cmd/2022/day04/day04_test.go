@@ -7,6 +7,7 @@ package TwentyTwentyTwo_day04
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -158,3 +159,7 @@ func TestCleaningPairIntersect(t *testing.T) {
 		assert.Equal(t, test.expectedIntersect, cp.Intersect())
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -118,6 +118,19 @@ func (w *Warehouse) ApplyMovementOp9001(mo MovementOp) {
 	w.crates[mo.endStackIndex-1] = append(crane9001, w.crates[mo.endStackIndex-1]...)
 }
 
+// TopCrates is the labels of the crates on top of each stack, in stack order.
+func (w *Warehouse) TopCrates() string {
+	var sb strings.Builder
+
+	for _, stack := range w.crates {
+		if len(stack) > 0 {
+			sb.WriteString(stack[0].label)
+		}
+	}
+
+	return sb.String()
+}
+
 func (w *Warehouse) Describe() {
 	var highestStackHeight = 0
 
@@ -260,6 +273,8 @@ func day(fileContents string) error {
 
 	warehouse1.Describe()
 
+	utilities.Answer(1, "Crates on top of each stack", warehouse1.TopCrates())
+
 	// Part 2: If multiple crates are moved in a single movement op, their order is kept.  Now what are the labels of the crates on
 	// top of each stack?
 
@@ -291,5 +306,8 @@ func day(fileContents string) error {
 	}
 
 	warehouse2.Describe()
+
+	utilities.Answer(2, "Crates on top of each stack", warehouse2.TopCrates())
+
 	return nil
 }
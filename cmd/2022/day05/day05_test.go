@@ -7,6 +7,7 @@ package TwentyTwentyTwo_day05
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -261,3 +262,18 @@ func TestWarehouseApplyMovementOp9001(t *testing.T) {
 		assert.Equal(t, test.expectedCrates, w.crates)
 	}
 }
+
+func TestWarehouseTopCrates(t *testing.T) {
+	w := NewWarehouse()
+	w.AddCrate(NewCrate("N"), 1)
+	w.AddCrate(NewCrate("Z"), 1)
+	w.AddCrate(NewCrate("D"), 2)
+	w.AddCrate(NewCrate("C"), 2)
+	w.AddCrate(NewCrate("P"), 4)
+
+	assert.Equal(t, "NDP", w.TopCrates())
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
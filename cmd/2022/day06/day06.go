@@ -109,14 +109,14 @@ func day(fileContents string) error {
 	// Part 1: What is the offset of the first valid packet marker?  Packets need 4 unique characters.
 	ds := Datastream(fileContents)
 	if validOffset := ds.GetPacketMarkerStart(); validOffset > 0 {
-		fmt.Printf("Valid packet marker starting at offset %d\n", validOffset)
+		utilities.Answer(1, "Valid packet marker starting at offset", validOffset)
 	} else {
 		fmt.Print("No valid packet marker found")
 	}
 
 	// Part 2: What is the offset of the first valid message marker?  Messages need 14 unique characters.
 	if validOffset := ds.GetMessageMarkerStart(); validOffset > 0 {
-		fmt.Printf("Valid message marker starting at offset %d\n", validOffset)
+		utilities.Answer(2, "Valid message marker starting at offset", validOffset)
 	} else {
 		fmt.Print("No valid message marker found")
 	}
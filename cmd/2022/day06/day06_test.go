@@ -7,6 +7,7 @@ package TwentyTwentyTwo_day06
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -51,3 +52,7 @@ func TestGetMessageMarkerStart(t *testing.T) {
 		assert.Equal(t, test.expectedValidOffset, ds.GetMessageMarkerStart())
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
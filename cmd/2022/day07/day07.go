@@ -363,7 +363,7 @@ NextLine:
 		return nil
 	})
 
-	fmt.Printf("Sum of the total sizes of directories whose size is at most 100000: %d\n", totalSize)
+	utilities.Answer(1, "Sum of the total sizes of directories whose size is at most 100000", totalSize)
 
 	const DiskSize = int64(70000000)
 	const UpdateSize = int64(30000000)
@@ -392,7 +392,7 @@ NextLine:
 			return nil
 		})
 
-		fmt.Printf("Smallest directory size which can satisfy our update: %d\n", minimumSize)
+		utilities.Answer(2, "Smallest directory size which can satisfy our update", minimumSize)
 	}
 
 	return nil
@@ -7,6 +7,7 @@ package TwentyTwentyTwo_day07
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -102,3 +103,7 @@ func TestParseLsOutputLine(t *testing.T) {
 		}
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
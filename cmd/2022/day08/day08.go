@@ -248,10 +248,10 @@ func day(fileContents string) error {
 	}
 
 	// Part 1: How many trees are visible from outside the forest?
-	log.Printf("Number of visible trees: %d\n", f.NumberVisibleTrees())
+	utilities.Answer(1, "Number of visible trees", f.NumberVisibleTrees())
 
 	// Part 2: What is the highest possible scenic score possible for any tree?
-	log.Printf("Best possible scenic score: %d\n", f.BestScenicScore())
+	utilities.Answer(2, "Best possible scenic score", f.BestScenicScore())
 
 	return nil
 }
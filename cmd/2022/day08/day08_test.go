@@ -7,6 +7,7 @@ package TwentyTwentyTwo_day08
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -89,3 +90,7 @@ func TestScenicScoreForTree(t *testing.T) {
 	assert.Equal(t, 4, f.scenicScoreForTree(2, 1))
 	assert.Equal(t, 8, f.scenicScoreForTree(2, 3))
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
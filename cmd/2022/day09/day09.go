@@ -7,6 +7,7 @@ package TwentyTwentyTwo_day09
 import (
 	"errors"
 	"fmt"
+	"image/color"
 	"io"
 	"log"
 	"math"
@@ -14,6 +15,7 @@ import (
 	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/render"
 	"github.com/spf13/cobra"
 )
 
@@ -40,6 +42,15 @@ var Day09Cmd = &cobra.Command{
 	},
 }
 
+var animatePath string
+
+func init() {
+	Day09Cmd.Flags().StringVar(&animatePath, "animate", "", "write an animation of the 10 knot rope to a .gif file or numbered .png files")
+}
+
+// StepsPerFrame keeps animations of thousands of steps to a reasonable size.
+const StepsPerFrame = 10
+
 func GetMovementAmount(dir MovementDirection) (int, int) {
 	switch dir {
 	case UpDirection:
@@ -206,6 +217,77 @@ func (w *World) GetTailPositions() []KnotPosition {
 	return keys
 }
 
+// Size is the area the rope has covered so far.
+func (w *World) Size() utilities.Size2D {
+	return utilities.NewSize2D(w.MaxX-w.MinX+1, w.MaxY-w.MinY+1)
+}
+
+// Pixel draws the head as H, the other knots as K, the start as s and the positions
+// the tail has visited as #. Positions are relative to the top left of the area
+// the rope has covered, with up at the top.
+func (w *World) Pixel(p utilities.Point2D) rune {
+	kp := NewKnotPosition(p.X+w.MinX, w.MaxY-p.Y)
+
+	if kp == w.Head {
+		return 'H'
+	}
+
+	for _, knot := range w.RemainingKnots {
+		if kp == knot {
+			return 'K'
+		}
+	}
+
+	if kp == NewKnotPosition(0, 0) {
+		return 's'
+	}
+
+	if w.TailPositions[kp] {
+		return '#'
+	}
+
+	return '.'
+}
+
+func NewRecorder() *render.Recorder[rune] {
+	palette := render.NewPalette[rune](color.RGBA{0, 0, 40, 255}).
+		Set('#', color.RGBA{60, 90, 160, 255}).
+		Set('s', color.RGBA{255, 255, 255, 255}).
+		Set('K', color.RGBA{200, 160, 100, 255}).
+		Set('H', color.RGBA{255, 80, 80, 255})
+
+	recorder := render.NewRecorder(palette)
+	recorder.Scale = 2
+	recorder.Every = StepsPerFrame
+
+	return recorder
+}
+
+// AnimateRope replays the movement operations with a rope of totalKnots knots,
+// recording a frame after every step of the head.
+func AnimateRope(ops KnotMovementOpList, totalKnots int, recorder *render.Recorder[rune]) *World {
+	// Every frame has to be the same size, so find the area the rope covers first.
+	bounds := NewWorld(totalKnots)
+
+	for _, op := range ops {
+		bounds.ApplyMovementOp(op)
+	}
+
+	w := NewWorld(totalKnots)
+	w.MinX, w.MinY, w.MaxX, w.MaxY = bounds.MinX, bounds.MinY, bounds.MaxX, bounds.MaxY
+
+	for _, op := range ops {
+		for i := 0; i < op.Count; i++ {
+			w.ApplyMovementOp(NewKnotMovementOp(op.Direction, 1))
+			recorder.Snapshot(w.Size(), w.Pixel)
+		}
+	}
+
+	recorder.Capture(w.Size(), w.Pixel)
+
+	return w
+}
+
 type MovementDirection int
 
 const (
@@ -292,7 +374,7 @@ func day(fileContents string) error {
 
 	fmt.Printf("Dynamic board size (%d,%d,%d,%d)\n", w2.MinX, w2.MinY, w2.MaxX, w2.MaxY)
 
-	fmt.Printf("Tail knot visited %d positions\n", len(w2.GetTailPositions()))
+	utilities.Answer(1, "Positions the tail knot visited", len(w2.GetTailPositions()))
 
 	// Part 2: What if there are 10 knots?  How many positions does the final tail knot visit at least once?
 	w10 := NewWorld(10)
@@ -303,7 +385,19 @@ func day(fileContents string) error {
 
 	fmt.Printf("Dynamic board size (%d,%d,%d,%d)\n", w10.MinX, w10.MinY, w10.MaxX, w10.MaxY)
 
-	fmt.Printf("Tail knot visited %d positions\n", len(w10.GetTailPositions()))
+	utilities.Answer(2, "Positions the tail knot visited", len(w10.GetTailPositions()))
+
+	if animatePath != "" {
+		recorder := NewRecorder()
+
+		AnimateRope(headMovementOperations, 10, recorder)
+
+		if err := recorder.Save(animatePath); err != nil {
+			return err
+		}
+
+		fmt.Printf("Wrote %d frames to %s\n", recorder.FrameCount(), animatePath)
+	}
 
 	return nil
 }
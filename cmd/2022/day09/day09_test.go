@@ -6,8 +6,11 @@ package TwentyTwentyTwo_day09
 
 import (
 	"math"
+	"strings"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -238,3 +241,34 @@ func TestManyKnotsApplyMovementOp(t *testing.T) {
 		assert.Equal(t, test.expectedKnots, finalKnots)
 	}
 }
+
+func TestAnimateRope(t *testing.T) {
+	ops, err := ParseKnotMovementOps(strings.Split(`R 5
+U 8
+L 8
+D 3
+R 17
+D 10
+L 25
+U 20`, "\n"))
+	assert.NoError(t, err)
+
+	recorder := NewRecorder()
+	w := AnimateRope(ops, 10, recorder)
+
+	assert.Equal(t, 36, len(w.GetTailPositions()))
+	assert.Equal(t, utilities.NewSize2D(26, 21), w.Size())
+
+	// 96 steps of the head, plus the final frame.
+	assert.Equal(t, (96+StepsPerFrame-1)/StepsPerFrame+1, recorder.FrameCount())
+	assert.Equal(t, 2*26, recorder.Frames[0].Bounds().Dx())
+
+	// The head finishes at -11,15, in the top left corner of the area.
+	assert.Equal(t, NewKnotPosition(-11, 15), w.Head)
+	assert.Equal(t, 'H', w.Pixel(utilities.NewPoint2D(0, 0)))
+	assert.Equal(t, 's', w.Pixel(utilities.NewPoint2D(11, 15)))
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/debugger"
 	"github.com/spf13/cobra"
 )
 
@@ -40,6 +41,24 @@ var Day10Cmd = &cobra.Command{
 	},
 }
 
+// SignalCycles are the cycles whose signal strength part 1 adds up.
+var SignalCycles = []int{20, 60, 100, 140, 180, 220}
+
+func init() {
+	debugger.Register(2022, 10, func(input string) (debugger.Simulation, error) {
+		instructions, err := ParseInstructions(strings.Split(strings.TrimSpace(input), "\n"))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(instructions) == 0 {
+			return nil, errors.New("empty program")
+		}
+
+		return NewProgramSimulation(instructions), nil
+	})
+}
+
 type Sample struct {
 	Cycle int
 	Value int
@@ -222,6 +241,86 @@ func (o *BufferedOutput) NextLine() {
 	}
 }
 
+func (o *BufferedOutput) Rows() []string {
+	rows := make([]string, 0, len(o.Screen))
+
+	for _, line := range o.Screen {
+		rows = append(rows, string(line))
+	}
+
+	return rows
+}
+
+// OCROutput buffers the screen like BufferedOutput, and can read the capital
+// letters drawn on it.
+type OCROutput struct {
+	BufferedOutput
+}
+
+func NewOCROutput() *OCROutput {
+	o := &OCROutput{}
+	o.SetScreenDimensions(40, 6)
+
+	return o
+}
+
+func (o *OCROutput) Text() (string, error) {
+	return utilities.RecognizeLetters(o.Rows())
+}
+
+// ProgramSimulation runs one instruction per step in the debugger, drawing the
+// CRT as it goes.
+type ProgramSimulation struct {
+	CPU          *CPU
+	Screen       *OCROutput
+	Instructions []Instruction
+	Next         int
+	sampled      bool
+}
+
+func NewProgramSimulation(instructions []Instruction) *ProgramSimulation {
+	s := &ProgramSimulation{CPU: NewCPU(), Screen: NewOCROutput(), Instructions: instructions}
+
+	s.CPU.SetOutput(s.Screen)
+	s.CPU.SetXSampleCycles(append([]int{}, SignalCycles...))
+
+	return s
+}
+
+func (s *ProgramSimulation) Step() bool {
+	samples := len(s.CPU.GetXSampleBuffer())
+
+	s.CPU.RunInstruction(s.Instructions[s.Next])
+	s.Next++
+
+	s.sampled = len(s.CPU.GetXSampleBuffer()) > samples
+
+	return s.Next >= len(s.Instructions)
+}
+
+// Render draws the CRT, leaving the pixels the beam hasn't reached yet blank.
+func (s *ProgramSimulation) Render() string {
+	return strings.ReplaceAll(strings.Join(s.Screen.Rows(), "\n"), "\x00", " ")
+}
+
+func (s *ProgramSimulation) Inspect() string {
+	next := "none"
+	if s.Next < len(s.Instructions) {
+		next = s.Instructions[s.Next].Describe()
+	}
+
+	return fmt.Sprintf("cycle %d: X=%d, beam at %d, next instruction %s, samples %v",
+		s.CPU.Cycle, s.CPU.X, s.CPU.CRTPosition, next, s.CPU.GetXSampleBuffer())
+}
+
+// Breakpoints stops on the instructions that pass one of the cycles part 1
+// samples X on.
+func (s *ProgramSimulation) Breakpoints() map[string]func() bool {
+	return map[string]func() bool{
+		"sample": func() bool { return s.sampled },
+	}
+}
+
 type Instruction interface {
 	Describe() string
 	CycleCount() int
@@ -312,8 +411,7 @@ func day(fileContents string) error {
 	// Part 1: Find the signal strength during the 20th, 60th, 100th, 140th, 180th, and 220th cycles. What is the sum of these six signal strengths?
 	c := NewCPU()
 
-	cyclesToSampleX := []int{20, 60, 100, 140, 180, 220}
-	c.SetXSampleCycles(cyclesToSampleX)
+	c.SetXSampleCycles(append([]int{}, SignalCycles...))
 
 	for _, i := range instructions {
 		c.RunInstruction(i)
@@ -326,17 +424,27 @@ func day(fileContents string) error {
 	}
 
 	fmt.Printf("Cycle count: %d\n", c.Cycle)
-	fmt.Printf("Total signal strength: %d\n", totalSignalStrength)
+	utilities.Answer(1, "Total signal strength", totalSignalStrength)
 
 	// Part 2: Register X is the sprite location register.  If the CRT beam horizontal counter is +/-1 of X, then draw a lit pixel.  Otherwise, draw
 	// a dark one.  Given a 40x6 "screen", what 8 capital letters are displayed?
 	c2 := NewCPU()
+	o := NewOCROutput()
 
-	c2.SetOutput(NewStdoutOutput())
+	c2.SetOutput(o)
 
 	for _, i := range instructions {
 		c2.RunInstruction(i)
 	}
 
+	fmt.Printf("%s\n", strings.Join(o.Rows(), "\n"))
+
+	text, err := o.Text()
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(2, "Letters", text)
+
 	return nil
 }
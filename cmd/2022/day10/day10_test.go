@@ -8,6 +8,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/debugger"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -493,3 +496,81 @@ noop`,
 		assert.Equal(t, test.output, screen)
 	}
 }
+
+func TestOCROutput(t *testing.T) {
+	rows, err := utilities.RenderLetters("EHPZPJGL")
+	assert.NoError(t, err)
+
+	c := NewCPU()
+	o := NewOCROutput()
+
+	c.SetOutput(o)
+
+	for _, row := range rows {
+		for x := 0; x < 40; x++ {
+			if x < len(row) && row[x] == '#' {
+				o.DrawLitPixel()
+			} else {
+				o.DrawDarkPixel()
+			}
+		}
+		o.NextLine()
+	}
+
+	text, err := o.Text()
+	assert.NoError(t, err)
+	assert.Equal(t, "EHPZPJGL", text)
+
+	// The example program draws stripes rather than letters.
+	instructions, err := ParseInstructions([]string{"noop", "addx 3", "addx -5"})
+	assert.NoError(t, err)
+
+	c = NewCPU()
+	o = NewOCROutput()
+
+	c.SetOutput(o)
+
+	for _, i := range instructions {
+		c.RunInstruction(i)
+	}
+
+	_, err = o.Text()
+	assert.Error(t, err)
+}
+
+func TestProgramSimulation(t *testing.T) {
+	factory, ok := debugger.Lookup(2022, 10)
+	assert.True(t, ok)
+
+	simulation, err := factory("noop\naddx 3\naddx -5\n")
+	assert.NoError(t, err)
+
+	session := debugger.NewSession(simulation)
+	assert.Equal(t, "cycle 1: X=1, beam at 0, next instruction noop, samples []", session.Inspect())
+
+	session.Forward(2)
+	assert.Equal(t, "cycle 4: X=4, beam at 3, next instruction addx -5, samples []", session.Inspect())
+
+	session.Forward(1)
+	assert.True(t, session.Finished)
+	assert.Equal(t, "#####"+strings.Repeat(" ", 35), strings.Split(session.Render(), "\n")[0])
+
+	simulation, err = factory(strings.Repeat("noop\n", 25))
+	assert.NoError(t, err)
+
+	session = debugger.NewSession(simulation)
+	session.Toggle("sample")
+	assert.Equal(t, "sample", session.Continue(nil))
+	assert.Equal(t, 20, session.Step())
+	assert.Equal(t, "cycle 21: X=1, beam at 20, next instruction noop, samples [{20 1}]", session.Inspect())
+
+	_, err = factory("")
+	assert.Error(t, err)
+
+	_, err = factory("jmp 4")
+	assert.Error(t, err)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -5,6 +5,7 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyTwo_day11
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -16,13 +17,14 @@ import (
 	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/debugger"
 	"github.com/spf13/cobra"
 )
 
 // Day11Cmd represents the day11 command
 var Day11Cmd = &cobra.Command{
 	Use:   "day11",
-	Short: `Monkey in the Middle - NOT COMPLETED`,
+	Short: `Monkey in the Middle`,
 	Run: func(cmd *cobra.Command, args []string) {
 		df, err := os.Open(utilities.GetInputPath(cmd))
 		if err != nil {
@@ -35,13 +37,30 @@ var Day11Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(string(fileContent))
+		err = day(utilities.GetContext(cmd), string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
 	},
 }
 
+// DebuggerRounds is how many rounds the debugger plays, as in part 1.
+const DebuggerRounds = 20
+
+// WorriedRounds is how many rounds part 2 plays.
+const WorriedRounds = 10000
+
+func init() {
+	debugger.Register(2022, 11, func(input string) (debugger.Simulation, error) {
+		monkeys, err := ParseNotes(strings.Split(strings.TrimSpace(input), "\n"))
+		if err != nil {
+			return nil, err
+		}
+
+		return &RoundSimulation{Jungle: NewJungle(monkeys), Limit: DebuggerRounds}, nil
+	})
+}
+
 type Item struct {
 	WorryLevel *big.Int
 }
@@ -105,6 +124,28 @@ func (j *Jungle) ApplyUndamagedWorryAdjustment(item Item) Item {
 	return item
 }
 
+// RoundSimulation plays one round of keep away per step in the debugger.
+type RoundSimulation struct {
+	Jungle *Jungle
+	Rounds int
+	Limit  int
+}
+
+func (s *RoundSimulation) Step() bool {
+	s.Jungle.Evaluate()
+	s.Rounds++
+
+	return s.Rounds >= s.Limit
+}
+
+func (s *RoundSimulation) Render() string {
+	return strings.TrimSuffix(s.Jungle.Describe(), "\n")
+}
+
+func (s *RoundSimulation) Inspect() string {
+	return fmt.Sprintf("round %d: inspection counts %v", s.Rounds, s.Jungle.GetMonkeyInspectionCounts())
+}
+
 type OperationFn func(item Item) Item
 type TestFn func(item Item) bool
 
@@ -409,7 +450,7 @@ func ParseNotes(lines []string) ([]*Monkey, error) {
 	return monkeys, nil
 }
 
-func day(fileContents string) error {
+func day(ctx context.Context, fileContents string) error {
 	// Part 1: After evaluating all the monkey shines, the monkey level business is the activity of the top two monkeys multiplied together.  What is it?
 
 	// Scan monkey notes.
@@ -426,7 +467,7 @@ func day(fileContents string) error {
 	inspectionCounts := j.GetMonkeyInspectionCounts()
 	sort.Sort(sort.Reverse(sort.IntSlice(inspectionCounts)))
 
-	fmt.Printf("Level of monkey business: %d\n", inspectionCounts[0]*inspectionCounts[1])
+	utilities.Answer(1, "Level of monkey business", inspectionCounts[0]*inspectionCounts[1])
 
 	// Part 2: Now you're so worried that your relief that the items are undamaged don't lower your worry level by 3.  Now you need to run 10,000.
 	// What is the new monkey level business?
@@ -440,20 +481,23 @@ func day(fileContents string) error {
 	j = NewJungle(monkeys)
 	j.SetUndamagedWorryLevelAdjustment(1)
 
-	for i := 1; i <= 10000; i++ {
-		j.Evaluate()
-		// fmt.Println(j.Describe())
+	progress := utilities.NewProgress("rounds", WorriedRounds)
 
-		fmt.Printf("== After round %d ==\n", i)
-		for mid, count := range j.GetMonkeyInspectionCounts() {
-			fmt.Printf("Monkey %d inspected items %d times.\n", mid, count)
+	for i := 1; i <= WorriedRounds; i++ {
+		if err := utilities.Interrupted(ctx); err != nil {
+			return fmt.Errorf("stopped after %d of %d rounds: %w", i-1, WorriedRounds, err)
 		}
+
+		j.Evaluate()
+		progress.Add(1)
 	}
 
+	progress.Done()
+
 	inspectionCounts = j.GetMonkeyInspectionCounts()
 	sort.Sort(sort.Reverse(sort.IntSlice(inspectionCounts)))
 
-	fmt.Printf("Level of monkey business: %d\n", inspectionCounts[0]*inspectionCounts[1])
+	utilities.Answer(2, "Level of monkey business", inspectionCounts[0]*inspectionCounts[1])
 
 	return nil
 }
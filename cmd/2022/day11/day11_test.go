@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/debugger"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -136,3 +137,54 @@ func TestParseOperation(t *testing.T) {
 		}
 	}
 }
+
+func TestRoundSimulation(t *testing.T) {
+	factory, ok := debugger.Lookup(2022, 11)
+	assert.True(t, ok)
+
+	simulation, err := factory(`Monkey 0:
+  Starting items: 79, 98
+  Operation: new = old * 19
+  Test: divisible by 23
+    If true: throw to monkey 2
+    If false: throw to monkey 3
+
+Monkey 1:
+  Starting items: 54, 65, 75, 74
+  Operation: new = old + 6
+  Test: divisible by 19
+    If true: throw to monkey 2
+    If false: throw to monkey 0
+
+Monkey 2:
+  Starting items: 79, 60, 97
+  Operation: new = old * old
+  Test: divisible by 13
+    If true: throw to monkey 1
+    If false: throw to monkey 3
+
+Monkey 3:
+  Starting items: 74
+  Operation: new = old + 3
+  Test: divisible by 17
+    If true: throw to monkey 0
+    If false: throw to monkey 1
+`)
+	assert.NoError(t, err)
+
+	session := debugger.NewSession(simulation)
+
+	session.Forward(1)
+	assert.Equal(t, `Monkey 0: 20, 23, 27, 26
+Monkey 1: 2080, 25, 167, 207, 401, 1046
+Monkey 2: 
+Monkey 3: `, session.Render())
+	assert.Equal(t, "round 1: inspection counts [2 4 3 5]", session.Inspect())
+
+	session.Forward(DebuggerRounds)
+	assert.True(t, session.Finished)
+	assert.Equal(t, "round 20: inspection counts [101 95 7 105]", session.Inspect())
+
+	_, err = factory("Monkey zero:")
+	assert.Error(t, err)
+}
@@ -369,7 +369,7 @@ func day(fileContents string) error {
 	// ending position.
 	world := ParseWorld(fileContents)
 
-	fmt.Printf("Minimum moves %d\n", FindMinimumMovement(world))
+	utilities.Answer(1, "Minimum moves", FindMinimumMovement(world))
 
 	// Part 2: Let's plan a more scenic route to the destination.  What is the fewest steps
 	// required to move starting from any square with elevation a to the location that should
@@ -384,7 +384,7 @@ func day(fileContents string) error {
 
 	sort.Ints(movesCount)
 
-	fmt.Printf("Minimum moves from scenic positions %d\n", movesCount[0])
+	utilities.Answer(2, "Minimum moves from scenic positions", movesCount[0])
 
 	return nil
 }
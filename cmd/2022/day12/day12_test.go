@@ -7,6 +7,7 @@ package TwentyTwentyTwo_day12
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -344,3 +345,7 @@ abcccccccccaaaaccaaaaaaaaaaaaaaccaaccccccccccccccccccccccccccccccaaaaa`
 
 	assert.Equal(t, 352, FindMinimumMovement(w))
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
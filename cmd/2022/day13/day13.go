@@ -5,7 +5,6 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyTwo_day13
 
 import (
-	"fmt"
 	"io"
 	"log"
 	"os"
@@ -261,7 +260,7 @@ func day(fileContents string) error {
 		indexSum += index
 	}
 
-	fmt.Printf("Index sum %d\n", indexSum)
+	utilities.Answer(1, "Index sum", indexSum)
 
 	// Part 2: Break apart the pairs into individual packets.  Insert [[2]] and [[6]].  Sort the packets.
 	// The decoder key is the indices of [[2]] and [[6]] multiplied together.  What's the decoder key?
@@ -275,6 +274,6 @@ func day(fileContents string) error {
 	two := FindPacketIndex("[[2]]", list)
 	six := FindPacketIndex("[[6]]", list)
 
-	fmt.Printf("Decoder key %d\n", two*six)
+	utilities.Answer(2, "Decoder key", two*six)
 	return nil
 }
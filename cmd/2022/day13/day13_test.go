@@ -8,6 +8,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -238,3 +239,7 @@ func TestPacketSorting(t *testing.T) {
 		assert.Equal(t, test.expectedSortedOutput, output)
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
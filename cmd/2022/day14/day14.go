@@ -6,6 +6,7 @@ package TwentyTwentyTwo_day14
 
 import (
 	"fmt"
+	"image/color"
 	"io"
 	"log"
 	"math"
@@ -13,6 +14,8 @@ import (
 	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/render"
+	termcolor "github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -39,6 +42,15 @@ var Day14Cmd = &cobra.Command{
 	},
 }
 
+var animatePath string
+
+func init() {
+	Day14Cmd.Flags().StringVar(&animatePath, "animate", "", "write an animation of the sand falling into the abyss to a .gif file or numbered .png files")
+}
+
+// SandPerFrame keeps animations of thousands of units of sand to a reasonable size.
+const SandPerFrame = 5
+
 type Cell byte
 
 const (
@@ -189,6 +201,42 @@ func (c *Cave) Describe() string {
 	return description
 }
 
+// Size is the size of the cave for rendering, where Pixel's positions are relative
+// to the cave's origin.
+func (c *Cave) Size() utilities.Size2D {
+	return utilities.NewSize2D(c.Bounds.Size.W, c.Bounds.Size.H)
+}
+
+func (c *Cave) Pixel(p utilities.Point2D) Cell {
+	return c.GetCell(Point{p.X + c.Bounds.Origin.X, p.Y + c.Bounds.Origin.Y})
+}
+
+func NewRecorder() *render.Recorder[Cell] {
+	palette := render.NewPalette[Cell](color.RGBA{20, 20, 20, 255}).
+		Set(Edge, color.RGBA{120, 110, 100, 255}).
+		Set(Sand, color.RGBA{230, 200, 120, 255}).
+		Set(Source, color.RGBA{255, 80, 80, 255})
+
+	recorder := render.NewRecorder(palette)
+	recorder.Every = SandPerFrame
+
+	return recorder
+}
+
+func NewTerminal() *render.Terminal[Cell] {
+	return render.NewTerminal[Cell]().
+		Set(Edge, termcolor.FgHiBlack).
+		Set(Sand, termcolor.FgYellow).
+		Set(Source, termcolor.FgHiRed, termcolor.Bold)
+}
+
+// Render draws the cave like Describe, coloured by terminal.
+func (c *Cave) Render(terminal *render.Terminal[Cell]) string {
+	return terminal.Draw(c.Size(), func(p utilities.Point2D) string {
+		return getCellString(c.Pixel(p))
+	}, c.Pixel)
+}
+
 type DropResult int
 
 const (
@@ -343,11 +391,18 @@ func day(fileContents string) error {
 	// Part 1: How many units of sand come to rest before sand starts flowing into the abyss below?
 	cave := ParseCave(fileContents, true)
 
-	fmt.Println(cave.Describe())
+	fmt.Println(cave.Render(NewTerminal()))
+
+	var recorder *render.Recorder[Cell]
+	if animatePath != "" {
+		recorder = NewRecorder()
+	}
 
 	sandCount := 0
 
 	for {
+		recorder.Snapshot(cave.Size(), cave.Pixel)
+
 		result := cave.DropSand()
 		if result == SandFalling {
 			break
@@ -355,9 +410,19 @@ func day(fileContents string) error {
 		sandCount++
 	}
 
-	fmt.Printf("%d sand units come to rest before the others start flowing into the abyss.\n", sandCount)
+	recorder.Capture(cave.Size(), cave.Pixel)
+
+	if animatePath != "" {
+		if err := recorder.Save(animatePath); err != nil {
+			return err
+		}
+
+		fmt.Printf("Wrote %d frames to %s\n", recorder.FrameCount(), animatePath)
+	}
+
+	utilities.Answer(1, "Sand units at rest before the others start flowing into the abyss", sandCount)
 
-	fmt.Println(cave.Describe())
+	fmt.Println(cave.Render(NewTerminal()))
 
 	// Part 2: You misread the scan.  There isn't an infinite void.  You're standing on the floor.  It's
 	// an infinite horizontal line with a Y coordinate +2 of the highest Y coordinate of any point in your
@@ -375,7 +440,7 @@ func day(fileContents string) error {
 		sandCount2++
 	}
 
-	fmt.Printf("%d sand units come to rest before the source is blocked.\n", sandCount2)
+	utilities.Answer(2, "Sand units at rest before the source is blocked", sandCount2)
 
 	return nil
 }
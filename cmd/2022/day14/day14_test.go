@@ -5,8 +5,11 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyTwo_day14
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -160,3 +163,48 @@ func TestDropSandBlocked(t *testing.T) {
 		assert.Equal(t, SandBlocked, cave.DropSand())
 	}
 }
+
+func TestAnimateSand(t *testing.T) {
+	cave := ParseCave(`498,4 -> 498,6 -> 496,6
+503,4 -> 502,4 -> 502,9 -> 494,9`, true)
+
+	assert.Equal(t, utilities.NewSize2D(10, 10), cave.Size())
+	assert.Equal(t, Source, cave.Pixel(utilities.NewPoint2D(6, 0)))
+	assert.Equal(t, Edge, cave.Pixel(utilities.NewPoint2D(0, 9)))
+
+	recorder := NewRecorder()
+
+	for {
+		recorder.Snapshot(cave.Size(), cave.Pixel)
+
+		if cave.DropSand() == SandFalling {
+			break
+		}
+	}
+
+	recorder.Capture(cave.Size(), cave.Pixel)
+
+	// 24 units come to rest, so there are 25 snapshots plus the final frame.
+	assert.Equal(t, 5+1, recorder.FrameCount())
+	assert.Equal(t, Sand, cave.Pixel(utilities.NewPoint2D(6, 8)))
+}
+
+func TestRender(t *testing.T) {
+	cave := ParseCave(`498,4 -> 498,6 -> 496,6
+503,4 -> 502,4 -> 502,9 -> 494,9`, true)
+
+	cave.DropSand()
+
+	terminal := NewTerminal()
+
+	terminal.Enabled = false
+	assert.Equal(t, cave.Describe(), cave.Render(terminal))
+
+	terminal.Enabled = true
+	assert.Equal(t, "......\x1b[91;1m+\x1b[0;22m...", strings.Split(cave.Render(terminal), "\n")[0])
+	assert.Equal(t, "......\x1b[33mo\x1b[0m.\x1b[90m#\x1b[0m.", strings.Split(cave.Render(terminal), "\n")[8])
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -261,7 +261,7 @@ func day(fileContents string) error {
 	row := 2000000
 	invalidLocations := n.InvalidBeaconLocations(row)
 
-	fmt.Printf("Beacons cannot be in %d locations in row %d.\n", len(invalidLocations), row)
+	utilities.Answer(1, fmt.Sprintf("Locations in row %d that cannot hold a beacon", row), len(invalidLocations))
 
 	// Part 2: Given a sensor report containing sensor locations and the closest beacons to
 	// them, there is only a single location where the distress beacon can be.  You can calculate
@@ -271,11 +271,10 @@ func day(fileContents string) error {
 	validLocations := n.PossibleBeaconLocations()
 
 	if len(validLocations) > 1 {
-		fmt.Printf("Unexpected number of possible beacon locations: %d\n", len(validLocations))
-		return nil
+		return fmt.Errorf("unexpected number of possible beacon locations: %d", len(validLocations))
 	}
 
-	fmt.Printf("Distress beacon tuning frequency %d.\n", validLocations[0].X*4000000+validLocations[0].Y)
+	utilities.Answer(2, "Distress beacon tuning frequency", validLocations[0].X*4000000+validLocations[0].Y)
 
 	return nil
 }
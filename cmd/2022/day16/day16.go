@@ -19,7 +19,7 @@ import (
 // Day16Cmd represents the day16 command
 var Day16Cmd = &cobra.Command{
 	Use:   "day16",
-	Short: `Proboscidea Volcanium - NOT COMPLETED`,
+	Short: `Proboscidea Volcanium`,
 	Run: func(cmd *cobra.Command, args []string) {
 		df, err := os.Open(utilities.GetInputPath(cmd))
 		if err != nil {
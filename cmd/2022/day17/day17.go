@@ -5,21 +5,24 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyTwo_day17
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/debugger"
 	"github.com/spf13/cobra"
 )
 
 // Day17Cmd represents the day17 command
 var Day17Cmd = &cobra.Command{
 	Use:   "day17",
-	Short: `Pyroclastic Flow - NOT COMPLETED`,
+	Short: `Pyroclastic Flow`,
 	Run: func(cmd *cobra.Command, args []string) {
 		df, err := os.Open(utilities.GetInputPath(cmd))
 		if err != nil {
@@ -32,13 +35,31 @@ var Day17Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(string(fileContent))
+		err = day(utilities.GetContext(cmd), string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
 	},
 }
 
+const (
+	RoomWidth     = 7
+	DebuggerRocks = 2022
+	RenderedRows  = 20
+	ElephantRocks = 1000000000000
+)
+
+func init() {
+	debugger.Register(2022, 17, func(input string) (debugger.Simulation, error) {
+		jetDirections, err := ParseJetDirections(strings.TrimSpace(input))
+		if err != nil {
+			return nil, err
+		}
+
+		return &DropSimulation{Room: NewRoom(RoomWidth, jetDirections), Limit: DebuggerRocks}, nil
+	})
+}
+
 type Column []bool
 
 type Tower struct {
@@ -73,6 +94,20 @@ func (t *Tower) GetHeights() []int64 {
 	return heights
 }
 
+// CountFullRows counts the rows that are blocked all the way across.
+func (t *Tower) CountFullRows() int {
+	full := byte(0xff) << (8 - t.Width)
+	count := 0
+
+	for _, row := range t.Rows {
+		if row == full {
+			count++
+		}
+	}
+
+	return count
+}
+
 func (t *Tower) AddEmptyRows(count int) {
 	for i := 0; i < count; i++ {
 		t.Rows = append(t.Rows, 0)
@@ -210,6 +245,78 @@ func (r *Room) DropShape() {
 	}
 }
 
+// Describe draws the top rows of the tower the way the puzzle does, with the floor
+// once the bottom of the tower is in view.
+func (r *Room) Describe(rows int) string {
+	lines := make([]string, 0, rows+1)
+
+	top := r.GetTowerHeight() - 1
+	bottom := max(top-int64(rows)+1, 0)
+
+	for y := top; y >= bottom; y-- {
+		var b strings.Builder
+
+		b.WriteString("|")
+
+		mask := byte(0x80)
+		for x := 0; x < r.Tower.Width; x++ {
+			if r.Tower.Rows[y]&mask != 0 {
+				b.WriteString("#")
+			} else {
+				b.WriteString(".")
+			}
+			mask >>= 1
+		}
+
+		b.WriteString("|")
+
+		lines = append(lines, b.String())
+	}
+
+	if bottom == 0 {
+		lines = append(lines, "+"+strings.Repeat("-", r.Tower.Width)+"+")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// DropSimulation drops one rock per step in the debugger.
+type DropSimulation struct {
+	Room     *Room
+	Rocks    int
+	Limit    int
+	fullRows int
+	filled   bool
+}
+
+func (s *DropSimulation) Step() bool {
+	s.Room.DropShape()
+	s.Rocks++
+
+	fullRows := s.Room.Tower.CountFullRows()
+	s.filled = fullRows > s.fullRows
+	s.fullRows = fullRows
+
+	return s.Rocks >= s.Limit
+}
+
+func (s *DropSimulation) Render() string {
+	return s.Room.Describe(RenderedRows)
+}
+
+func (s *DropSimulation) Inspect() string {
+	return fmt.Sprintf("%d rocks, tower height %d, %d full rows, next shape %d, next jet %d of %d",
+		s.Rocks, s.Room.GetTowerHeight(), s.fullRows, s.Room.NextShape, s.Room.NextJetDirection, len(s.Room.JetDirections))
+}
+
+// Breakpoints stops when a rock completes a row all the way across, which nothing
+// below can be seen through.
+func (s *DropSimulation) Breakpoints() map[string]func() bool {
+	return map[string]func() bool{
+		"full-row": func() bool { return s.filled },
+	}
+}
+
 type JetDirection int
 type JetDirectionList []JetDirection
 
@@ -238,7 +345,7 @@ func ParseJetDirections(line string) (JetDirectionList, error) {
 	return directionList, nil
 }
 
-func day(fileContents string) error {
+func day(ctx context.Context, fileContents string) error {
 	jetDirections, err := ParseJetDirections(fileContents)
 	if err != nil {
 		return err
@@ -251,19 +358,25 @@ func day(fileContents string) error {
 		room.DropShape()
 	}
 
-	fmt.Printf("Tower height: %d\n", room.GetTowerHeight())
+	utilities.Answer(1, "Tower height", room.GetTowerHeight())
 
 	// Part 2: Elephants still don't believe you.  They want you to drop 1,000,000,000,000 rocks.  Now how tall will the tower of rocks be?
 	room = NewRoom(7, jetDirections)
 
-	for i := 0; i < 1000000000000; i++ {
-		if i%10000 == 0 {
-			fmt.Println("Shape", i)
+	progress := utilities.NewProgress("rocks", ElephantRocks)
+
+	for i := 0; i < ElephantRocks; i++ {
+		if err := utilities.Interrupted(ctx); err != nil {
+			return fmt.Errorf("stopped after %d of %d rocks with tower height %d: %w", i, ElephantRocks, room.GetTowerHeight(), err)
 		}
+
 		room.DropShape()
+		progress.Add(1)
 	}
 
-	fmt.Printf("Tower height: %d\n", room.GetTowerHeight())
+	progress.Done()
+
+	utilities.Answer(2, "Tower height", room.GetTowerHeight())
 
 	return nil
 }
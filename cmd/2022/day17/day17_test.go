@@ -5,9 +5,13 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyTwo_day17
 
 import (
+	"context"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/debugger"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -397,3 +401,59 @@ func TestTower_DropShape(t *testing.T) {
 		assert.Equal(t, test.expectedHeight, room.GetTowerHeight())
 	}
 }
+
+func TestDropSimulation(t *testing.T) {
+	factory, ok := debugger.Lookup(2022, 17)
+	assert.True(t, ok)
+
+	simulation, err := factory(">>><<><>><<<>><>>><<<>>><<<><<<>><>><<>>\n")
+	assert.NoError(t, err)
+
+	session := debugger.NewSession(simulation)
+	assert.Equal(t, "+-------+", session.Render())
+
+	session.Forward(2)
+	assert.Equal(t, `|...#...|
+|..###..|
+|...#...|
+|..####.|
++-------+`, session.Render())
+	assert.Equal(t, "2 rocks, tower height 4, 0 full rows, next shape 2, next jet 8 of 40", session.Inspect())
+
+	// No row in the example is ever blocked all the way across.
+	session.Toggle("full-row")
+	assert.Equal(t, "", session.Continue(nil))
+	assert.True(t, session.Finished)
+	assert.Equal(t, DebuggerRocks, session.Step())
+	assert.Contains(t, session.Inspect(), "tower height 3068")
+
+	jetDirections, err := ParseJetDirections(">")
+	assert.NoError(t, err)
+
+	// The first rock is blown against the right wall and drops in beside the
+	// three blocks already on the floor.
+	simulation = &DropSimulation{Room: NewRoom(RoomWidth, jetDirections), Limit: 2}
+	simulation.(*DropSimulation).Room.Tower.Rows = []byte{0xe0}
+
+	session = debugger.NewSession(simulation)
+	session.Toggle("full-row")
+	assert.Equal(t, "full-row", session.Continue(nil))
+	assert.Equal(t, 1, session.Step())
+
+	_, err = factory("<<>x")
+	assert.Error(t, err)
+}
+
+func TestDayTimeout(t *testing.T) {
+	ctx, cancel := utilities.WithLimits(context.Background(), time.Nanosecond, 0)
+	defer cancel()
+	<-ctx.Done()
+
+	output, err := utilities.CaptureOutput(func() {
+		assert.EqualError(t, day(ctx, ">>><<><>><<<>><>>><<<>>><<<><<<>><>><<>>"), "stopped after 0 of 1000000000000 rocks with tower height 0: timed out after 1ns")
+	})
+	assert.NoError(t, err)
+
+	// Part 1 is still answered.
+	assert.Contains(t, output, "Tower height: 3068\n")
+}
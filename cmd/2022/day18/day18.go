@@ -337,11 +337,11 @@ func day(fileContents string) error {
 	fmt.Printf("Empty cubes: %d\n", g.Bounds.D*g.Bounds.H*g.Bounds.W-len(g.Cubes))
 
 	// Part 1: After reading in the scanner report, what is the surface area of the lava droplet?
-	fmt.Printf("Lava droplets surface area: %d units\n", g.GetSurfaceArea())
+	utilities.Answer(1, "Lava droplets surface area", g.GetSurfaceArea())
 
 	// Part 2: Ignore the surfaces that are trapped within the droplets.  What is the exterior
 	// surface area of the lava droplet?
-	fmt.Printf("Lava droplets external surface area: %d units\n", g.GetExternalSurfaceArea())
+	utilities.Answer(2, "Lava droplets external surface area", g.GetExternalSurfaceArea())
 
 	return nil
 }
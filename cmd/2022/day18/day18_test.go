@@ -7,6 +7,7 @@ package TwentyTwentyTwo_day18
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -79,3 +80,7 @@ func TestGetExteriorSurfaceArea(t *testing.T) {
 
 	assert.Equal(t, 58, g.GetExternalSurfaceArea())
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -18,7 +18,7 @@ import (
 // Day20Cmd represents the day20 command
 var Day20Cmd = &cobra.Command{
 	Use:   "day20",
-	Short: `Grove Positioning System - NOT COMPLETED`,
+	Short: `Grove Positioning System`,
 	Run: func(cmd *cobra.Command, args []string) {
 		df, err := os.Open(utilities.GetInputPath(cmd))
 		if err != nil {
@@ -181,7 +181,7 @@ func day(fileContents string) error {
 		sum += c
 	}
 
-	fmt.Printf("Sum of grove coordinates: %d\n", sum)
+	utilities.Answer(1, "Sum of grove coordinates", sum)
 
 	// Part 2: Ignore the surfaces that are trapped within the droplets.  What is the exterior
 	// surface area of the lava droplet?
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -231,3 +232,7 @@ func TestGetCoordinates(t *testing.T) {
 	wl := ParseWrappedList(str)
 	assert.Equal(t, [3]int{4, -3, 2}, wl.GetCoordinates())
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"os"
 	"strings"
 
@@ -32,356 +33,188 @@ var Day21Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(string(fileContent))
+		err = day(utilities.GetLogger(cmd), string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
 	},
 }
 
-type OperationFn func(a, b int) int
-
-type TreeNodeLookupMap map[string]*TreeNode
-
-type TreeRoot struct {
-	Lookup TreeNodeLookupMap
-}
-
-func NewTreeRoot(lookup TreeNodeLookupMap) *TreeRoot {
-	return &TreeRoot{Lookup: lookup}
-}
+const (
+	RootMonkeyName  = "root"
+	HumanName       = "humn"
+	MonkeyOperators = "+-*/"
+)
 
-func (t *TreeRoot) FindNode(nodeName string) *TreeNode {
-	return t.Lookup[nodeName]
+// Monkey yells a number, or the result of an operation on the numbers two other
+// monkeys yell.
+type Monkey struct {
+	Name   string
+	Number int64
+	Op     byte
+	Left   string
+	Right  string
 }
 
-func (t *TreeRoot) Evaluate(nodeName string) int {
-	node := t.FindNode(nodeName)
-
-	if node.Leaf {
-		return node.Value
-	}
-
-	// Parent node, evaluate the left and right children.
-	node.LeftValue = t.Evaluate(node.Left)
-	node.RightValue = t.Evaluate(node.Right)
+type Monkeys map[string]*Monkey
 
-	// If either of the children are poisoned, we can't evaluate.  Return a sentinel for debugging.
-	if node.LeftPoisoned || node.RightPoisoned {
-		return -1
-	}
+func ParseMonkeys(fileContents string) (Monkeys, error) {
+	monkeys := make(Monkeys)
 
-	return node.Op(node.LeftValue, node.RightValue)
-}
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		var n string
+		var operation byte
+		var src1MonkeyName string
+		var src2MonkeyName string
 
-func (t *TreeRoot) Solve(nodeName string, result int) int {
-	// Follow the poisoned path, evaluating each node until
-	// we get to the poisoned node.
+		// Try line of "monkeyName: monkeyName OP monkeyName" form first.
+		count, err := fmt.Sscanf(line, "%s %s %c %s", &n, &src1MonkeyName, &operation, &src2MonkeyName)
+		if err == nil && count == 4 {
+			if !strings.ContainsRune(MonkeyOperators, rune(operation)) {
+				return nil, fmt.Errorf("invalid operation in '%s'", line)
+			}
 
-	node := t.FindNode(nodeName)
+			monkeyName := strings.TrimSuffix(n, ":")
+			monkeys[monkeyName] = &Monkey{Name: monkeyName, Op: operation, Left: src1MonkeyName, Right: src2MonkeyName}
 
-	// Handle root special.
-	if nodeName == RootMonkeyName {
-		if node.LeftPoisoned {
-			result = node.RightValue
-			node = t.FindNode(node.Left)
-		} else {
-			result = node.LeftValue
-			node = t.FindNode(node.Right)
+			continue
 		}
-	}
 
-	if node.Poisoned {
-		return result
-	}
-
-	// There should only be one poisoned child...
-	if node.LeftPoisoned {
-		newResult := NodeSolve(node, node.RightValue, result)
-		return t.Solve(node.Left, newResult)
-	} else {
-		newResult := NodeSolve(node, node.LeftValue, result)
-		return t.Solve(node.Right, newResult)
-	}
-}
+		// Try line of "monkeyName: number" form.
+		var number int64
 
-func (t *TreeRoot) PromotePoison(nodeName string) bool {
-	node := t.Lookup[nodeName]
-
-	if !node.Leaf {
-		if t.PromotePoison(node.Left) {
-			node.LeftPoisoned = true
+		count, err = fmt.Sscanf(line, "%s %d", &n, &number)
+		if err != nil {
+			return nil, err
 		}
-
-		if t.PromotePoison(node.Right) {
-			node.RightPoisoned = true
+		if count != 2 {
+			return nil, errors.New("invalid monkey line")
 		}
 
-		return node.LeftPoisoned || node.RightPoisoned
+		monkeyName := strings.TrimSuffix(n, ":")
+		monkeys[monkeyName] = &Monkey{Name: monkeyName, Number: number}
 	}
 
-	return node.Poisoned
-}
-
-type TreeNode struct {
-	Poisoned bool
-
-	Name string
-
-	// Each node is either a leaf and has a value or has children and an operation.
-	Leaf bool
-
-	Value int
+	if _, ok := monkeys[RootMonkeyName]; !ok {
+		return nil, errors.New("missing root monkey")
+	}
 
-	Op            OperationFn
-	InvertOpLeft  OperationFn
-	InvertOpRight OperationFn
-	Left          string
-	LeftPoisoned  bool
-	LeftValue     int
-	Right         string
-	RightPoisoned bool
-	RightValue    int
+	return monkeys, nil
 }
 
-const RootMonkeyName = "root"
-
-var AddOp = func(a, b int) int { return a + b }
-var InvertAddLeft = func(a, b int) int { return b - a }
-var InvertAddRight = func(a, b int) int { return b - a }
-
-var SubtractOp = func(a, b int) int { return a - b }
-var InvertSubtractLeft = func(a, b int) int { return b + a }
-var InvertSubtractRight = func(a, b int) int { return a - b }
-
-var MultiplyOp = func(a, b int) int { return a * b }
-var InvertMultiplyLeft = func(a, b int) int { return b / a }
-var InvertMultiplyRight = func(a, b int) int { return b / a }
-
-var DivideOp = func(a, b int) int { return a / b }
-var InvertDivideLeft = func(a, b int) int { return b * a }
-var InvertDivideRight = func(a, b int) int { return a / b }
-
-func NewLeafNode(name string, value int) *TreeNode {
-	return &TreeNode{Name: name, Leaf: true, Value: value}
+// Expression builds the expression for the number a monkey yells. When unknown
+// names a monkey, it's an unknown rather than the number that monkey yells.
+func (m Monkeys) Expression(name, unknown string) (Expr, error) {
+	return m.expression(name, unknown, make(map[string]bool))
 }
 
-func NodeSolve(node *TreeNode, a, result int) int {
-	if node.LeftPoisoned {
-		return node.InvertOpLeft(a, result)
+func (m Monkeys) expression(name, unknown string, waiting map[string]bool) (Expr, error) {
+	if name == unknown {
+		return &Variable{Name: name}, nil
 	}
-	return node.InvertOpRight(a, result)
-}
 
-func NewParentNode(name string, operation byte) *TreeNode {
-	n := &TreeNode{Name: name, Leaf: false}
-
-	switch operation {
-	case '+':
-		n.Op = AddOp
-		n.InvertOpLeft = InvertAddLeft
-		n.InvertOpRight = InvertAddRight
-	case '-':
-		n.Op = SubtractOp
-		n.InvertOpLeft = InvertSubtractLeft
-		n.InvertOpRight = InvertSubtractRight
-	case '*':
-		n.Op = MultiplyOp
-		n.InvertOpLeft = InvertMultiplyLeft
-		n.InvertOpRight = InvertMultiplyRight
-	case '/':
-		n.Op = DivideOp
-		n.InvertOpLeft = InvertDivideLeft
-		n.InvertOpRight = InvertDivideRight
-	default:
-		panic("invalid operation")
+	monkey, ok := m[name]
+	if !ok {
+		return nil, fmt.Errorf("no monkey named '%s'", name)
 	}
 
-	return n
-}
-
-func CreateTree(poisonName string, fileContents string) (*TreeRoot, error) {
-	lookupMap := make(TreeNodeLookupMap)
-	var rootNode *TreeNode
-
-	for _, line := range strings.Split(fileContents, "\n") {
-
-		var n string
-		var operation byte
-		var src1MonkeyName string
-		var src2MonkeyName string
-
-		// Try line of "monkeyName: monkeyName OP monkeyName" form first.
-		count, err := fmt.Sscanf(line, "%s %s %c %s", &n, &src1MonkeyName, &operation, &src2MonkeyName)
-		if err == nil && count == 4 {
-			monkeyName := strings.TrimSuffix(n, ":")
-
-			node := NewParentNode(monkeyName, operation)
-
-			node.Left = src1MonkeyName
-			node.Right = src2MonkeyName
-
-			// Remember root node.
-			if node.Name == RootMonkeyName {
-				rootNode = node
-			}
-
-			// Add the node to the lookup table.
-			lookupMap[monkeyName] = node
-
-		} else {
-			// Try line of "monkeyName: number" form.
-			var number int
-
-			count, err = fmt.Sscanf(line, "%s %d", &n, &number)
-			if err != nil {
-				return nil, err
-			}
-			if count != 2 {
-				return nil, errors.New("invalid monkey line")
-			}
-
-			monkeyName := strings.TrimSuffix(n, ":")
-
-			node := NewLeafNode(monkeyName, number)
-
-			if monkeyName == poisonName {
-				node.Poisoned = true
-
-				// Let's stick a sentinel value here for debugging...
-				node.Value = -1
-			}
-
-			// Add the node to the lookup table.
-			lookupMap[monkeyName] = node
-		}
+	if monkey.Op == 0 {
+		return NewConstant(monkey.Number), nil
 	}
 
-	treeRoot := NewTreeRoot(lookupMap)
-
-	// Now walk through the tree, bottom up, bringing up the poison flag.
-	treeRoot.PromotePoison(RootMonkeyName)
-
-	if rootNode.LeftPoisoned && rootNode.RightPoisoned {
-		return nil, errors.New("both sides of the root tree are poisoned")
+	if waiting[name] {
+		return nil, fmt.Errorf("monkey '%s' is waiting on itself", name)
 	}
 
-	return treeRoot, nil
-}
+	waiting[name] = true
+	defer delete(waiting, name)
 
-type MonkeyChannel chan int
+	left, err := m.expression(monkey.Left, unknown, waiting)
+	if err != nil {
+		return nil, err
+	}
 
-func GetOrCreateMonkeyChannel(monkeyChannelMap map[string]MonkeyChannel, monkeyName string) MonkeyChannel {
-	mc, ok := monkeyChannelMap[monkeyName]
-	if !ok {
-		// Need to create the channel.
-		mc = make(MonkeyChannel, 1)
-		monkeyChannelMap[monkeyName] = mc
+	right, err := m.expression(monkey.Right, unknown, waiting)
+	if err != nil {
+		return nil, err
 	}
 
-	return mc
+	return &Operation{Op: monkey.Op, Left: left, Right: right}, nil
 }
 
-func CreateChannels(fileContents string) (MonkeyChannel, error) {
-	monkeyChannelMap := make(map[string]MonkeyChannel)
-
-	for _, line := range strings.Split(fileContents, "\n") {
+// Equation is what the root monkey checks once it turns out to be testing its two
+// numbers for equality, simplified.
+func (m Monkeys) Equation(unknown string) (Equation, error) {
+	root := m[RootMonkeyName]
 
-		var monkeyName string
-		var operation byte
-		var src1MonkeyName string
-		var src2MonkeyName string
-
-		// Try line of "monkeyName: monkeyName OP monkeyName" form first.
-		count, err := fmt.Sscanf(line, "%s %s %c %s", &monkeyName, &src1MonkeyName, &operation, &src2MonkeyName)
-		if err == nil && count == 4 {
-			// Has this monkey channel already been created?
-			monkeyChannel := GetOrCreateMonkeyChannel(monkeyChannelMap, strings.TrimSuffix(monkeyName, ":"))
-
-			// Get the channels for our dependencies.
-			src1MonkeyChannel := GetOrCreateMonkeyChannel(monkeyChannelMap, src1MonkeyName)
-			src2MonkeyChannel := GetOrCreateMonkeyChannel(monkeyChannelMap, src2MonkeyName)
-
-			// Now spin up a go routine to wait for our dependencies.
-			switch operation {
-			case '+':
-				go func() {
-					num1 := <-src1MonkeyChannel
-					num2 := <-src2MonkeyChannel
-					monkeyChannel <- num1 + num2
-				}()
-			case '-':
-				go func() {
-					num1 := <-src1MonkeyChannel
-					num2 := <-src2MonkeyChannel
-					monkeyChannel <- num1 - num2
-				}()
-			case '*':
-				go func() {
-					num1 := <-src1MonkeyChannel
-					num2 := <-src2MonkeyChannel
-					monkeyChannel <- num1 * num2
-				}()
-			case '/':
-				go func() {
-					num1 := <-src1MonkeyChannel
-					num2 := <-src2MonkeyChannel
-					monkeyChannel <- num1 / num2
-				}()
-			default:
-				return nil, errors.New("invalid operation")
-			}
-		} else {
-			// Try line of "monkeyName: number" form.
-			var number int
+	if root.Op == 0 {
+		return Equation{}, errors.New("root monkey doesn't compare anything")
+	}
 
-			count, err = fmt.Sscanf(line, "%s %d", &monkeyName, &number)
-			if err != nil {
-				return nil, err
-			}
-			if count != 2 {
-				return nil, errors.New("invalid monkey line")
-			}
+	sides := make([]Linear, 0, 2)
 
-			// Has this monkey channel already been created?
-			monkeyChannel := GetOrCreateMonkeyChannel(monkeyChannelMap, strings.TrimSuffix(monkeyName, ":"))
+	for _, name := range []string{root.Left, root.Right} {
+		e, err := m.Expression(name, unknown)
+		if err != nil {
+			return Equation{}, err
+		}
 
-			// Push the number into the channel
-			monkeyChannel <- number
+		side, err := Simplify(e)
+		if err != nil {
+			return Equation{}, err
 		}
-	}
 
-	rootChannel, ok := monkeyChannelMap[RootMonkeyName]
-	if !ok {
-		return nil, errors.New("missing root channel")
+		sides = append(sides, side)
 	}
 
-	return rootChannel, nil
+	return Equation{Left: sides[0], Right: sides[1]}, nil
 }
 
-func day(fileContents string) error {
+func day(logger *slog.Logger, fileContents string) error {
+	monkeys, err := ParseMonkeys(fileContents)
+	if err != nil {
+		return err
+	}
 
 	// Part 1: Monkeys yell numbers.  Other monkeys listen for specific other monkeys and do math on the numbers they here.
 	// root is the alpha monkey.  What number will it yell?
-	rootChannel, err := CreateChannels(fileContents)
+	root, err := monkeys.Expression(RootMonkeyName, "")
 	if err != nil {
 		return err
 	}
 
-	// Now wait for the output of RootMonkeyName channel.
-	fmt.Printf("Root will yell: %d\n", <-rootChannel)
+	yelled, err := Simplify(root)
+	if err != nil {
+		return err
+	}
+
+	number, err := Whole(yelled.B)
+	if err != nil {
+		return fmt.Errorf("root would yell %w", err)
+	}
+
+	utilities.Answer(1, "Root will yell", number)
 
 	// Part 2: Confusion!  root monkey isn't doing math on its two dependent numbers: it's equality.  Both numbers need to be the same.
 	// And humn monkey isn't a monkey, it's you!  So what number do you have to yell such that root's two dependent numbers are equal?
-	t, err := CreateTree("humn", fileContents)
+	equation, err := monkeys.Equation(HumanName)
 	if err != nil {
 		return err
 	}
 
-	t.Evaluate(RootMonkeyName)
+	logger.Debug("root compares", "equation", equation.String())
+
+	solution, err := equation.Solve()
+	if err != nil {
+		return err
+	}
+
+	number, err = Whole(solution)
+	if err != nil {
+		return fmt.Errorf("you would have to yell %w", err)
+	}
 
-	result := t.Solve(RootMonkeyName, 0)
-	fmt.Printf("You should yell: %d\n", result)
+	utilities.Answer(2, "You should yell", number)
 
 	return nil
 }
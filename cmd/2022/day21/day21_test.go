@@ -5,13 +5,15 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyTwo_day21
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestCreateChannels(t *testing.T) {
-	src := `root: pppw + sjmn
+const exampleMonkeys = `root: pppw + sjmn
 dbpl: 5
 cczh: sllz + lgvd
 zczc: 2
@@ -25,166 +27,126 @@ sllz: 4
 pppw: cczh / lfqf
 lgvd: ljgn * ptdq
 drzm: hmdt - zczc
-hmdt: 32`
+hmdt: 32
+`
 
-	rootChannel, err := CreateChannels(src)
+func TestParseMonkeys(t *testing.T) {
+	monkeys, err := ParseMonkeys(exampleMonkeys)
 	assert.NoError(t, err)
-	if err != nil {
-		assert.FailNow(t, "CreateChannels error", err)
-	}
+	assert.Len(t, monkeys, 15)
+
+	assert.Equal(t, &Monkey{Name: "root", Op: '+', Left: "pppw", Right: "sjmn"}, monkeys["root"])
+	assert.Equal(t, &Monkey{Name: "humn", Number: 5}, monkeys["humn"])
+
+	_, err = ParseMonkeys("root: aaaa % bbbb\naaaa: 1\nbbbb: 2")
+	assert.Error(t, err)
 
-	number := <-rootChannel
-	assert.Equal(t, 152, number)
+	_, err = ParseMonkeys("aaaa: 1")
+	assert.Error(t, err)
 }
 
-func TestCreateTree(t *testing.T) {
-	src := `root: pppw + sjmn
-dbpl: 5
-cczh: sllz + lgvd
-zczc: 2
-ptdq: humn - dvpt
-dvpt: 3
-lfqf: 4
-humn: 5
-ljgn: 2
-sjmn: drzm * dbpl
-sllz: 4
-pppw: cczh / lfqf
-lgvd: ljgn * ptdq
-drzm: hmdt - zczc
-hmdt: 32`
+func TestExpression(t *testing.T) {
+	monkeys, err := ParseMonkeys(exampleMonkeys)
+	assert.NoError(t, err)
 
-	_, err := CreateTree("humn", src)
+	e, err := monkeys.Expression("pppw", HumanName)
 	assert.NoError(t, err)
-}
+	assert.Equal(t, "((4 + (2 * (humn - 3))) / 4)", e.String())
 
-func TestEvaluate(t *testing.T) {
-	src := `root: pppw + sjmn
-dbpl: 5
-cczh: sllz + lgvd
-zczc: 2
-ptdq: humn - dvpt
-dvpt: 3
-lfqf: 4
-humn: 5
-ljgn: 2
-sjmn: drzm * dbpl
-sllz: 4
-pppw: cczh / lfqf
-lgvd: ljgn * ptdq
-drzm: hmdt - zczc
-hmdt: 32`
+	_, err = monkeys.Expression("nope", HumanName)
+	assert.Error(t, err)
 
-	treeRoot, err := CreateTree("humn", src)
+	looping, err := ParseMonkeys("root: aaaa + bbbb\naaaa: bbbb * 2\nbbbb: aaaa - 1")
 	assert.NoError(t, err)
 
-	treeRoot.Evaluate(RootMonkeyName)
+	_, err = looping.Expression(RootMonkeyName, "")
+	assert.Error(t, err)
+}
 
-	rootNode := treeRoot.FindNode(RootMonkeyName)
+func TestSimplify(t *testing.T) {
+	humn := &Variable{Name: HumanName}
+
+	type test struct {
+		e        Expr
+		expected string
+		err      error
+	}
+
+	tests := []test{
+		{e: NewConstant(7), expected: "7"},
+		{e: humn, expected: "humn"},
+		{e: &Operation{Op: '-', Left: NewConstant(0), Right: humn}, expected: "-humn"},
+		// Integer division would make this 1.
+		{e: &Operation{Op: '/', Left: NewConstant(3), Right: NewConstant(2)}, expected: "3/2"},
+		{e: &Operation{Op: '*', Left: &Operation{Op: '/', Left: NewConstant(3), Right: NewConstant(2)}, Right: NewConstant(2)}, expected: "3"},
+		{e: &Operation{Op: '/', Left: &Operation{Op: '-', Left: humn, Right: NewConstant(3)}, Right: NewConstant(2)}, expected: "1/2·humn - 3/2"},
+		{e: &Operation{Op: '*', Left: NewConstant(4), Right: &Operation{Op: '+', Left: humn, Right: NewConstant(1)}}, expected: "4·humn + 4"},
+		{e: &Operation{Op: '-', Left: humn, Right: humn}, expected: "0"},
+		{e: &Operation{Op: '*', Left: humn, Right: humn}, err: ErrNonLinear},
+		{e: &Operation{Op: '/', Left: NewConstant(1), Right: humn}, err: ErrNonLinear},
+		{e: &Operation{Op: '+', Left: humn, Right: &Variable{Name: "you"}}, err: ErrNonLinear},
+		{e: &Operation{Op: '/', Left: humn, Right: &Operation{Op: '-', Left: NewConstant(2), Right: NewConstant(2)}}, err: ErrDivideByZero},
+	}
+
+	for _, test := range tests {
+		l, err := Simplify(test.e)
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, test.e.String())
+			continue
+		}
 
-	if rootNode.LeftPoisoned {
-		assert.Equal(t, 150, rootNode.RightValue)
-	} else {
-		assert.Equal(t, 150, rootNode.LeftValue)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, l.String(), test.e.String())
 	}
 }
 
+func TestEquation(t *testing.T) {
+	monkeys, err := ParseMonkeys(exampleMonkeys)
+	assert.NoError(t, err)
+
+	equation, err := monkeys.Equation(HumanName)
+	assert.NoError(t, err)
+	assert.Equal(t, "1/2·humn - 1/2 = 150", equation.String())
+
+	solution, err := equation.Solve()
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewRat(301, 1), solution)
+}
+
 func TestSolve(t *testing.T) {
-	src := `root: pppw + sjmn
-dbpl: 5
-cczh: sllz + lgvd
-zczc: 2
-ptdq: humn - dvpt
-dvpt: 3
-lfqf: 4
-humn: 5
-ljgn: 2
-sjmn: drzm * dbpl
-sllz: 4
-pppw: cczh / lfqf
-lgvd: ljgn * ptdq
-drzm: hmdt - zczc
-hmdt: 32`
+	linear := func(a, b int64) Linear {
+		return Linear{A: big.NewRat(a, 1), B: big.NewRat(b, 1), Variable: HumanName}
+	}
 
-	treeRoot, err := CreateTree("humn", src)
+	solution, err := Equation{Left: linear(2, 1), Right: linear(0, 8)}.Solve()
 	assert.NoError(t, err)
+	assert.Equal(t, "7/2", solution.RatString())
 
-	treeRoot.Evaluate(RootMonkeyName)
+	_, err = Whole(solution)
+	assert.ErrorIs(t, err, ErrNotWhole)
 
-	result := treeRoot.Solve(RootMonkeyName, 0)
+	_, err = Equation{Left: linear(2, 1), Right: linear(2, 8)}.Solve()
+	assert.ErrorIs(t, err, ErrNoUniqueSolution)
 
-	assert.Equal(t, 301, result)
+	_, err = Equation{Left: linear(2, 1), Right: linear(2, 1)}.Solve()
+	assert.ErrorIs(t, err, ErrNoUniqueSolution)
 }
 
-func TestNewLeafNode(t *testing.T) {
-	type testCase struct {
-		name         string
-		value        int
-		expectedNode TreeNode
-	}
-	testCases := []testCase{
-		{"root", 100, TreeNode{Name: "root", Value: 100, Leaf: true}},
-		{"argb", 100, TreeNode{Name: "argb", Value: 100, Leaf: true}},
-	}
+func TestEvaluate(t *testing.T) {
+	monkeys, err := ParseMonkeys(exampleMonkeys)
+	assert.NoError(t, err)
 
-	for _, test := range testCases {
-		assert.Equal(t, test.expectedNode, *NewLeafNode(test.name, test.value))
-	}
-}
+	e, err := monkeys.Expression(RootMonkeyName, "")
+	assert.NoError(t, err)
 
-func TestNewParentNode(t *testing.T) {
-	type testCase struct {
-		name          string
-		value         int
-		expectedName  string
-		expectedValue int
-	}
-	testCases := []testCase{
-		{"root", 100, "root", 0},
-		{"argb", 100, "argb", 0},
-	}
+	yelled, err := Simplify(e)
+	assert.NoError(t, err)
 
-	for _, test := range testCases {
-		n := NewParentNode(test.name, '+')
-		assert.Equal(t, test.expectedName, n.Name)
-		assert.Equal(t, test.expectedValue, n.Value)
-	}
+	number, err := Whole(yelled.B)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(152), number.Int64())
 }
 
-func TestNodeSolve(t *testing.T) {
-	type testCase struct {
-		knownValue           int
-		knownResult          int
-		leftSolve            bool
-		op                   byte
-		expectedUnknownValue int
-	}
-
-	testCases := []testCase{
-		// x + a = b
-		{5, 100, true, '+', 95},
-		// x - a = b
-		{5, 100, true, '-', 105},
-		// x / a = b
-		{5, 100, true, '/', 500},
-		// x * a = b
-		{5, 100, true, '*', 20},
-		// a + x = b
-		{5, 100, false, '+', 95},
-		// a - x = b
-		{500, 100, false, '-', 400},
-		// a / x = b
-		{20, 5, false, '/', 4},
-		// a * x = b
-		{5, 100, false, '*', 20},
-	}
-	for _, test := range testCases {
-		n := NewParentNode("test", test.op)
-		if test.leftSolve {
-			n.LeftPoisoned = true
-		} else {
-			n.RightPoisoned = true
-		}
-		assert.Equal(t, test.expectedUnknownValue, NodeSolve(n, test.knownValue, test.knownResult))
-	}
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
 }
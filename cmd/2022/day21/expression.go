@@ -0,0 +1,214 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyTwo_day21
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var ErrNonLinear = errors.New("not linear in the unknown")
+var ErrDivideByZero = errors.New("division by zero")
+var ErrNoUniqueSolution = errors.New("no unique solution")
+var ErrNotWhole = errors.New("not a whole number")
+
+// Expr is an arithmetic expression over exact rationals, with at most one unknown.
+type Expr interface {
+	String() string
+}
+
+type Constant struct {
+	Value *big.Rat
+}
+
+func NewConstant(value int64) *Constant {
+	return &Constant{Value: big.NewRat(value, 1)}
+}
+
+func (c *Constant) String() string {
+	return c.Value.RatString()
+}
+
+type Variable struct {
+	Name string
+}
+
+func (v *Variable) String() string {
+	return v.Name
+}
+
+type Operation struct {
+	Op    byte
+	Left  Expr
+	Right Expr
+}
+
+func (o *Operation) String() string {
+	return fmt.Sprintf("(%s %c %s)", o.Left, o.Op, o.Right)
+}
+
+// Linear is a·Variable + b. With no variable, or a of 0, it's just the constant b.
+type Linear struct {
+	A        *big.Rat
+	B        *big.Rat
+	Variable string
+}
+
+func constantLinear(b *big.Rat) Linear {
+	return Linear{A: new(big.Rat), B: b}
+}
+
+func (l Linear) IsConstant() bool {
+	return l.A.Sign() == 0
+}
+
+// String writes the form the way it'd be written by hand, like 3/2·humn - 7.
+func (l Linear) String() string {
+	if l.IsConstant() {
+		return l.B.RatString()
+	}
+
+	var b strings.Builder
+
+	switch {
+	case l.A.Cmp(big.NewRat(1, 1)) == 0:
+	case l.A.Cmp(big.NewRat(-1, 1)) == 0:
+		b.WriteString("-")
+	default:
+		b.WriteString(l.A.RatString() + "·")
+	}
+
+	b.WriteString(l.Variable)
+
+	switch l.B.Sign() {
+	case 1:
+		b.WriteString(" + " + l.B.RatString())
+	case -1:
+		b.WriteString(" - " + new(big.Rat).Neg(l.B).RatString())
+	}
+
+	return b.String()
+}
+
+func variableOf(left, right Linear) (string, error) {
+	if !left.IsConstant() && !right.IsConstant() && left.Variable != right.Variable {
+		return "", fmt.Errorf("%w: both %s and %s", ErrNonLinear, left.Variable, right.Variable)
+	}
+
+	if left.IsConstant() {
+		return right.Variable, nil
+	}
+
+	return left.Variable, nil
+}
+
+// Simplify reduces an expression to a linear form in its unknown. Multiplying two
+// expressions in the unknown, or dividing by one, isn't linear; dividing by zero
+// isn't anything.
+func Simplify(e Expr) (Linear, error) {
+	switch e := e.(type) {
+	case *Constant:
+		return constantLinear(new(big.Rat).Set(e.Value)), nil
+	case *Variable:
+		return Linear{A: big.NewRat(1, 1), B: new(big.Rat), Variable: e.Name}, nil
+	case *Operation:
+		left, err := Simplify(e.Left)
+		if err != nil {
+			return Linear{}, err
+		}
+
+		right, err := Simplify(e.Right)
+		if err != nil {
+			return Linear{}, err
+		}
+
+		variable, err := variableOf(left, right)
+		if err != nil {
+			return Linear{}, err
+		}
+
+		result := Linear{A: new(big.Rat), B: new(big.Rat), Variable: variable}
+
+		switch e.Op {
+		case '+':
+			result.A.Add(left.A, right.A)
+			result.B.Add(left.B, right.B)
+		case '-':
+			result.A.Sub(left.A, right.A)
+			result.B.Sub(left.B, right.B)
+		case '*':
+			if !left.IsConstant() && !right.IsConstant() {
+				return Linear{}, fmt.Errorf("%w: %s", ErrNonLinear, e)
+			}
+
+			// (a·x + b)·c, either way around.
+			if left.IsConstant() {
+				left, right = right, left
+			}
+
+			result.A.Mul(left.A, right.B)
+			result.B.Mul(left.B, right.B)
+		case '/':
+			if !right.IsConstant() {
+				return Linear{}, fmt.Errorf("%w: dividing by %s", ErrNonLinear, e.Right)
+			}
+
+			if right.B.Sign() == 0 {
+				return Linear{}, fmt.Errorf("%w: %s", ErrDivideByZero, e)
+			}
+
+			result.A.Quo(left.A, right.B)
+			result.B.Quo(left.B, right.B)
+		default:
+			return Linear{}, fmt.Errorf("invalid operation '%c'", e.Op)
+		}
+
+		if result.IsConstant() {
+			result.Variable = ""
+		}
+
+		return result, nil
+	}
+
+	return Linear{}, fmt.Errorf("unexpected expression %T", e)
+}
+
+// Equation says two linear forms are equal.
+type Equation struct {
+	Left  Linear
+	Right Linear
+}
+
+func (e Equation) String() string {
+	return e.Left.String() + " = " + e.Right.String()
+}
+
+// Solve finds the one value of the unknown that makes both sides equal.
+func (e Equation) Solve() (*big.Rat, error) {
+	if _, err := variableOf(e.Left, e.Right); err != nil {
+		return nil, err
+	}
+
+	// a1·x + b1 = a2·x + b2, so x = (b2 - b1) / (a1 - a2).
+	a := new(big.Rat).Sub(e.Left.A, e.Right.A)
+	b := new(big.Rat).Sub(e.Right.B, e.Left.B)
+
+	if a.Sign() == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoUniqueSolution, e)
+	}
+
+	return b.Quo(b, a), nil
+}
+
+// Whole returns r as an integer, if it is one.
+func Whole(r *big.Rat) (*big.Int, error) {
+	if !r.IsInt() {
+		return nil, fmt.Errorf("%w: %s", ErrNotWhole, r.RatString())
+	}
+
+	return new(big.Int).Set(r.Num()), nil
+}
@@ -5,6 +5,8 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree
 
 import (
+	"embed"
+
 	TwentyTwentyThree_day01 "github.com/d1r7y/adventofcode/cmd/2023/day01"
 	TwentyTwentyThree_day02 "github.com/d1r7y/adventofcode/cmd/2023/day02"
 	TwentyTwentyThree_day03 "github.com/d1r7y/adventofcode/cmd/2023/day03"
@@ -23,8 +25,14 @@ import (
 	TwentyTwentyThree_day16 "github.com/d1r7y/adventofcode/cmd/2023/day16"
 	TwentyTwentyThree_day17 "github.com/d1r7y/adventofcode/cmd/2023/day17"
 	TwentyTwentyThree_day18 "github.com/d1r7y/adventofcode/cmd/2023/day18"
+	TwentyTwentyThree_day19 "github.com/d1r7y/adventofcode/cmd/2023/day19"
 	TwentyTwentyThree_day20 "github.com/d1r7y/adventofcode/cmd/2023/day20"
 	TwentyTwentyThree_day21 "github.com/d1r7y/adventofcode/cmd/2023/day21"
+	TwentyTwentyThree_day22 "github.com/d1r7y/adventofcode/cmd/2023/day22"
+	TwentyTwentyThree_day23 "github.com/d1r7y/adventofcode/cmd/2023/day23"
+	TwentyTwentyThree_day24 "github.com/d1r7y/adventofcode/cmd/2023/day24"
+	TwentyTwentyThree_day25 "github.com/d1r7y/adventofcode/cmd/2023/day25"
+	"github.com/d1r7y/adventofcode/utilities/metadata"
 	"github.com/spf13/cobra"
 )
 
@@ -35,7 +43,14 @@ var TwentyTwentyThreeCmd = &cobra.Command{
 	Long:  ``,
 }
 
+// puzzles holds each day's puzzle.yaml
+//
+//go:embed day*/puzzle.yaml
+var puzzles embed.FS
+
 func init() {
+	metadata.MustRegisterYear(2023, puzzles)
+
 	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day01.Day01Cmd)
 	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day02.Day02Cmd)
 	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day03.Day03Cmd)
@@ -54,6 +69,11 @@ func init() {
 	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day16.Day16Cmd)
 	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day17.Day17Cmd)
 	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day18.Day18Cmd)
+	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day19.Day19Cmd)
 	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day20.Day20Cmd)
 	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day21.Day21Cmd)
+	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day22.Day22Cmd)
+	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day23.Day23Cmd)
+	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day24.Day24Cmd)
+	TwentyTwentyThreeCmd.AddCommand(TwentyTwentyThree_day25.Day25Cmd)
 }
@@ -139,7 +139,7 @@ func day(fileContent string) error {
 	}
 
 	// Part 1: What is the sum of all of the calibration values?
-	log.Printf("Sum of all calibration values: %d\n", calibrationSum)
+	utilities.Answer(1, "Sum of all calibration values", calibrationSum)
 
 	return nil
 }
@@ -7,6 +7,7 @@ package TwentyTwentyThree_day01
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -73,3 +74,7 @@ func TestDigitFromString(t *testing.T) {
 		}
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
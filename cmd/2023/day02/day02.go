@@ -192,14 +192,14 @@ func day(fileContents string) error {
 	// only 12 red cubes, 13 green cubes, and 14 blue cubes. What is the sum of the IDs of those games?
 	gameIDSum := PossibleGameSum(games, 12, 13, 14)
 
-	log.Printf("Sum of possible game IDs: %d\n", gameIDSum)
+	utilities.Answer(1, "Sum of possible game IDs", gameIDSum)
 
 	// Part 2: For each game, find the minimum set of cubes that must have been present. What is the
 	// sum of the power of these sets?
 
 	powerSum := GamePowerSum(games)
 
-	log.Printf("Sum of game powers: %d\n", powerSum)
+	utilities.Answer(2, "Sum of game powers", powerSum)
 
 	return nil
 }
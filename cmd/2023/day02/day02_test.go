@@ -8,6 +8,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -178,3 +179,7 @@ func TestGamePowerSum(t *testing.T) {
 	powerSum := GamePowerSum(games)
 	assert.Equal(t, 2286, powerSum)
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -178,7 +178,7 @@ func day(fileContents string) error {
 		}
 	}
 
-	log.Printf("Sum of part numbers: %d\n", partNumberSum)
+	utilities.Answer(1, "Sum of part numbers", partNumberSum)
 
 	// Part 2: What is the sum of all of the gear ratios in your engine schematic?
 	gearRatioSum := 0
@@ -196,7 +196,7 @@ func day(fileContents string) error {
 		}
 	}
 
-	log.Printf("Sum of all gear ratios: %d\n", gearRatioSum)
+	utilities.Answer(2, "Sum of all gear ratios", gearRatioSum)
 
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -174,3 +175,7 @@ func TestParseSchematicGearRatioSum(t *testing.T) {
 
 	assert.Equal(t, 467835, gearRatioSum)
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -139,7 +139,7 @@ func day(fileContents string) error {
 		totalPoints += card.Worth()
 	}
 
-	log.Printf("Cards worth a total of %d points\n", totalPoints)
+	utilities.Answer(1, "Points the cards are worth", totalPoints)
 
 	// Part 2: Including the original set of scratchcards, how many total scratchcards do you end up with?
 	for i := range cards {
@@ -157,7 +157,7 @@ func day(fileContents string) error {
 		totalCardsWon += c.Count
 	}
 
-	log.Printf("Total cards won %d\n", totalCardsWon)
+	utilities.Answer(2, "Total cards won", totalCardsWon)
 
 	return nil
 }
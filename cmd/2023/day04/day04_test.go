@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -118,3 +119,7 @@ func TestTotalCardsWon(t *testing.T) {
 
 	assert.Equal(t, 30, totalCardsWon)
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
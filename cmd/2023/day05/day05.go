@@ -5,6 +5,7 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree_day05
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
@@ -37,7 +38,7 @@ var Day05Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(string(fileContent))
+		err = day(utilities.GetContext(cmd), string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -335,7 +336,10 @@ func ParseAlmanac(fileContents string, partOne bool) *Almanac {
 	return almanac
 }
 
-func day(fileContents string) error {
+// SeedsPerCheck is how many seeds part 2 looks up between checks for a timeout.
+const SeedsPerCheck = 1 << 16
+
+func day(ctx context.Context, fileContents string) error {
 	almanac1 := ParseAlmanac(fileContents, true)
 
 	// Part 1: What is the lowest location number that corresponds to any of the initial seed numbers?
@@ -350,7 +354,7 @@ func day(fileContents string) error {
 
 	}
 
-	log.Printf("Lowest location: %d\n", lowestLocation)
+	utilities.Answer(1, "Lowest location", lowestLocation)
 
 	// Part 2: Consider all of the initial seed numbers listed in the ranges on the first line of the almanac.
 	// What is the lowest location number that corresponds to any of the initial seed numbers?
@@ -359,6 +363,12 @@ func day(fileContents string) error {
 	lowestLocation2 := math.MaxInt
 
 	for i := 0; i < almanac2.GetSeedCount(); i++ {
+		if i%SeedsPerCheck == 0 {
+			if err := utilities.Interrupted(ctx); err != nil {
+				return fmt.Errorf("stopped after %d of %d seeds with lowest location %d: %w", i, almanac2.GetSeedCount(), lowestLocation2, err)
+			}
+		}
+
 		location := almanac2.GetLocation(almanac2.GetNextSeed())
 
 		if location < lowestLocation2 {
@@ -367,7 +377,7 @@ func day(fileContents string) error {
 
 	}
 
-	log.Printf("Lowest location: %d\n", lowestLocation2)
+	utilities.Answer(2, "Lowest location", lowestLocation2)
 
 	return nil
 }
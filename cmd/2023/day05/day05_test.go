@@ -5,8 +5,10 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree_day05
 
 import (
+	"context"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -246,3 +248,7 @@ func TestGetLocation(t *testing.T) {
 		assert.Equal(t, test.expectedLocation, almanac.GetLocation(test.seed))
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(context.Background(), input) })
+}
@@ -124,11 +124,11 @@ func day(fileContents string) error {
 		totalWinningWays *= beatRecordCount
 	}
 
-	log.Printf("Total winning ways: %d\n", totalWinningWays)
+	utilities.Answer(1, "Total winning ways", totalWinningWays)
 
 	races2 := ParseRaces(fileContents, false)
 
-	// Part 1: How many ways can you beat the record in this one much longer race?
+	// Part 2: How many ways can you beat the record in this one much longer race?
 	totalWinningWays = 1
 
 	for _, r := range races2 {
@@ -142,7 +142,7 @@ func day(fileContents string) error {
 		totalWinningWays *= beatRecordCount
 	}
 
-	log.Printf("Total winning ways: %d\n", totalWinningWays)
+	utilities.Answer(2, "Total winning ways", totalWinningWays)
 
 	return nil
 }
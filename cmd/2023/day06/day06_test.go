@@ -7,6 +7,7 @@ package TwentyTwentyThree_day06
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -149,3 +150,7 @@ func TestDetermineTotalWinningWaysPart2(t *testing.T) {
 
 	assert.Equal(t, 71503, totalWinningWays)
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -364,7 +364,7 @@ func day(fileContents string) error {
 		totalWinnings1 += int(hb.Bid) * (rank + 1)
 	}
 
-	log.Printf("Total winnings: %d\n", totalWinnings1)
+	utilities.Answer(1, "Total winnings", totalWinnings1)
 
 	// Part 2: Using the new joker rule, find the rank of every hand in your set. What are the new total winnings?
 	handAndBidList2 := make([]HandAndBid, 0)
@@ -386,7 +386,7 @@ func day(fileContents string) error {
 		totalWinnings2 += int(hb.Bid) * (rank + 1)
 	}
 
-	log.Printf("Total winnings with jokers: %d\n", totalWinnings2)
+	utilities.Answer(2, "Total winnings with jokers", totalWinnings2)
 
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -179,3 +180,7 @@ func TestTotalWinningsJoker(t *testing.T) {
 
 	assert.Equal(t, 5905, totalWinnings)
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
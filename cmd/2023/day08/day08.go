@@ -206,7 +206,7 @@ func day(fileContents string) error {
 	// Part 1: Starting at AAA, follow the left/right instructions. How many steps are required to reach ZZZ?
 	steps := n.Walk(n.Find("AAA"), directions, n.Find("ZZZ"))
 
-	log.Printf("Total steps: %d\n", steps)
+	utilities.Answer(1, "Total steps", steps)
 
 	// Part 2: Simultaneously start on every node that ends with A. How many steps does it take before you're
 	// only on nodes that end with Z?
@@ -259,7 +259,7 @@ func day(fileContents string) error {
 		ghostSteps *= k
 	}
 
-	log.Printf("Total ghost steps %d\n", ghostSteps)
+	utilities.Answer(2, "Total ghost steps", ghostSteps)
 
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -147,3 +148,7 @@ func TestGhostWalk(t *testing.T) {
 		assert.Equal(t, test.expectedSteps, steps)
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -115,7 +115,7 @@ func day(fileContents string) error {
 		nextNumbersForwardSum += nextNumber
 	}
 
-	log.Printf("Sum of forward extrapolated values: %d\n", nextNumbersForwardSum)
+	utilities.Answer(1, "Sum of forward extrapolated values", nextNumbersForwardSum)
 
 	// Part 2: Analyze your OASIS report and extrapolate the next value for each history. What is the sum of these extrapolated values?
 	nextNumbersBackwardSum := 0
@@ -126,7 +126,7 @@ func day(fileContents string) error {
 		nextNumbersBackwardSum += nextNumber
 	}
 
-	log.Printf("Sum of backward extrapolated values: %d\n", nextNumbersBackwardSum)
+	utilities.Answer(2, "Sum of backward extrapolated values", nextNumbersBackwardSum)
 
 	return nil
 }
@@ -7,6 +7,7 @@ package TwentyTwentyThree_day09
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -91,3 +92,7 @@ func TestCalculateNextNumberBackward(t *testing.T) {
 		assert.Equal(t, test.expectedNextNumber, CalculateNextNumberBackward(test.numbers))
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
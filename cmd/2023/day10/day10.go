@@ -5,14 +5,18 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree_day10
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"math"
 	"os"
 	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/render"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -32,7 +36,7 @@ var Day10Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(string(fileContent))
+		err = day(utilities.GetLogger(cmd), string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -386,6 +390,45 @@ func (g *Grid) Describe() string {
 	return str
 }
 
+type Marking byte
+
+const (
+	Unmarked Marking = iota
+	OnLoop
+	Enclosed
+	AtStart
+)
+
+func NewTerminal() *render.Terminal[Marking] {
+	return render.NewTerminal[Marking]().
+		Set(OnLoop, color.FgHiCyan).
+		Set(Enclosed, color.FgBlack, color.BgHiGreen).
+		Set(AtStart, color.FgHiRed, color.Bold)
+}
+
+// Render draws the grid like Describe, highlighting the tiles on the loop and the
+// tiles it encloses.
+func (g *Grid) Render(terminal *render.Terminal[Marking], loop, enclosed *utilities.SetPoint2D) string {
+	return terminal.Draw(g.Bounds, func(p utilities.Point2D) string {
+		if p == g.StartPosition {
+			return "S"
+		}
+
+		return g.GetTile(p).Describe()
+	}, func(p utilities.Point2D) Marking {
+		switch {
+		case p == g.StartPosition:
+			return AtStart
+		case loop.Exists(p):
+			return OnLoop
+		case enclosed.Exists(p):
+			return Enclosed
+		}
+
+		return Unmarked
+	})
+}
+
 func IsTilePipe(t Tile) bool {
 	switch t {
 	case VerticalPipe:
@@ -604,7 +647,7 @@ func CanConnect(t1 Tile, d Direction, t2 Tile) bool {
 	return false
 }
 
-func day(fileContents string) error {
+func day(logger *slog.Logger, fileContents string) error {
 	// Part 1: Find the single giant loop starting at S. How many steps along the loop does it take
 	// to get from the starting position to the point farthest from the starting position?
 	grid := ParseGrid(strings.Split(strings.TrimSpace(fileContents), "\n"))
@@ -618,16 +661,19 @@ func day(fileContents string) error {
 		return true
 	})
 
-	log.Printf("Steps to furthest point from starting position: %d\n", distance/2)
+	utilities.Answer(1, "Steps to furthest point from starting position", distance/2)
 
 	// Part 2: Figure out whether you have time to search for the nest by calculating the area
 	// within the loop. How many tiles are enclosed by the loop?
 	vertices := make([]utilities.Point2D, 0)
 
 	visited := NewDistances(grid.Bounds)
+	loop := utilities.NewSetPoint2D()
+	enclosed := utilities.NewSetPoint2D()
 
 	grid.TraverseLoop(func(p utilities.Point2D, d Direction, t Tile) bool {
 		visited.SetDistance(p, 10)
+		loop.Add(p)
 		vertices = append(vertices, p)
 		return true
 	})
@@ -641,13 +687,16 @@ func day(fileContents string) error {
 			d := visited.GetDistance(utilities.NewPoint2D(x, y))
 			if d < 0 {
 				if utilities.PointInPolyCrossing(utilities.NewPoint2D(x, y), vertices) {
+					enclosed.Add(utilities.NewPoint2D(x, y))
 					area++
 				}
 			}
 		}
 	}
 
-	log.Printf("Area enclosed by loop: %d\n", area)
+	logger.Log(context.Background(), utilities.LevelDetail, "enclosed", "grid", utilities.Lazy(func() any { return grid.Render(NewTerminal(), loop, enclosed) }))
+
+	utilities.Answer(2, "Area enclosed by loop", area)
 
 	return nil
 }
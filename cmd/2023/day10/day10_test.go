@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -532,3 +533,37 @@ func TestGridArea(t *testing.T) {
 		assert.Equal(t, test.expectedArea, area)
 	}
 }
+
+func TestGridRender(t *testing.T) {
+	grid := ParseGrid([]string{
+		".....",
+		".S-7.",
+		".|.|.",
+		".L-J.",
+		".....",
+	})
+
+	loop := utilities.NewSetPoint2D()
+	grid.TraverseLoop(func(p utilities.Point2D, d Direction, t Tile) bool {
+		loop.Add(p)
+		return true
+	})
+
+	enclosed := utilities.NewSetPoint2D()
+	enclosed.Add(utilities.NewPoint2D(2, 2))
+
+	terminal := NewTerminal()
+
+	terminal.Enabled = false
+	assert.Equal(t, grid.Describe(), grid.Render(terminal, loop, enclosed))
+
+	terminal.Enabled = true
+	rendered := strings.Split(grid.Render(terminal, loop, enclosed), "\n")
+	assert.Equal(t, ".....", rendered[0])
+	assert.Equal(t, ".\x1b[91;1mS\x1b[0;22m\x1b[96m-7\x1b[0m.", rendered[1])
+	assert.Equal(t, ".\x1b[96m|\x1b[0m\x1b[30;102m.\x1b[0;0m\x1b[96m|\x1b[0m.", rendered[2])
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
@@ -196,7 +196,7 @@ func day(fileContents string) error {
 		}
 	}
 
-	log.Printf("Sum of shortest paths between all pairs of galaxies: %d\n", sumGalaxyDistances)
+	utilities.Answer(1, "Sum of shortest paths between all pairs of galaxies", sumGalaxyDistances)
 
 	olderUniverse := ParseUniverse(strings.Split(strings.TrimSpace(fileContents), "\n"))
 
@@ -212,7 +212,7 @@ func day(fileContents string) error {
 		}
 	}
 
-	log.Printf("Sum of shortest paths between all pairs of galaxies: %d\n", sumOlderGalaxyDistances)
+	utilities.Answer(2, "Sum of shortest paths between all pairs of galaxies", sumOlderGalaxyDistances)
 
 	return nil
 }
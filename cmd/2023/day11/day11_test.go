@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -291,3 +292,7 @@ func TestSumGalaxyDistancesOlder(t *testing.T) {
 		assert.Equal(t, test.expectedsumGalaxyDistances, sumGalaxyDistances)
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -5,6 +5,7 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree_day12
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
@@ -19,7 +20,7 @@ import (
 // Day12Cmd represents the day13 command
 var Day12Cmd = &cobra.Command{
 	Use:   "day12",
-	Short: `Hot Springs - NOT COMPLETED`,
+	Short: `Hot Springs`,
 	Run: func(cmd *cobra.Command, args []string) {
 		df, err := os.Open(utilities.GetInputPath(cmd))
 		if err != nil {
@@ -32,7 +33,7 @@ var Day12Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(string(fileContent))
+		err = day(utilities.GetContext(cmd), string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -122,11 +123,16 @@ func (sg *SpringGroup) Describe() string {
 	return str
 }
 
-func (sg *SpringGroup) Solve() []*SpringGroup {
+func (sg *SpringGroup) Solve(ctx context.Context) ([]*SpringGroup, error) {
 	solutions := make([]*SpringGroup, 0)
 
+	alternatives, err := GenerateAlternatives(ctx, sg.States, sg.DamagedSpringRuns, sg.Unfolded)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get all the solutions given states
-	for _, alternative := range GenerateAlternatives(sg.States, sg.DamagedSpringRuns, sg.Unfolded) {
+	for _, alternative := range alternatives {
 		if !IsAlternativeValid(alternative, sg.DamagedSpringRuns) {
 			continue
 		}
@@ -136,7 +142,7 @@ func (sg *SpringGroup) Solve() []*SpringGroup {
 		solutions = append(solutions, group)
 	}
 
-	return solutions
+	return solutions, nil
 }
 
 func IsAlternativeValid(states SpringStateList, requirements []int) bool {
@@ -215,7 +221,10 @@ func SimplifySpringStateRun(states SpringStateList, interestedState SpringState)
 	return simplified
 }
 
-func generateAlternativesCore(alternatives []SpringStateList, states SpringStateList, offset int, requirements []int, unfold int, reject RejectStateList) []SpringStateList {
+func generateAlternativesCore(ctx context.Context, alternatives []SpringStateList, states SpringStateList, offset int, requirements []int, unfold int, reject RejectStateList) ([]SpringStateList, error) {
+	if err := utilities.Interrupted(ctx); err != nil {
+		return nil, err
+	}
 
 	createAlternate := func(states SpringStateList, offset int, newState SpringState) SpringStateList {
 		alternateState := make(SpringStateList, len(states))
@@ -269,7 +278,7 @@ func generateAlternativesCore(alternatives []SpringStateList, states SpringState
 			// We've reached the end.  No more states to mutate.
 			alternatives = append(alternatives, states)
 
-			return alternatives
+			return alternatives, nil
 		}
 
 		if states[offset] == Unknown {
@@ -282,7 +291,10 @@ func generateAlternativesCore(alternatives []SpringStateList, states SpringState
 				if invalidAlternate(mutateBroken, requirements) {
 					updateRejectList(RejectBrokenState, reject, offset, unfold)
 				} else {
-					alternatives = generateAlternativesCore(alternatives, mutateBroken, offset+1, requirements, unfold, reject)
+					var err error
+					if alternatives, err = generateAlternativesCore(ctx, alternatives, mutateBroken, offset+1, requirements, unfold, reject); err != nil {
+						return nil, err
+					}
 				}
 			}
 
@@ -295,7 +307,10 @@ func generateAlternativesCore(alternatives []SpringStateList, states SpringState
 				if invalidAlternate(mutateOperational, requirements) {
 					updateRejectList(RejectOperationalState, reject, offset, unfold)
 				} else {
-					alternatives = generateAlternativesCore(alternatives, mutateOperational, offset+1, requirements, unfold, reject)
+					var err error
+					if alternatives, err = generateAlternativesCore(ctx, alternatives, mutateOperational, offset+1, requirements, unfold, reject); err != nil {
+						return nil, err
+					}
 				}
 			}
 			break
@@ -305,17 +320,19 @@ func generateAlternativesCore(alternatives []SpringStateList, states SpringState
 		}
 	}
 
-	return alternatives
+	return alternatives, nil
 }
 
-func GenerateAlternatives(states SpringStateList, requirements []int, unfold int) []SpringStateList {
+// GenerateAlternatives tries every state for each unknown spring, which grows
+// exponentially with the number of unknowns; it gives up once ctx is cancelled.
+func GenerateAlternatives(ctx context.Context, states SpringStateList, requirements []int, unfold int) ([]SpringStateList, error) {
 	alternatives := make([]SpringStateList, 0)
 	reject := make(RejectStateList, len(states))
 	for i := 0; i < len(reject); i++ {
 		reject[i] = NoReject
 	}
 
-	return generateAlternativesCore(alternatives, states, 0, requirements, unfold, reject)
+	return generateAlternativesCore(ctx, alternatives, states, 0, requirements, unfold, reject)
 }
 
 func ParseLine(line string) *SpringGroup {
@@ -354,7 +371,7 @@ func ParseLine(line string) *SpringGroup {
 	return group
 }
 
-func day(fileContents string) error {
+func day(ctx context.Context, fileContents string) error {
 	// Part 1: For each row, count all of the different arrangements of operational and broken
 	// springs that meet the given criteria. What is the sum of those counts?
 
@@ -363,24 +380,34 @@ func day(fileContents string) error {
 	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
 		springGroup := ParseLine(line)
 
-		totalArrangements += len(springGroup.Solve())
+		solutions, err := springGroup.Solve(ctx)
+		if err != nil {
+			return err
+		}
+
+		totalArrangements += len(solutions)
 	}
 
-	log.Printf("Sum of possible arrangements: %d\n", totalArrangements)
+	utilities.Answer(1, "Sum of possible arrangements", totalArrangements)
 
 	// Part 2: Unfold your condition records; what is the new sum of possible arrangement counts?
 
 	totalUnfoldedArrangements := 0
 
-	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+	for i, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
 		springGroup := ParseLine(line)
 		unfolded := springGroup.Unfold(5)
 		log.Println(unfolded.Describe())
 
-		totalUnfoldedArrangements += len(unfolded.Solve())
+		solutions, err := unfolded.Solve(ctx)
+		if err != nil {
+			return fmt.Errorf("stopped at row %d with %d arrangements so far: %w", i+1, totalUnfoldedArrangements, err)
+		}
+
+		totalUnfoldedArrangements += len(solutions)
 	}
 
-	log.Printf("Sum of unfolded possible arrangements: %d\n", totalUnfoldedArrangements)
+	utilities.Answer(2, "Sum of unfolded possible arrangements", totalUnfoldedArrangements)
 
 	return nil
 }
@@ -5,8 +5,10 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree_day12
 
 import (
+	"context"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -116,14 +118,17 @@ func TestSpringGroupSolve(t *testing.T) {
 
 	for _, test := range testCases {
 		group := ParseLine(test.line)
-		assert.Equal(t, test.expectedAlternatives, group.Solve())
+		alternatives, err := group.Solve(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedAlternatives, alternatives)
 	}
 }
 
 func TestSpringGroupSolveUnfolded(t *testing.T) {
 	line := "?###???????? 3,2,1"
 	group := ParseLine(line)
-	group.Unfold(5).Solve()
+	_, err := group.Unfold(5).Solve(context.Background())
+	assert.NoError(t, err)
 }
 
 func TestSpringGroupSolveCount(t *testing.T) {
@@ -139,7 +144,9 @@ func TestSpringGroupSolveCount(t *testing.T) {
 
 	for _, test := range testCases {
 		group := ParseLine(test.line)
-		assert.Equal(t, test.expectedAlternativesCount, len(group.Solve()))
+		alternatives, err := group.Solve(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedAlternativesCount, len(alternatives))
 	}
 }
 
@@ -159,3 +166,15 @@ func TestSpringGroupUnfold(t *testing.T) {
 		assert.Equal(t, test.expectedLine, unfoldedGroup.Describe())
 	}
 }
+
+func TestSpringGroupSolveCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ParseLine("?###???????? 3,2,1").Unfold(5).Solve(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(context.Background(), input) })
+}
@@ -313,7 +313,7 @@ func day(fileContents string) error {
 		}
 	}
 
-	log.Printf("Note summary: %d\n", noteSummary)
+	utilities.Answer(1, "Note summary", noteSummary)
 
 	// Part 2: In each pattern, fix the smudge and find the different line of reflection.
 	// What number do you get after summarizing the new reflection line in each pattern in your notes?
@@ -334,7 +334,7 @@ func day(fileContents string) error {
 		}
 	}
 
-	log.Printf("Note summary for smudged mirrors: %d\n", noteSummarySmudged)
+	utilities.Answer(2, "Note summary for smudged mirrors", noteSummarySmudged)
 
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -281,3 +282,7 @@ func TestSummarizeNotesSmudged(t *testing.T) {
 
 	assert.Equal(t, 400, noteSummary)
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -5,13 +5,19 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree_day14
 
 import (
+	"context"
+	"fmt"
+	"image/color"
 	"io"
 	"log"
+	"log/slog"
 	"os"
 	"strings"
 	"sync"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/render"
+	termcolor "github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -31,14 +37,24 @@ var Day14Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(string(fileContent))
+		err = day(utilities.GetContext(cmd), utilities.GetLogger(cmd), string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
 	},
 }
 
-const ColumnsPerGoRoutine = 5
+var animatePath string
+
+func init() {
+	Day14Cmd.Flags().StringVar(&animatePath, "animate", "", "write an animation of the first spin cycles to a .gif file or numbered .png files")
+}
+
+const (
+	ColumnsPerGoRoutine = 5
+	AnimatedSpinCycles  = 10
+	SpinCycles          = 1000000000
+)
 
 type Rock byte
 
@@ -379,26 +395,86 @@ func (p *Platform) Load() int {
 	return totalLoad
 }
 
-func day(fileContents string) error {
+func NewTerminal() *render.Terminal[Rock] {
+	return render.NewTerminal[Rock]().
+		Set(Rounded, termcolor.FgHiWhite, termcolor.Bold).
+		Set(Cube, termcolor.FgYellow)
+}
+
+// Render draws the platform like Describe, coloured by terminal.
+func (p *Platform) Render(terminal *render.Terminal[Rock]) string {
+	return terminal.Draw(p.Bounds, func(position utilities.Point2D) string {
+		return p.Pixel(position).Describe()
+	}, p.Pixel)
+}
+
+func (p *Platform) Pixel(position utilities.Point2D) Rock {
+	return p.Columns[position.X][position.Y]
+}
+
+func NewRecorder() *render.Recorder[Rock] {
+	palette := render.NewPalette[Rock](color.RGBA{30, 30, 40, 255}).
+		Set(Rounded, color.RGBA{220, 220, 220, 255}).
+		Set(Cube, color.RGBA{150, 80, 40, 255})
+
+	recorder := render.NewRecorder(palette)
+	recorder.Delay = 25
+
+	return recorder
+}
+
+// AnimateSpinCycles records the platform after every tilt of the first cycles spin
+// cycles.
+func (p *Platform) AnimateSpinCycles(cycles int, recorder *render.Recorder[Rock]) {
+	recorder.Snapshot(p.Bounds, p.Pixel)
+
+	for i := 0; i < cycles; i++ {
+		for _, tilt := range []func(){p.TiltNorth, p.TiltWest, p.TiltSouth, p.TiltEast} {
+			tilt()
+			recorder.Snapshot(p.Bounds, p.Pixel)
+		}
+	}
+}
+
+func day(ctx context.Context, logger *slog.Logger, fileContents string) error {
 	platform := ParsePlatform(strings.Split(fileContents, "\n"))
 
+	if animatePath != "" {
+		recorder := NewRecorder()
+
+		ParsePlatform(strings.Split(fileContents, "\n")).AnimateSpinCycles(AnimatedSpinCycles, recorder)
+
+		if err := recorder.Save(animatePath); err != nil {
+			return err
+		}
+
+		log.Printf("Wrote %d frames to %s\n", recorder.FrameCount(), animatePath)
+	}
+
 	// Part 1: Tilt the platform so that the rounded rocks all roll north.
 	// Afterward, what is the total load on the north support beams?
 	platform.TiltNorth()
 
-	log.Printf("Total load on north support beams: %d\n", platform.Load())
+	utilities.Answer(1, "Total load on north support beams", platform.Load())
+
+	logger.Log(ctx, utilities.LevelDetail, "tilted north", "platform", utilities.Lazy(func() any { return platform.Render(NewTerminal()) }))
 
 	// Part 2: Run the spin cycle for 1000000000 cycles. Afterward, what is the
 	// total load on the north support beams?
-	for i := 0; i < 1000000000; i++ {
-		if i%100000 == 0 {
-			log.Printf("Cycle %d\n", i)
+	progress := utilities.NewProgress("spin cycles", SpinCycles)
+
+	for i := 0; i < SpinCycles; i++ {
+		if err := utilities.Interrupted(ctx); err != nil {
+			return fmt.Errorf("stopped at spin cycle %d of %d with load %d: %w", i, SpinCycles, platform.Load(), err)
 		}
 
 		platform.TiltCycle()
+		progress.Add(1)
 	}
 
-	log.Printf("Total load on north support beams after spin cycles: %d\n", platform.Load())
+	progress.Done()
+
+	utilities.Answer(2, "Total load on north support beams after spin cycles", platform.Load())
 
 	return nil
 }
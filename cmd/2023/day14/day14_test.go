@@ -5,8 +5,10 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree_day14
 
 import (
+	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/d1r7y/adventofcode/utilities"
 	"github.com/stretchr/testify/assert"
@@ -474,3 +476,62 @@ O#.O`,
 		assert.Equal(t, strings.TrimSpace(test.expectedDescription), p.Describe())
 	}
 }
+
+func TestPlatformAnimateSpinCycles(t *testing.T) {
+	lines := []string{
+		"O....#....",
+		"O.OO#....#",
+		".....##...",
+		"OO.#O....O",
+		".O.....O#.",
+		"O.#..O.#.#",
+		"..O..#O..O",
+		".......O..",
+		"#....###..",
+		"#OO..#....",
+	}
+
+	platform := ParsePlatform(lines)
+
+	assert.Equal(t, Rounded, platform.Pixel(utilities.NewPoint2D(0, 0)))
+	assert.Equal(t, Cube, platform.Pixel(utilities.NewPoint2D(5, 0)))
+	assert.Equal(t, Empty, platform.Pixel(utilities.NewPoint2D(1, 0)))
+
+	recorder := NewRecorder()
+	platform.AnimateSpinCycles(3, recorder)
+
+	assert.Equal(t, 1+3*4, recorder.FrameCount())
+
+	spun := ParsePlatform(lines)
+	for i := 0; i < 3; i++ {
+		spun.TiltCycle()
+	}
+
+	assert.Equal(t, spun.Describe(), platform.Describe())
+}
+
+func TestPlatformRender(t *testing.T) {
+	platform := ParsePlatform([]string{
+		"..#.",
+		".O#O",
+		"...O",
+		".#.."})
+
+	terminal := NewTerminal()
+
+	terminal.Enabled = false
+	assert.Equal(t, platform.Describe(), platform.Render(terminal))
+
+	terminal.Enabled = true
+	assert.Equal(t, ".\x1b[97;1mO\x1b[0;22m\x1b[33m#\x1b[0m\x1b[97;1mO\x1b[0;22m", strings.Split(platform.Render(terminal), "\n")[1])
+}
+
+func TestDayTimeout(t *testing.T) {
+	ctx, cancel := utilities.WithLimits(context.Background(), time.Nanosecond, 0)
+	defer cancel()
+	<-ctx.Done()
+
+	platform := "O....#....\nO.OO#....#\n.....##...\nOO.#O....O\n.O.....O#.\nO.#..O.#.#\n..O..#O..O\n.......O..\n#....###..\n#OO..#...."
+
+	assert.EqualError(t, day(ctx, utilities.DiscardLogger, platform), "stopped at spin cycle 0 of 1000000000 with load 136: timed out after 1ns")
+}
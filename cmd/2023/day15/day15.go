@@ -163,14 +163,14 @@ func day(fileContents string) error {
 	// be careful when copy-pasting it.)
 	sum := SumInitializationSequence(fileContents)
 
-	log.Printf("Sum of initialization sequence hash: %d\n", sum)
+	utilities.Answer(1, "Sum of initialization sequence hash", sum)
 
 	// Part 2: With the help of an over-enthusiastic reindeer in a hard hat,
 	// follow the initialization sequence. What is the focusing power of the
 	// resulting lens configuration?
 	focusingPower := SumFocusingPowerFromInitializationSequence(fileContents)
 
-	log.Printf("Focusing power of resulting lens configuration: %d\n", focusingPower)
+	utilities.Answer(2, "Focusing power of resulting lens configuration", focusingPower)
 
 	return nil
 }
@@ -7,6 +7,7 @@ package TwentyTwentyThree_day15
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -98,3 +99,7 @@ func TestBoxLineRemoveLens(t *testing.T) {
 func TestSumFocusingPowerFromInitializationSequence(t *testing.T) {
 	assert.Equal(t, 145, SumFocusingPowerFromInitializationSequence("rn=1,cm-,qp=3,cm=2,qp-,pc=4,ot=9,ab=5,pc-,pc=6,ot=7"))
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -5,13 +5,18 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree_day16
 
 import (
+	"context"
+	"image/color"
 	"io"
 	"log"
-	"math"
+	"log/slog"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/render"
+	termcolor "github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -31,13 +36,22 @@ var Day16Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(string(fileContent))
+		err = day(utilities.GetContext(cmd), utilities.GetLogger(cmd), string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
 	},
 }
 
+var animatePath string
+
+func init() {
+	Day16Cmd.Flags().StringVar(&animatePath, "animate", "", "write an animation of the beam from the top-left to a .gif file or numbered .png files")
+}
+
+// PhotonsPerFrame keeps animations of large contraptions to a reasonable size.
+const PhotonsPerFrame = 25
+
 type Direction byte
 
 const (
@@ -346,6 +360,68 @@ func (g *Grid) StartPhoton(initialPhoton Photon, visitedTile func(position utili
 	}
 }
 
+// Pixel draws an energized empty tile as #, and every other tile as it appears in
+// the puzzle.
+func (g *Grid) Pixel(position utilities.Point2D) rune {
+	t := g.GetTile(position)
+
+	if t == Empty && g.VisitedRows[position.Y][position.X] != 0 {
+		return '#'
+	}
+
+	return rune(t.Describe()[0])
+}
+
+func NewRecorder() *render.Recorder[rune] {
+	mirror := color.RGBA{160, 160, 200, 255}
+
+	palette := render.NewPalette[rune](color.RGBA{10, 10, 10, 255}).
+		Set('#', color.RGBA{255, 140, 0, 255}).
+		Set('\\', mirror).
+		Set('/', mirror).
+		Set('|', mirror).
+		Set('-', mirror)
+
+	recorder := render.NewRecorder(palette)
+	recorder.Every = PhotonsPerFrame
+
+	return recorder
+}
+
+// AnimateBeam records a frame as each photon of the beam moves through the grid.
+func AnimateBeam(grid *Grid, initialPhoton Photon, recorder *render.Recorder[rune]) {
+	grid.Reset()
+
+	grid.StartPhoton(initialPhoton, func(position utilities.Point2D) {
+		recorder.Snapshot(grid.Bounds, grid.Pixel)
+	})
+
+	recorder.Capture(grid.Bounds, grid.Pixel)
+
+	grid.Reset()
+}
+
+func NewTerminal() *render.Terminal[bool] {
+	return render.NewTerminal[bool]().Set(true, termcolor.FgHiYellow, termcolor.Bold)
+}
+
+// Render draws the grid like Describe, highlighting the energized tiles.
+func (g *Grid) Render(terminal *render.Terminal[bool]) string {
+	return terminal.Draw(g.Bounds, func(position utilities.Point2D) string {
+		return g.GetTile(position).Describe()
+	}, func(position utilities.Point2D) bool {
+		return g.VisitedRows[position.Y][position.X] != 0
+	})
+}
+
+// Clone copies the grid's tiles, which are never changed, with no beams in it.
+func (g *Grid) Clone() *Grid {
+	clone := &Grid{Bounds: g.Bounds, Rows: g.Rows}
+	clone.Reset()
+
+	return clone
+}
+
 func (g *Grid) Reset() {
 	g.Photons = &utilities.FIFO[Photon]{}
 
@@ -445,42 +521,36 @@ func GetEnergizedTilesCount(grid *Grid, initialPhoton Photon) int {
 	return energizedTilesCount
 }
 
-func GetMaxEnergizedTilesCount(grid *Grid) int {
-	maxEnergizedTileCount := math.MinInt
+// GetMaxEnergizedTilesCount tries a beam from every edge tile heading into the
+// grid, each on its own copy of the grid.
+func GetMaxEnergizedTilesCount(ctx context.Context, grid *Grid) (int, error) {
+	photons := make([]Photon, 0, 2*(grid.Bounds.Width+grid.Bounds.Height))
 
-	getMaxTileCount := func(photon Photon) {
-		grid.Reset()
-		energizedTileCount := GetEnergizedTilesCount(grid, photon)
-
-		if energizedTileCount > maxEnergizedTileCount {
-			maxEnergizedTileCount = energizedTileCount
-		}
-	}
-
-	// Top row
 	for x := 0; x < grid.Bounds.Width; x++ {
-		getMaxTileCount(Photon{Position: utilities.NewPoint2D(x, 0), Direction: South})
+		// Top row
+		photons = append(photons, Photon{Position: utilities.NewPoint2D(x, 0), Direction: South})
+		// Bottom row
+		photons = append(photons, Photon{Position: utilities.NewPoint2D(x, grid.Bounds.Height-1), Direction: North})
 	}
 
-	// Bottom row
-	for x := 0; x < grid.Bounds.Width; x++ {
-		getMaxTileCount(Photon{Position: utilities.NewPoint2D(x, grid.Bounds.Height-1), Direction: North})
-	}
-
-	// Left column
 	for y := 0; y < grid.Bounds.Height; y++ {
-		getMaxTileCount(Photon{Position: utilities.NewPoint2D(0, y), Direction: East})
+		// Left column
+		photons = append(photons, Photon{Position: utilities.NewPoint2D(0, y), Direction: East})
+		// Right column
+		photons = append(photons, Photon{Position: utilities.NewPoint2D(grid.Bounds.Width-1, y), Direction: West})
 	}
 
-	// Right column
-	for y := 0; y < grid.Bounds.Height; y++ {
-		getMaxTileCount(Photon{Position: utilities.NewPoint2D(grid.Bounds.Width-1, y), Direction: West})
+	counts, err := utilities.ParallelMap(ctx, photons, func(photon Photon) int {
+		return GetEnergizedTilesCount(grid.Clone(), photon)
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	return maxEnergizedTileCount
+	return slices.Max(counts), nil
 }
 
-func day(fileContents string) error {
+func day(ctx context.Context, logger *slog.Logger, fileContents string) error {
 	grid := ParseGrid(fileContents)
 
 	// Part 1: The light isn't energizing enough tiles to produce lava; to debug the contraption,
@@ -491,15 +561,32 @@ func day(fileContents string) error {
 		Direction: East,
 	}
 
+	if animatePath != "" {
+		recorder := NewRecorder()
+
+		AnimateBeam(grid, initialPhoton, recorder)
+
+		if err := recorder.Save(animatePath); err != nil {
+			return err
+		}
+
+		log.Printf("Wrote %d frames to %s\n", recorder.FrameCount(), animatePath)
+	}
+
 	energizedTilesCount := GetEnergizedTilesCount(grid, initialPhoton)
 
-	log.Printf("Energized tile count: %d\n", energizedTilesCount)
+	utilities.Answer(1, "Energized tile count", energizedTilesCount)
+
+	logger.Log(ctx, utilities.LevelDetail, "energized", "grid", utilities.Lazy(func() any { return grid.Render(NewTerminal()) }))
 
 	// Part 2: Find the initial beam configuration that energizes the largest number of tiles;
 	// how many tiles are energized in that configuration?
-	maximumEnergedTilesCount := GetMaxEnergizedTilesCount(grid)
+	maximumEnergedTilesCount, err := GetMaxEnergizedTilesCount(ctx, grid)
+	if err != nil {
+		return err
+	}
 
-	log.Printf("Maximum energized tile count: %d\n", maximumEnergedTilesCount)
+	utilities.Answer(2, "Maximum energized tile count", maximumEnergedTilesCount)
 
 	return nil
 }
@@ -5,10 +5,12 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree_day16
 
 import (
+	"context"
 	"strings"
 	"testing"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -318,5 +320,86 @@ func TestGetMaxEnergizedTilesCount(t *testing.T) {
 
 	grid := ParseGrid(content)
 
-	assert.Equal(t, 51, GetMaxEnergizedTilesCount(grid))
+	count, err := GetMaxEnergizedTilesCount(context.Background(), grid)
+	assert.NoError(t, err)
+	assert.Equal(t, 51, count)
+}
+
+func TestAnimateBeam(t *testing.T) {
+	content := `
+.|...\....
+|.-.\.....
+.....|-...
+........|.
+..........
+.........\
+..../.\\..
+.-.-/..|..
+.|....-|.\
+..//.|....`
+
+	grid := ParseGrid(content)
+
+	initialPhoton := Photon{
+		Position:  utilities.NewPoint2D(0, 0),
+		Direction: East,
+	}
+
+	recorder := NewRecorder()
+	recorder.Every = 1
+
+	AnimateBeam(grid, initialPhoton, recorder)
+
+	final := recorder.Frames[recorder.FrameCount()-1]
+	lit := 0
+
+	for y := 0; y < grid.Bounds.Height; y++ {
+		for x := 0; x < grid.Bounds.Width; x++ {
+			if final.ColorIndexAt(x*recorder.Scale, y*recorder.Scale) == recorder.Palette.Index('#') {
+				lit++
+			}
+		}
+	}
+
+	// Energized mirrors and splitters are drawn as themselves.
+	assert.Equal(t, 46-15, lit)
+	assert.Greater(t, recorder.FrameCount(), 46)
+
+	// The grid is reset afterwards, so the animation doesn't change the answer.
+	assert.Equal(t, 46, GetEnergizedTilesCount(grid, initialPhoton))
+}
+
+func TestGridRender(t *testing.T) {
+	content := `
+.|...\....
+|.-.\.....
+.....|-...
+........|.
+..........
+.........\
+..../.\\..
+.-.-/..|..
+.|....-|.\
+..//.|....`
+
+	grid := ParseGrid(content)
+
+	GetEnergizedTilesCount(grid, Photon{
+		Position:  utilities.NewPoint2D(0, 0),
+		Direction: East,
+	})
+
+	terminal := NewTerminal()
+
+	terminal.Enabled = false
+	assert.Equal(t, grid.Describe(), grid.Render(terminal))
+
+	terminal.Enabled = true
+	rendered := strings.Split(grid.Render(terminal), "\n")
+	assert.Equal(t, "\x1b[93;1m.|...\\\x1b[0;22m....", rendered[0])
+	assert.Equal(t, "|\x1b[93;1m.\x1b[0;22m-.\\\x1b[93;1m.\x1b[0;22m....", rendered[1])
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(context.Background(), utilities.DiscardLogger, input) })
 }
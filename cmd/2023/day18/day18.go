@@ -8,8 +8,9 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"math"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
@@ -19,7 +20,7 @@ import (
 // Day18Cmd represents the day18 command
 var Day18Cmd = &cobra.Command{
 	Use:   "day18",
-	Short: `Lavaduct Lagoon - NOT COMPLETED`,
+	Short: `Lavaduct Lagoon`,
 	Run: func(cmd *cobra.Command, args []string) {
 		df, err := os.Open(utilities.GetInputPath(cmd))
 		if err != nil {
@@ -39,309 +40,183 @@ var Day18Cmd = &cobra.Command{
 	},
 }
 
-type Neighbor int
+type Direction byte
 
 const (
-	Top Neighbor = iota
-	Bottom
+	Up Direction = iota
+	Down
 	Left
 	Right
-	Front
-	Back
 )
 
-type Point struct {
-	X, Y, Z int
-}
-
-type Bounds struct {
-	W, H, D int
-}
-
-type Offsets struct {
-	X, Y, Z int
-}
-
-type Cube struct {
-	Empty                bool
-	ExternalAccess       bool
-	Position             Point
-	FacesExposed         int
-	ExternalFacesExposed int
-}
-
-func NewEmptyCube(p Point) *Cube {
-	return &Cube{Empty: true, Position: p}
-}
-
-type Plane []*Cube
-
-type Grid struct {
-	Bounds  Bounds
-	Offsets Offsets
-	Min     Point
-	Max     Point
-
-	Space []Plane
-	Cubes []*Cube
-}
-
-func (g *Grid) GetCube(p Point) *Cube {
-	return g.Space[p.Z+g.Offsets.Z][g.Bounds.W*(p.Y+g.Offsets.Y)+(p.X+g.Offsets.X)]
-}
-
-func (g *Grid) GetNeighbor(point Point, neighbor Neighbor) (*Cube, bool) {
-	switch neighbor {
-	case Top:
-		if point.Z == g.Max.Z {
-			return nil, false
-		}
-		position := Point{point.X, point.Y, point.Z + 1}
-		return g.GetCube(position), true
-	case Bottom:
-		if point.Z == g.Min.Z {
-			return nil, false
-		}
-		position := Point{point.X, point.Y, point.Z - 1}
-		return g.GetCube(position), true
+func (d Direction) Describe() string {
+	switch d {
+	case Up:
+		return "U"
+	case Down:
+		return "D"
 	case Left:
-		if point.X == g.Min.X {
-			return nil, false
-		}
-		position := Point{point.X - 1, point.Y, point.Z}
-		return g.GetCube(position), true
+		return "L"
 	case Right:
-		if point.X == g.Max.X {
-			return nil, false
-		}
-		position := Point{point.X + 1, point.Y, point.Z}
-		return g.GetCube(position), true
-	case Front:
-		if point.Y == g.Max.Y {
-			return nil, false
-		}
-		position := Point{point.X, point.Y + 1, point.Z}
-		return g.GetCube(position), true
-	case Back:
-		if point.Y == g.Min.Y {
-			return nil, false
-		}
-		position := Point{point.X, point.Y - 1, point.Z}
-		return g.GetCube(position), true
+		return "R"
 	}
 
-	log.Panic("unknown neighbor")
-	return nil, false
-}
-
-func (g *Grid) AddCube(cube *Cube) {
-	g.Space[cube.Position.Z+g.Offsets.Z][g.Bounds.W*(cube.Position.Y+g.Offsets.Y)+(cube.Position.X+g.Offsets.X)] = cube
-	g.Cubes = append(g.Cubes, cube)
+	log.Panicf("unknown direction: %d\n", d)
+	return "X"
 }
 
-func (g *Grid) AddEmptyCube(p Point) {
-	cube := NewEmptyCube(p)
-	g.Space[cube.Position.Z+g.Offsets.Z][g.Bounds.W*(cube.Position.Y+g.Offsets.Y)+(cube.Position.X+g.Offsets.X)] = cube
+type DigInstruction struct {
+	Direction Direction
+	Distance  int64
+	Color     string
 }
 
-func (g *Grid) GetSurfaceArea() int {
-	exposedFaces := 0
-
-	for _, cube := range g.Cubes {
-		exposedFaces += cube.FacesExposed
+func ParseDirection(s string) (Direction, error) {
+	switch s {
+	case "U":
+		return Up, nil
+	case "D":
+		return Down, nil
+	case "L":
+		return Left, nil
+	case "R":
+		return Right, nil
 	}
 
-	return exposedFaces
+	return Up, fmt.Errorf("unknown direction '%s'", s)
 }
 
-func (g *Grid) GetExternalSurfaceArea() int {
-	exposedFaces := 0
+func ParseDigInstruction(line string) (DigInstruction, error) {
+	instructionRE := regexp.MustCompile(`^([UDLR]) ([0-9]+) \(#([0-9a-f]{6})\)$`)
+	matches := instructionRE.FindStringSubmatch(strings.TrimSpace(line))
+	if matches == nil {
+		return DigInstruction{}, fmt.Errorf("invalid dig instruction '%s'", line)
+	}
 
-	for _, cube := range g.Cubes {
-		exposedFaces += cube.ExternalFacesExposed
+	direction, err := ParseDirection(matches[1])
+	if err != nil {
+		return DigInstruction{}, err
 	}
 
-	return exposedFaces
+	distance, err := strconv.ParseInt(matches[2], 10, 64)
+	if err != nil {
+		return DigInstruction{}, err
+	}
+
+	return DigInstruction{Direction: direction, Distance: distance, Color: matches[3]}, nil
 }
 
-func (g *Grid) FillExternalAccess() {
-	candidates := make([]Point, 0)
-	visited := make(map[Point]bool)
-
-	// Set ExternalAccess on all empty cubes on the border.
-	for z := g.Min.Z; z <= g.Max.Z; z++ {
-		for x := g.Min.X; x <= g.Max.X; x++ {
-			p := Point{x, g.Min.Y, z}
-			cube := g.GetCube(p)
-			if cube.Empty {
-				cube.ExternalAccess = true
-				candidates = append(candidates, p)
-				visited[p] = true
-			}
-
-			p = Point{x, g.Max.Y, z}
-			cube = g.GetCube(p)
-			if cube.Empty {
-				cube.ExternalAccess = true
-				candidates = append(candidates, p)
-				visited[p] = true
-			}
-		}
+func ParseDigPlan(fileContents string) ([]DigInstruction, error) {
+	plan := make([]DigInstruction, 0)
 
-		for y := g.Min.Y + 1; y <= g.Max.Y-1; y++ {
-			p := Point{g.Min.X, y, z}
-			cube := g.GetCube(p)
-			if cube.Empty {
-				cube.ExternalAccess = true
-				candidates = append(candidates, p)
-				visited[p] = true
-			}
-
-			p = Point{g.Max.X, y, z}
-			cube = g.GetCube(p)
-			if cube.Empty {
-				cube.ExternalAccess = true
-				candidates = append(candidates, p)
-				visited[p] = true
-			}
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
 		}
-	}
 
-	// Now, starting with the positions in candidates, flood fill ExternalAccess to all accessible empty cubes.
-	for i := 0; i < len(candidates); i++ {
-		cp := candidates[i]
-
-		for _, n := range []Neighbor{Top, Bottom, Left, Right, Front, Back} {
-			if neighbor, ok := g.GetNeighbor(cp, n); ok {
-				if neighbor.Empty {
-					if !visited[neighbor.Position] {
-						neighbor.ExternalAccess = true
-						candidates = append(candidates, neighbor.Position)
-						visited[neighbor.Position] = true
-					}
-				}
-			}
+		instruction, err := ParseDigInstruction(line)
+		if err != nil {
+			return nil, err
 		}
+
+		plan = append(plan, instruction)
 	}
-}
 
-func ParseCube(line string) *Cube {
-	var x, y, z int
+	return plan, nil
+}
 
-	count, err := fmt.Sscanf(line, "%d,%d,%d", &x, &y, &z)
+// DecodeColor swaps the instruction for the one hidden in its color code: the first
+// five hexadecimal digits are the distance and the last digit is the direction.
+func (di DigInstruction) DecodeColor() (DigInstruction, error) {
+	distance, err := strconv.ParseInt(di.Color[:5], 16, 64)
 	if err != nil {
-		log.Panic("Invalid cube line.")
+		return DigInstruction{}, err
 	}
 
-	if count != 3 {
-		log.Panic("Invalid cube line.")
+	var direction Direction
+
+	switch di.Color[5] {
+	case '0':
+		direction = Right
+	case '1':
+		direction = Down
+	case '2':
+		direction = Left
+	case '3':
+		direction = Up
+	default:
+		return DigInstruction{}, fmt.Errorf("invalid direction digit in color '%s'", di.Color)
 	}
 
-	return &Cube{Position: Point{X: x, Y: y, Z: z}, FacesExposed: 0}
+	return DigInstruction{Direction: direction, Distance: distance, Color: di.Color}, nil
 }
 
-func ParseCubes(fileContents string) *Grid {
-	// Need to make three passes: first to get the bounds of the grid, next to allocate and store the
-	// cubes, third to calculate the exposed faces.
-	g := &Grid{}
-
-	g.Min.X = math.MaxInt
-	g.Max.X = math.MinInt
-	g.Min.Y = math.MaxInt
-	g.Max.Y = math.MinInt
-	g.Min.Z = math.MaxInt
-	g.Max.Z = math.MinInt
-
-	for _, line := range strings.Split(fileContents, "\n") {
-		cube := ParseCube(line)
-
-		if cube.Position.X < g.Min.X {
-			g.Min.X = cube.Position.X
-		}
-		if cube.Position.X > g.Max.X {
-			g.Max.X = cube.Position.X
-		}
+func DecodePlan(plan []DigInstruction) ([]DigInstruction, error) {
+	decoded := make([]DigInstruction, 0, len(plan))
 
-		if cube.Position.Y < g.Min.Y {
-			g.Min.Y = cube.Position.Y
-		}
-		if cube.Position.Y > g.Max.Y {
-			g.Max.Y = cube.Position.Y
+	for _, instruction := range plan {
+		d, err := instruction.DecodeColor()
+		if err != nil {
+			return nil, err
 		}
 
-		if cube.Position.Z < g.Min.Z {
-			g.Min.Z = cube.Position.Z
-		}
-		if cube.Position.Z > g.Max.Z {
-			g.Max.Z = cube.Position.Z
-		}
+		decoded = append(decoded, d)
 	}
 
-	g.Bounds.H = g.Max.Z - g.Min.Z + 1
-	g.Bounds.W = g.Max.X - g.Min.X + 1
-	g.Bounds.D = g.Max.Y - g.Min.Y + 1
+	return decoded, nil
+}
 
-	g.Offsets.X = -g.Min.X
-	g.Offsets.Y = -g.Min.Y
-	g.Offsets.Z = -g.Min.Z
+// LagoonVolume returns the number of cubic meters of lava the lagoon holds. The
+// trench is treated as a polygon through the centers of the dug cubes: the shoelace
+// formula gives its interior area and Pick's theorem adds back the outer half of
+// the trench cubes, so the answer is exact however far apart the vertices are.
+func LagoonVolume(plan []DigInstruction) int64 {
+	x, y := int64(0), int64(0)
+	doubleArea := int64(0)
+	perimeter := int64(0)
 
-	g.Space = make([]Plane, g.Bounds.H)
+	for _, instruction := range plan {
+		nx, ny := x, y
 
-	// Prefill the space with empty cubes.
-	z := g.Min.Z
-	for i := range g.Space {
-		g.Space[i] = make(Plane, g.Bounds.W*g.Bounds.D)
-		for y := g.Min.Y; y <= g.Max.Y; y++ {
-			for x := g.Min.X; x <= g.Max.X; x++ {
-				g.AddEmptyCube(Point{x, y, z})
-			}
+		switch instruction.Direction {
+		case Up:
+			ny -= instruction.Distance
+		case Down:
+			ny += instruction.Distance
+		case Left:
+			nx -= instruction.Distance
+		case Right:
+			nx += instruction.Distance
 		}
-		z++
-	}
 
-	// Add the real cubes.
-	for _, line := range strings.Split(fileContents, "\n") {
-		g.AddCube(ParseCube(line))
-	}
+		doubleArea += x*ny - nx*y
+		perimeter += instruction.Distance
 
-	// Set ExternalAccess on all empty cubes on the border.  Flood fill ExternalAccess to all reachable empty cubes.
-	g.FillExternalAccess()
-
-	for _, cube := range g.Cubes {
-		for _, n := range []Neighbor{Top, Bottom, Left, Right, Front, Back} {
-			if neighbor, ok := g.GetNeighbor(cube.Position, n); ok {
-				if !neighbor.Empty {
-					continue
-				}
-
-				cube.FacesExposed++
-				if neighbor.ExternalAccess {
-					cube.ExternalFacesExposed++
-				}
-			} else {
-				// If a side of a cube is on the edge, then it is an external face.
-				cube.ExternalFacesExposed++
-				cube.FacesExposed++
-			}
-		}
+		x, y = nx, ny
 	}
 
-	return g
+	return utilities.Abs(doubleArea)/2 + perimeter/2 + 1
 }
 
 func day(fileContents string) error {
-	g := ParseCubes(fileContents)
+	plan, err := ParseDigPlan(fileContents)
+	if err != nil {
+		return err
+	}
 
-	fmt.Printf("Bounds: %dx%dx%d\n", g.Bounds.W, g.Bounds.D, g.Bounds.H)
-	fmt.Printf("Cubes read: %d\n", len(g.Cubes))
-	fmt.Printf("Empty cubes: %d\n", g.Bounds.D*g.Bounds.H*g.Bounds.W-len(g.Cubes))
+	// Part 1: The Elves are concerned the lagoon won't be large enough; if they
+	// follow their dig plan, how many cubic meters of lava could it hold?
+	utilities.Answer(1, "Lagoon volume", LagoonVolume(plan))
 
-	// Part 1: After reading in the scanner report, what is the surface area of the lava droplet?
-	fmt.Printf("Lava droplets surface area: %d units\n", g.GetSurfaceArea())
+	// Part 2: Convert the hexadecimal color codes into the correct instructions;
+	// if the Elves follow this new dig plan, how many cubic meters of lava could
+	// the lagoon hold?
+	decodedPlan, err := DecodePlan(plan)
+	if err != nil {
+		return err
+	}
 
-	// Part 2: Ignore the surfaces that are trapped within the droplets.  What is the exterior
-	// surface area of the lava droplet?
-	fmt.Printf("Lava droplets external surface area: %d units\n", g.GetExternalSurfaceArea())
+	utilities.Answer(2, "Decoded lagoon volume", LagoonVolume(decodedPlan))
 
 	return nil
 }
@@ -7,75 +7,126 @@ package TwentyTwentyThree_day18
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestParseCube(t *testing.T) {
+const examplePlan = `R 6 (#70c710)
+D 5 (#0dc571)
+L 2 (#5713f0)
+D 2 (#d2c081)
+R 2 (#59c680)
+D 2 (#411b91)
+L 5 (#8ceee2)
+U 2 (#caa173)
+L 1 (#1b58a2)
+U 2 (#caa171)
+R 2 (#7807d2)
+U 3 (#a77fa3)
+L 2 (#015232)
+U 2 (#7a21e3)`
+
+func TestParseDigInstruction(t *testing.T) {
 	type testCase struct {
-		line         string
-		expectedCube *Cube
+		line                string
+		expectedInstruction DigInstruction
 	}
 
 	testCases := []testCase{
 		{
-			line:         "2,2,2",
-			expectedCube: &Cube{Position: Point{2, 2, 2}, FacesExposed: 0},
+			line:                "R 6 (#70c710)",
+			expectedInstruction: DigInstruction{Direction: Right, Distance: 6, Color: "70c710"},
+		},
+		{
+			line:                "D 5 (#0dc571)",
+			expectedInstruction: DigInstruction{Direction: Down, Distance: 5, Color: "0dc571"},
+		},
+		{
+			line:                "L 2 (#5713f0)",
+			expectedInstruction: DigInstruction{Direction: Left, Distance: 2, Color: "5713f0"},
 		},
 		{
-			line:         "2,1,2",
-			expectedCube: &Cube{Position: Point{2, 1, 2}, FacesExposed: 0},
+			line:                "U 12 (#caa171)",
+			expectedInstruction: DigInstruction{Direction: Up, Distance: 12, Color: "caa171"},
 		},
+	}
+
+	for _, test := range testCases {
+		instruction, err := ParseDigInstruction(test.line)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedInstruction, instruction)
+	}
+
+	_, err := ParseDigInstruction("X 6 (#70c710)")
+	assert.Error(t, err)
+
+	_, err = ParseDigInstruction("R 6")
+	assert.Error(t, err)
+}
+
+func TestDecodeColor(t *testing.T) {
+	type testCase struct {
+		color             string
+		expectedDirection Direction
+		expectedDistance  int64
+	}
+
+	testCases := []testCase{
+		{color: "70c710", expectedDirection: Right, expectedDistance: 461937},
+		{color: "0dc571", expectedDirection: Down, expectedDistance: 56407},
+		{color: "5713f0", expectedDirection: Right, expectedDistance: 356671},
+		{color: "8ceee2", expectedDirection: Left, expectedDistance: 577262},
+		{color: "caa173", expectedDirection: Up, expectedDistance: 829975},
+	}
+
+	for _, test := range testCases {
+		decoded, err := DigInstruction{Direction: Up, Distance: 1, Color: test.color}.DecodeColor()
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedDirection, decoded.Direction)
+		assert.Equal(t, test.expectedDistance, decoded.Distance)
+	}
+
+	_, err := DigInstruction{Color: "70c714"}.DecodeColor()
+	assert.Error(t, err)
+}
+
+func TestLagoonVolume(t *testing.T) {
+	type testCase struct {
+		text           string
+		expectedVolume int64
+	}
+
+	testCases := []testCase{
 		{
-			line:         "0,0,0",
-			expectedCube: &Cube{Position: Point{0, 0, 0}, FacesExposed: 0},
+			text:           examplePlan,
+			expectedVolume: 62,
 		},
 		{
-			line:         "-1,-2,-3",
-			expectedCube: &Cube{Position: Point{-1, -2, -3}, FacesExposed: 0},
+			text: `R 2 (#000000)
+D 2 (#000000)
+L 2 (#000000)
+U 2 (#000000)`,
+			expectedVolume: 9,
 		},
 	}
 
 	for _, test := range testCases {
-		assert.Equal(t, test.expectedCube, ParseCube(test.line))
+		plan, err := ParseDigPlan(test.text)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedVolume, LagoonVolume(plan))
 	}
 }
 
-func TestGetSurfaceArea(t *testing.T) {
-	str := `2,2,2
-1,2,2
-3,2,2
-2,1,2
-2,3,2
-2,2,1
-2,2,3
-2,2,4
-2,2,6
-1,2,5
-3,2,5
-2,1,5
-2,3,5`
-
-	g := ParseCubes(str)
-
-	assert.Equal(t, 64, g.GetSurfaceArea())
+func TestDecodedLagoonVolume(t *testing.T) {
+	plan, err := ParseDigPlan(examplePlan)
+	assert.NoError(t, err)
+
+	decodedPlan, err := DecodePlan(plan)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(952408144115), LagoonVolume(decodedPlan))
 }
 
-func TestGetExteriorSurfaceArea(t *testing.T) {
-	str := `2,2,2
-1,2,2
-3,2,2
-2,1,2
-2,3,2
-2,2,1
-2,2,3
-2,2,4
-2,2,6
-1,2,5
-3,2,5
-2,1,5
-2,3,5`
-
-	g := ParseCubes(str)
-
-	assert.Equal(t, 58, g.GetExternalSurfaceArea())
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
 }
@@ -0,0 +1,498 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyThree_day19
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day19Cmd represents the day19 command
+var Day19Cmd = &cobra.Command{
+	Use:   "day19",
+	Short: `Aplenty`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(utilities.GetLogger(cmd), string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	Accepted = "A"
+	Rejected = "R"
+	Start    = "in"
+
+	MinimumRating = 1
+	MaximumRating = 4000
+)
+
+type Category byte
+
+const (
+	ExtremelyCoolLooking Category = iota
+	Musical
+	Aerodynamic
+	Shiny
+	CategoryCount
+)
+
+func (c Category) Describe() string {
+	switch c {
+	case ExtremelyCoolLooking:
+		return "x"
+	case Musical:
+		return "m"
+	case Aerodynamic:
+		return "a"
+	case Shiny:
+		return "s"
+	}
+
+	log.Panicf("unknown category: %d\n", c)
+	return "?"
+}
+
+func ParseCategory(s string) (Category, error) {
+	switch s {
+	case "x":
+		return ExtremelyCoolLooking, nil
+	case "m":
+		return Musical, nil
+	case "a":
+		return Aerodynamic, nil
+	case "s":
+		return Shiny, nil
+	}
+
+	return CategoryCount, fmt.Errorf("unknown category '%s'", s)
+}
+
+type Part struct {
+	Ratings [CategoryCount]int
+}
+
+func (p Part) TotalRating() int {
+	total := 0
+
+	for _, r := range p.Ratings {
+		total += r
+	}
+
+	return total
+}
+
+type Comparison byte
+
+const (
+	Always Comparison = iota
+	LessThan
+	GreaterThan
+)
+
+type Rule struct {
+	Comparison  Comparison
+	Category    Category
+	Value       int
+	Destination string
+}
+
+func (r Rule) Matches(p Part) bool {
+	switch r.Comparison {
+	case LessThan:
+		return p.Ratings[r.Category] < r.Value
+	case GreaterThan:
+		return p.Ratings[r.Category] > r.Value
+	}
+
+	return true
+}
+
+func (r Rule) Describe() string {
+	switch r.Comparison {
+	case LessThan:
+		return fmt.Sprintf("%s<%d:%s", r.Category.Describe(), r.Value, r.Destination)
+	case GreaterThan:
+		return fmt.Sprintf("%s>%d:%s", r.Category.Describe(), r.Value, r.Destination)
+	}
+
+	return r.Destination
+}
+
+type Workflow struct {
+	Name  string
+	Rules []Rule
+}
+
+func (w *Workflow) Describe() string {
+	rules := make([]string, 0, len(w.Rules))
+
+	for _, r := range w.Rules {
+		rules = append(rules, r.Describe())
+	}
+
+	return fmt.Sprintf("%s{%s}", w.Name, strings.Join(rules, ","))
+}
+
+func ParseRule(s string) (Rule, error) {
+	ruleRE := regexp.MustCompile(`^([xmas])([<>])([0-9]+):([a-zAR]+)$`)
+
+	matches := ruleRE.FindStringSubmatch(s)
+	if matches == nil {
+		if !regexp.MustCompile(`^[a-zAR]+$`).MatchString(s) {
+			return Rule{}, fmt.Errorf("invalid rule '%s'", s)
+		}
+
+		return Rule{Comparison: Always, Destination: s}, nil
+	}
+
+	category, err := ParseCategory(matches[1])
+	if err != nil {
+		return Rule{}, err
+	}
+
+	comparison := LessThan
+	if matches[2] == ">" {
+		comparison = GreaterThan
+	}
+
+	value, err := strconv.Atoi(matches[3])
+	if err != nil {
+		return Rule{}, err
+	}
+
+	return Rule{Comparison: comparison, Category: category, Value: value, Destination: matches[4]}, nil
+}
+
+func ParseWorkflow(line string) (*Workflow, error) {
+	workflowRE := regexp.MustCompile(`^([a-z]+)\{(.+)\}$`)
+
+	matches := workflowRE.FindStringSubmatch(strings.TrimSpace(line))
+	if matches == nil {
+		return nil, fmt.Errorf("invalid workflow '%s'", line)
+	}
+
+	workflow := &Workflow{Name: matches[1]}
+
+	for _, r := range strings.Split(matches[2], ",") {
+		rule, err := ParseRule(r)
+		if err != nil {
+			return nil, err
+		}
+
+		workflow.Rules = append(workflow.Rules, rule)
+	}
+
+	if len(workflow.Rules) == 0 || workflow.Rules[len(workflow.Rules)-1].Comparison != Always {
+		return nil, fmt.Errorf("workflow '%s' has no fallback rule", workflow.Name)
+	}
+
+	return workflow, nil
+}
+
+func ParsePart(line string) (Part, error) {
+	partRE := regexp.MustCompile(`^\{x=([0-9]+),m=([0-9]+),a=([0-9]+),s=([0-9]+)\}$`)
+
+	matches := partRE.FindStringSubmatch(strings.TrimSpace(line))
+	if matches == nil {
+		return Part{}, fmt.Errorf("invalid part '%s'", line)
+	}
+
+	part := Part{}
+
+	for i := 0; i < int(CategoryCount); i++ {
+		rating, err := strconv.Atoi(matches[i+1])
+		if err != nil {
+			return Part{}, err
+		}
+
+		part.Ratings[i] = rating
+	}
+
+	return part, nil
+}
+
+type System struct {
+	Workflows map[string]*Workflow
+	Parts     []Part
+}
+
+func ParseSystem(fileContents string) (*System, error) {
+	sections := strings.SplitN(strings.TrimSpace(fileContents), "\n\n", 2)
+	if len(sections) != 2 {
+		return nil, fmt.Errorf("expected workflows and parts separated by a blank line")
+	}
+
+	system := &System{Workflows: make(map[string]*Workflow)}
+
+	for _, line := range strings.Split(sections[0], "\n") {
+		workflow, err := ParseWorkflow(line)
+		if err != nil {
+			return nil, err
+		}
+
+		system.Workflows[workflow.Name] = workflow
+	}
+
+	for _, line := range strings.Split(sections[1], "\n") {
+		part, err := ParsePart(line)
+		if err != nil {
+			return nil, err
+		}
+
+		system.Parts = append(system.Parts, part)
+	}
+
+	if _, ok := system.Workflows[Start]; !ok {
+		return nil, fmt.Errorf("missing '%s' workflow", Start)
+	}
+
+	return system, nil
+}
+
+func (s *System) Accepts(p Part) (bool, error) {
+	name := Start
+
+	// Every step must visit a new workflow, otherwise the rules loop.
+	for steps := 0; steps <= len(s.Workflows); steps++ {
+		switch name {
+		case Accepted:
+			return true, nil
+		case Rejected:
+			return false, nil
+		}
+
+		workflow, ok := s.Workflows[name]
+		if !ok {
+			return false, fmt.Errorf("unknown workflow '%s'", name)
+		}
+
+		for _, r := range workflow.Rules {
+			if r.Matches(p) {
+				name = r.Destination
+				break
+			}
+		}
+	}
+
+	return false, fmt.Errorf("workflows loop for part %v", p.Ratings)
+}
+
+func (s *System) AcceptedRatingsTotal() (int, error) {
+	total := 0
+
+	for _, p := range s.Parts {
+		accepted, err := s.Accepts(p)
+		if err != nil {
+			return 0, err
+		}
+
+		if accepted {
+			total += p.TotalRating()
+		}
+	}
+
+	return total, nil
+}
+
+// Interval is an inclusive range of ratings.
+type Interval struct {
+	Min int
+	Max int
+}
+
+func (i Interval) Empty() bool {
+	return i.Min > i.Max
+}
+
+func (i Interval) Size() int64 {
+	if i.Empty() {
+		return 0
+	}
+
+	return int64(i.Max - i.Min + 1)
+}
+
+// HyperRectangle is a set of parts with every category rating in its own interval.
+type HyperRectangle [CategoryCount]Interval
+
+func NewHyperRectangle(min, max int) HyperRectangle {
+	h := HyperRectangle{}
+
+	for i := range h {
+		h[i] = Interval{Min: min, Max: max}
+	}
+
+	return h
+}
+
+func (h HyperRectangle) Combinations() int64 {
+	combinations := int64(1)
+
+	for _, i := range h {
+		combinations *= i.Size()
+	}
+
+	return combinations
+}
+
+func (h HyperRectangle) Empty() bool {
+	for _, i := range h {
+		if i.Empty() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h HyperRectangle) Describe() string {
+	description := ""
+
+	for c, i := range h {
+		if description != "" {
+			description += " "
+		}
+
+		description += fmt.Sprintf("%s=%d..%d", Category(c).Describe(), i.Min, i.Max)
+	}
+
+	return description
+}
+
+// Split divides the hyper-rectangle into the parts that match the rule and the
+// parts that fall through to the next rule.
+func (h HyperRectangle) Split(r Rule) (matched HyperRectangle, unmatched HyperRectangle) {
+	matched, unmatched = h, h
+
+	switch r.Comparison {
+	case LessThan:
+		matched[r.Category].Max = min(h[r.Category].Max, r.Value-1)
+		unmatched[r.Category].Min = max(h[r.Category].Min, r.Value)
+	case GreaterThan:
+		matched[r.Category].Min = max(h[r.Category].Min, r.Value+1)
+		unmatched[r.Category].Max = min(h[r.Category].Max, r.Value)
+	case Always:
+		unmatched[r.Category] = Interval{Min: 1, Max: 0}
+	}
+
+	return
+}
+
+// AcceptedHyperRectangles pushes the initial hyper-rectangle through the workflows,
+// splitting it at every rule, and returns the disjoint pieces that end up accepted.
+func (s *System) AcceptedHyperRectangles(initial HyperRectangle) ([]HyperRectangle, error) {
+	type pending struct {
+		Workflow  string
+		Rectangle HyperRectangle
+		Depth     int
+	}
+
+	accepted := make([]HyperRectangle, 0)
+
+	stack := utilities.Stack[pending]{}
+	stack.Push(pending{Workflow: Start, Rectangle: initial})
+
+	for !stack.IsEmpty() {
+		p := stack.Pop()
+
+		if p.Rectangle.Empty() || p.Workflow == Rejected {
+			continue
+		}
+
+		if p.Workflow == Accepted {
+			accepted = append(accepted, p.Rectangle)
+			continue
+		}
+
+		if p.Depth > len(s.Workflows) {
+			return nil, fmt.Errorf("workflows loop at '%s'", p.Workflow)
+		}
+
+		workflow, ok := s.Workflows[p.Workflow]
+		if !ok {
+			return nil, fmt.Errorf("unknown workflow '%s'", p.Workflow)
+		}
+
+		remaining := p.Rectangle
+
+		for _, r := range workflow.Rules {
+			matched, unmatched := remaining.Split(r)
+
+			stack.Push(pending{Workflow: r.Destination, Rectangle: matched, Depth: p.Depth + 1})
+
+			remaining = unmatched
+			if remaining.Empty() {
+				break
+			}
+		}
+	}
+
+	return accepted, nil
+}
+
+func CountCombinations(rectangles []HyperRectangle) int64 {
+	total := int64(0)
+
+	for _, h := range rectangles {
+		total += h.Combinations()
+	}
+
+	return total
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	system, err := ParseSystem(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Sort through all of the parts you've been given; what do you get if
+	// you add together all of the rating numbers for all of the parts that
+	// ultimately get accepted?
+	total, err := system.AcceptedRatingsTotal()
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(1, "Total ratings of accepted parts", total)
+
+	// Part 2: Each of the four ratings can have an integer value ranging from a
+	// minimum of 1 to a maximum of 4000. How many distinct combinations of ratings
+	// will be accepted by the Elves' workflows?
+	rectangles, err := system.AcceptedHyperRectangles(NewHyperRectangle(MinimumRating, MaximumRating))
+	if err != nil {
+		return err
+	}
+
+	for _, h := range rectangles {
+		logger.Debug("accepted", "ratings", h.Describe(), "combinations", h.Combinations())
+	}
+
+	utilities.Answer(2, "Distinct accepted rating combinations", CountCombinations(rectangles))
+
+	return nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyThree_day19
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleSystem = `px{a<2006:qkq,m>2090:A,rfg}
+pv{a>1716:R,A}
+lnx{m>1548:A,A}
+rfg{s<537:gd,x>2440:R,A}
+qs{s>3448:A,lnx}
+qkq{x<1416:A,crn}
+crn{x>2662:A,R}
+in{s<1351:px,qqz}
+qqz{s>2770:qs,m<1801:hdj,R}
+gd{a>3333:R,R}
+hdj{m>838:A,pv}
+
+{x=787,m=2655,a=1222,s=2876}
+{x=1679,m=44,a=2067,s=496}
+{x=2036,m=264,a=79,s=2244}
+{x=2461,m=1339,a=466,s=291}
+{x=2127,m=1623,a=2188,s=1013}`
+
+func TestParseWorkflow(t *testing.T) {
+	workflow, err := ParseWorkflow("px{a<2006:qkq,m>2090:A,rfg}")
+	assert.NoError(t, err)
+	assert.Equal(t, &Workflow{
+		Name: "px",
+		Rules: []Rule{
+			{Comparison: LessThan, Category: Aerodynamic, Value: 2006, Destination: "qkq"},
+			{Comparison: GreaterThan, Category: Musical, Value: 2090, Destination: Accepted},
+			{Comparison: Always, Destination: "rfg"},
+		},
+	}, workflow)
+	assert.Equal(t, "px{a<2006:qkq,m>2090:A,rfg}", workflow.Describe())
+
+	_, err = ParseWorkflow("px{a<2006:qkq}")
+	assert.Error(t, err)
+
+	_, err = ParseWorkflow("px{q<2006:qkq,A}")
+	assert.Error(t, err)
+}
+
+func TestParsePart(t *testing.T) {
+	part, err := ParsePart("{x=787,m=2655,a=1222,s=2876}")
+	assert.NoError(t, err)
+	assert.Equal(t, Part{Ratings: [CategoryCount]int{787, 2655, 1222, 2876}}, part)
+	assert.Equal(t, 7540, part.TotalRating())
+
+	_, err = ParsePart("{x=787,m=2655}")
+	assert.Error(t, err)
+}
+
+func TestAccepts(t *testing.T) {
+	system, err := ParseSystem(exampleSystem)
+	assert.NoError(t, err)
+
+	expected := []bool{true, false, true, false, true}
+
+	for i, p := range system.Parts {
+		accepted, err := system.Accepts(p)
+		assert.NoError(t, err)
+		assert.Equal(t, expected[i], accepted)
+	}
+}
+
+func TestAcceptedRatingsTotal(t *testing.T) {
+	system, err := ParseSystem(exampleSystem)
+	assert.NoError(t, err)
+
+	total, err := system.AcceptedRatingsTotal()
+	assert.NoError(t, err)
+	assert.Equal(t, 19114, total)
+}
+
+func TestSplit(t *testing.T) {
+	h := NewHyperRectangle(1, 4000)
+
+	matched, unmatched := h.Split(Rule{Comparison: LessThan, Category: Aerodynamic, Value: 2006})
+	assert.Equal(t, Interval{Min: 1, Max: 2005}, matched[Aerodynamic])
+	assert.Equal(t, Interval{Min: 2006, Max: 4000}, unmatched[Aerodynamic])
+	assert.Equal(t, h.Combinations(), matched.Combinations()+unmatched.Combinations())
+
+	matched, unmatched = h.Split(Rule{Comparison: GreaterThan, Category: Musical, Value: 2090})
+	assert.Equal(t, Interval{Min: 2091, Max: 4000}, matched[Musical])
+	assert.Equal(t, Interval{Min: 1, Max: 2090}, unmatched[Musical])
+
+	matched, unmatched = h.Split(Rule{Comparison: Always, Destination: Accepted})
+	assert.Equal(t, h, matched)
+	assert.True(t, unmatched.Empty())
+}
+
+func TestAcceptedHyperRectangles(t *testing.T) {
+	system, err := ParseSystem(exampleSystem)
+	assert.NoError(t, err)
+
+	rectangles, err := system.AcceptedHyperRectangles(NewHyperRectangle(MinimumRating, MaximumRating))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(167409079868000), CountCombinations(rectangles))
+}
+
+func TestWorkflowLoop(t *testing.T) {
+	system, err := ParseSystem(`in{x<10:a,R}
+a{in}
+
+{x=1,m=1,a=1,s=1}`)
+	assert.NoError(t, err)
+
+	_, err = system.Accepts(system.Parts[0])
+	assert.Error(t, err)
+
+	_, err = system.AcceptedHyperRectangles(NewHyperRectangle(MinimumRating, MaximumRating))
+	assert.Error(t, err)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
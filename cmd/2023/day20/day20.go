@@ -9,6 +9,8 @@ import (
 	"io"
 	"log"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
@@ -18,7 +20,7 @@ import (
 // Day20Cmd represents the day20 command
 var Day20Cmd = &cobra.Command{
 	Use:   "day20",
-	Short: `Pulse Propagation - NOT COMPLETED`,
+	Short: `Pulse Propagation`,
 	Run: func(cmd *cobra.Command, args []string) {
 		df, err := os.Open(utilities.GetInputPath(cmd))
 		if err != nil {
@@ -38,153 +40,353 @@ var Day20Cmd = &cobra.Command{
 	},
 }
 
-type WrappedList struct {
-	List []int
+var graphvizPath string
+
+func init() {
+	Day20Cmd.Flags().StringVarP(&graphvizPath, "graphviz", "g", "", "write the module network as a Graphviz dot file")
 }
 
-func ParseWrappedList(fileContents string) *WrappedList {
-	wl := &WrappedList{List: make([]int, 0)}
+const (
+	Button      = "button"
+	Broadcaster = "broadcaster"
+	SandMachine = "rx"
 
-	for _, line := range strings.Split(fileContents, "\n") {
-		var number int
-		count, err := fmt.Sscanf(line, "%d", &number)
-		if err != nil {
-			log.Panic("invalid number")
+	MaximumCyclePresses = 1 << 20
+)
+
+type Pulse bool
+
+const (
+	Low  Pulse = false
+	High Pulse = true
+)
+
+func (p Pulse) Describe() string {
+	if p == High {
+		return "high"
+	}
+
+	return "low"
+}
+
+type ModuleType byte
+
+const (
+	Untyped ModuleType = iota
+	BroadcastModule
+	FlipFlop
+	Conjunction
+)
+
+type Module struct {
+	Name    string
+	Type    ModuleType
+	Inputs  []string
+	Outputs []string
+	On      bool
+	Memory  map[string]Pulse
+}
+
+func (m *Module) Describe() string {
+	prefix := ""
+
+	switch m.Type {
+	case FlipFlop:
+		prefix = "%"
+	case Conjunction:
+		prefix = "&"
+	}
+
+	return fmt.Sprintf("%s%s -> %s", prefix, m.Name, strings.Join(m.Outputs, ", "))
+}
+
+// Receive handles a pulse arriving at the module and returns the pulse it sends
+// to all of its outputs, if any.
+func (m *Module) Receive(source string, pulse Pulse) (Pulse, bool) {
+	switch m.Type {
+	case BroadcastModule:
+		return pulse, true
+	case FlipFlop:
+		if pulse == High {
+			return Low, false
 		}
-		if count != 1 {
-			log.Panic("invalid line")
+
+		m.On = !m.On
+
+		return Pulse(m.On), true
+	case Conjunction:
+		m.Memory[source] = pulse
+
+		for _, p := range m.Memory {
+			if p == Low {
+				return High, true
+			}
 		}
 
-		wl.List = append(wl.List, number)
+		return Low, true
 	}
 
-	return wl
+	return Low, false
 }
 
-func mod(a, b int) int {
-	return (a%b + b) % b
+type Signal struct {
+	Source      string
+	Destination string
+	Pulse       Pulse
 }
 
-func (w *WrappedList) NewIndex(index, delta int) int {
-	if delta == 0 {
-		return index
-	}
+func (s Signal) Describe() string {
+	return fmt.Sprintf("%s -%s-> %s", s.Source, s.Pulse.Describe(), s.Destination)
+}
+
+type Network struct {
+	Modules    map[string]*Module
+	Names      []string
+	LowPulses  int64
+	HighPulses int64
+	Presses    int64
+}
+
+func ParseNetwork(fileContents string) (*Network, error) {
+	network := &Network{Modules: make(map[string]*Module)}
+
+	moduleRE := regexp.MustCompile(`^([%&]?)([a-z0-9]+) -> ([a-z0-9, ]+)$`)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		matches := moduleRE.FindStringSubmatch(strings.TrimSpace(line))
+		if matches == nil {
+			return nil, fmt.Errorf("invalid module '%s'", line)
+		}
+
+		module := &Module{Name: matches[2]}
 
-	newIndex := mod(index+delta, len(w.List))
+		switch matches[1] {
+		case "%":
+			module.Type = FlipFlop
+		case "&":
+			module.Type = Conjunction
+		default:
+			if module.Name != Broadcaster {
+				return nil, fmt.Errorf("untyped module '%s'", module.Name)
+			}
+			module.Type = BroadcastModule
+		}
+
+		for _, o := range strings.Split(matches[3], ",") {
+			module.Outputs = append(module.Outputs, strings.TrimSpace(o))
+		}
 
-	if delta < 0 {
-		if newIndex > index {
-			// We wrapped.
-			newIndex--
-		} else if newIndex == 0 {
-			// Something something leapfrog versus swap...
-			newIndex = len(w.List) - 1
+		if _, ok := network.Modules[module.Name]; ok {
+			return nil, fmt.Errorf("duplicate module '%s'", module.Name)
 		}
-	} else if delta > 0 {
-		if newIndex < index {
-			// We wrapped.
-			newIndex++
-		} else if newIndex == len(w.List)-1 {
-			// Something something leapfrog versus swap...
-			newIndex = 0
+
+		network.Modules[module.Name] = module
+		network.Names = append(network.Names, module.Name)
+	}
+
+	if _, ok := network.Modules[Broadcaster]; !ok {
+		return nil, fmt.Errorf("missing %s module", Broadcaster)
+	}
+
+	// Outputs that are never declared, like rx, are untyped sinks.
+	for _, name := range slices.Clone(network.Names) {
+		for _, o := range network.Modules[name].Outputs {
+			destination, ok := network.Modules[o]
+			if !ok {
+				destination = &Module{Name: o, Type: Untyped}
+				network.Modules[o] = destination
+				network.Names = append(network.Names, o)
+			}
+
+			destination.Inputs = append(destination.Inputs, name)
 		}
 	}
 
-	return newIndex
+	network.Reset()
+
+	return network, nil
 }
 
-func (w *WrappedList) Move(index int, delta int) int {
-	newIndex := w.NewIndex(index, delta)
+func (n *Network) Reset() {
+	for _, m := range n.Modules {
+		m.On = false
+
+		if m.Type == Conjunction {
+			m.Memory = make(map[string]Pulse)
 
-	if newIndex == index {
-		return index
+			for _, i := range m.Inputs {
+				m.Memory[i] = Low
+			}
+		}
 	}
 
-	value := w.List[index]
+	n.LowPulses = 0
+	n.HighPulses = 0
+	n.Presses = 0
+}
+
+// PressButton sends a single low pulse to the broadcaster and processes pulses in
+// the order they were sent until the network settles. The observer, if any, sees
+// every pulse as it is delivered.
+func (n *Network) PressButton(observer func(s Signal)) {
+	n.Presses++
 
-	if newIndex > index {
-		for i := index; i < newIndex; i++ {
-			w.List[i] = w.List[i+1]
+	pulses := utilities.NewFIFO[Signal]()
+	pulses.Push(Signal{Source: Button, Destination: Broadcaster, Pulse: Low})
+
+	for !pulses.IsEmpty() {
+		s := pulses.Pop()
+
+		if s.Pulse == High {
+			n.HighPulses++
+		} else {
+			n.LowPulses++
 		}
-	} else {
-		for i := index; i > newIndex; i-- {
-			w.List[i] = w.List[i-1]
+
+		if observer != nil {
+			observer(s)
+		}
+
+		m := n.Modules[s.Destination]
+
+		pulse, send := m.Receive(s.Source, s.Pulse)
+		if !send {
+			continue
+		}
+
+		for _, o := range m.Outputs {
+			pulses.Push(Signal{Source: m.Name, Destination: o, Pulse: pulse})
 		}
 	}
+}
 
-	w.List[newIndex] = value
+func (n *Network) PulseProduct(presses int) int64 {
+	for i := 0; i < presses; i++ {
+		n.PressButton(nil)
+	}
 
-	return newIndex
+	return n.LowPulses * n.HighPulses
 }
 
-func (w *WrappedList) Mix() {
-	handled := make([]bool, len(w.List))
+// PressesUntilLowPulse finds how many button presses it takes for the destination
+// to receive a low pulse. The destination must be fed by a single conjunction, and
+// each input of that conjunction is assumed to send it a high pulse on a fixed
+// cycle starting from the first press, so the answer is the LCM of the cycles.
+func (n *Network) PressesUntilLowPulse(destination string) (int64, error) {
+	target, ok := n.Modules[destination]
+	if !ok {
+		return 0, fmt.Errorf("unknown module '%s'", destination)
+	}
 
-	for index := 0; index < len(w.List); {
-		if handled[index] {
-			index++
-			continue
+	if len(target.Inputs) != 1 || n.Modules[target.Inputs[0]].Type != Conjunction {
+		return 0, fmt.Errorf("%s must be fed by a single conjunction", destination)
+	}
+
+	feeder := n.Modules[target.Inputs[0]]
+
+	n.Reset()
+
+	firstHigh := make(map[string]int64)
+	cycles := make(map[string]int64)
+
+	for len(cycles) < len(feeder.Inputs) {
+		if n.Presses >= MaximumCyclePresses {
+			return 0, fmt.Errorf("no cycle found for inputs of %s after %d presses", feeder.Name, n.Presses)
 		}
 
-		d := w.List[index]
-		newIndex := w.Move(index, d)
+		n.PressButton(func(s Signal) {
+			if s.Destination != feeder.Name || s.Pulse != High {
+				return
+			}
 
-		if newIndex > index {
-			for i := index; i < newIndex; i++ {
-				handled[i] = handled[i+1]
+			if _, ok := cycles[s.Source]; ok {
+				return
 			}
-		} else {
-			for i := index; i > newIndex; i-- {
-				handled[i] = handled[i-1]
+
+			if first, ok := firstHigh[s.Source]; !ok {
+				firstHigh[s.Source] = n.Presses
+			} else if n.Presses != first {
+				cycles[s.Source] = n.Presses - first
 			}
+		})
+	}
+
+	lengths := make([]int64, 0, len(cycles))
+
+	for _, input := range feeder.Inputs {
+		if firstHigh[input] != cycles[input] {
+			return 0, fmt.Errorf("%s first sends high at press %d but cycles every %d", input, firstHigh[input], cycles[input])
 		}
 
-		handled[newIndex] = true
+		lengths = append(lengths, cycles[input])
 	}
+
+	return utilities.LCM(lengths...), nil
 }
 
-func (w *WrappedList) GetCoordinates() [3]int {
-	for i, n := range w.List {
-		if n == 0 {
-			c1 := w.List[mod(i+1000, len(w.List))]
-			c2 := w.List[mod(i+2000, len(w.List))]
-			c3 := w.List[mod(i+3000, len(w.List))]
-			return [3]int{c1, c2, c3}
+func (n *Network) Graphviz() string {
+	var b strings.Builder
+
+	b.WriteString("digraph modules {\n")
+
+	for _, name := range n.Names {
+		m := n.Modules[name]
+
+		shape := "ellipse"
+		label := name
+
+		switch m.Type {
+		case BroadcastModule:
+			shape = "doublecircle"
+		case FlipFlop:
+			shape = "box"
+			label = "%" + name
+		case Conjunction:
+			shape = "diamond"
+			label = "&" + name
 		}
-	}
 
-	log.Panic("Couldn't find 0")
-	return [3]int{0, 0, 0}
-}
+		fmt.Fprintf(&b, "\t%q [shape=%s, label=%q];\n", name, shape, label)
+	}
 
-func (w *WrappedList) Describe() string {
-	str := ""
-	for i, n := range w.List {
-		if i != 0 {
-			str += ", "
+	for _, name := range n.Names {
+		for _, o := range n.Modules[name].Outputs {
+			fmt.Fprintf(&b, "\t%q -> %q;\n", name, o)
 		}
-		str += fmt.Sprint(n)
 	}
 
-	return str
+	b.WriteString("}\n")
+
+	return b.String()
 }
 
 func day(fileContents string) error {
-	wl := ParseWrappedList(fileContents)
-
-	// Part 1: Mix the input file to decrypt it.  Get the coordinates.
-	wl.Mix()
-
-	sum := 0
+	network, err := ParseNetwork(fileContents)
+	if err != nil {
+		return err
+	}
 
-	for _, c := range wl.GetCoordinates() {
-		sum += c
+	if graphvizPath != "" {
+		if err := os.WriteFile(graphvizPath, []byte(network.Graphviz()), 0644); err != nil {
+			return err
+		}
 	}
 
-	fmt.Printf("Sum of grove coordinates: %d\n", sum)
+	// Part 1: Determine the number of low pulses and high pulses that would be sent
+	// after pushing the button 1000 times, waiting for all pulses to be fully handled
+	// after each push of the button. What do you get if you multiply the total number
+	// of low pulses sent by the total number of high pulses sent?
+	utilities.Answer(1, "Product of low and high pulses", network.PulseProduct(1000))
+
+	// Part 2: Reset all modules to their default states. Waiting for all pulses to be
+	// fully handled after each button press, what is the fewest number of button presses
+	// required to deliver a single low pulse to the module named rx?
+	presses, err := network.PressesUntilLowPulse(SandMachine)
+	if err != nil {
+		return err
+	}
 
-	// Part 2: Ignore the surfaces that are trapped within the droplets.  What is the exterior
-	// surface area of the lava droplet?
+	utilities.Answer(2, fmt.Sprintf("Button presses until %s receives a low pulse", SandMachine), presses)
 
 	return nil
 }
@@ -6,228 +6,207 @@ package TwentyTwentyThree_day20
 
 import (
 	"fmt"
+	"math/bits"
+	"strings"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestDescribe(t *testing.T) {
-	type testCase struct {
-		str                 string
-		expectedDescription string
-	}
-	testCases := []testCase{
-		{
-			str: `1
-2
--3
-3
--2
-0
-4`,
-			expectedDescription: "1, 2, -3, 3, -2, 0, 4",
-		},
+const firstExample = `broadcaster -> a, b, c
+%a -> b
+%b -> c
+%c -> inv
+&inv -> a`
+
+const secondExample = `broadcaster -> a
+%a -> inv, con
+&inv -> b
+%b -> con
+&con -> output`
+
+// counterNetwork builds a binary counter like the ones in the puzzle inputs: a chain
+// of flip-flops whose set bits feed a conjunction, which resets the counter and
+// pulses its inverter high once every period presses.
+func counterNetwork(name string, period int) []string {
+	lines := make([]string, 0)
+	flipFlop := func(bit int) string {
+		return fmt.Sprintf("%s%d", name, bit)
 	}
 
-	for _, test := range testCases {
-		wl := ParseWrappedList(test.str)
-		assert.Equal(t, test.expectedDescription, wl.Describe())
+	conjunction := "c" + name
+	inverter := "i" + name
+	resets := []string{flipFlop(0)}
+	width := bits.Len(uint(period))
+
+	for bit := 0; bit < width; bit++ {
+		outputs := make([]string, 0)
+
+		if bit < width-1 {
+			outputs = append(outputs, flipFlop(bit+1))
+		}
+
+		if period&(1<<bit) != 0 {
+			outputs = append(outputs, conjunction)
+		} else {
+			resets = append(resets, flipFlop(bit))
+		}
+
+		lines = append(lines, fmt.Sprintf("%%%s -> %s", flipFlop(bit), strings.Join(outputs, ", ")))
 	}
+
+	lines = append(lines, fmt.Sprintf("&%s -> %s, %s", conjunction, strings.Join(resets, ", "), inverter))
+	lines = append(lines, fmt.Sprintf("&%s -> hub", inverter))
+
+	return lines
 }
 
-func TestNewIndex(t *testing.T) {
-	type testCase struct {
-		index            int
-		delta            int
-		expectedNewIndex int
-	}
-	testCases := []testCase{
-		{
-			index:            0,
-			delta:            1,
-			expectedNewIndex: 1,
-		},
-		{
-			index:            0,
-			delta:            2,
-			expectedNewIndex: 2,
-		},
-		{
-			index:            1,
-			delta:            -3,
-			expectedNewIndex: 4,
-		},
-		{
-			index:            2,
-			delta:            3,
-			expectedNewIndex: 5,
-		},
-		{
-			index:            2,
-			delta:            -2,
-			expectedNewIndex: 6,
-		},
-		{
-			index:            3,
-			delta:            0,
-			expectedNewIndex: 3,
-		},
-		{
-			index:            5,
-			delta:            4,
-			expectedNewIndex: 3,
-		},
+func counterExample(periods []int) string {
+	lines := make([]string, 0)
+	starts := make([]string, 0)
+
+	for i, p := range periods {
+		name := string(rune('a' + i))
+		starts = append(starts, name+"0")
+		lines = append(lines, counterNetwork(name, p)...)
 	}
 
-	str := `1
-2
--3
-3
--2
-0
-4`
+	lines = append(lines, "broadcaster -> "+strings.Join(starts, ", "))
+	lines = append(lines, "&hub -> rx")
 
-	for _, test := range testCases {
-		wl := ParseWrappedList(str)
-		assert.Equal(t, test.expectedNewIndex, wl.NewIndex(test.index, test.delta), fmt.Sprintf("index=%d delta=%d", test.index, test.delta))
-	}
+	return strings.Join(lines, "\n")
+}
+
+func TestParseNetwork(t *testing.T) {
+	network, err := ParseNetwork(secondExample)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"broadcaster", "a", "inv", "b", "con", "output"}, network.Names)
+	assert.Equal(t, FlipFlop, network.Modules["a"].Type)
+	assert.Equal(t, Conjunction, network.Modules["con"].Type)
+	assert.Equal(t, Untyped, network.Modules["output"].Type)
+	assert.Equal(t, []string{"a", "b"}, network.Modules["con"].Inputs)
+	assert.Equal(t, map[string]Pulse{"a": Low, "b": Low}, network.Modules["con"].Memory)
+	assert.Equal(t, "%a -> inv, con", network.Modules["a"].Describe())
+
+	_, err = ParseNetwork("a -> b")
+	assert.Error(t, err)
+
+	_, err = ParseNetwork("%a -> b")
+	assert.Error(t, err)
+}
+
+func TestPressButton(t *testing.T) {
+	network, err := ParseNetwork(firstExample)
+	assert.NoError(t, err)
+
+	signals := make([]string, 0)
+	network.PressButton(func(s Signal) {
+		signals = append(signals, s.Describe())
+	})
+
+	assert.Equal(t, []string{
+		"button -low-> broadcaster",
+		"broadcaster -low-> a",
+		"broadcaster -low-> b",
+		"broadcaster -low-> c",
+		"a -high-> b",
+		"b -high-> c",
+		"c -high-> inv",
+		"inv -low-> a",
+		"a -low-> b",
+		"b -low-> c",
+		"c -low-> inv",
+		"inv -high-> a",
+	}, signals)
+
+	assert.Equal(t, int64(8), network.LowPulses)
+	assert.Equal(t, int64(4), network.HighPulses)
 }
 
-func TestMove(t *testing.T) {
+func TestPulseProduct(t *testing.T) {
 	type testCase struct {
-		str          string
-		index        int
-		delta        int
-		expectedList string
+		text            string
+		expectedProduct int64
 	}
+
 	testCases := []testCase{
-		{
-			str: `1
-2
--3
-3
--2
-0
-4`,
-			index:        0,
-			delta:        1,
-			expectedList: "2, 1, -3, 3, -2, 0, 4",
-		},
-		{
-			str: `2
-1
--3
-3
--2
-0
-4`,
-			index:        0,
-			delta:        2,
-			expectedList: "1, -3, 2, 3, -2, 0, 4",
-		},
-		{
-			str: `1
--3
-2
-3
--2
-0
-4`,
-			index:        1,
-			delta:        -3,
-			expectedList: "1, 2, 3, -2, -3, 0, 4",
-		},
-		{
-			str: `1
-2
-3
--2
--3
-0
-4`,
-			index:        2,
-			delta:        3,
-			expectedList: "1, 2, -2, -3, 0, 3, 4",
-		},
-		{
-			str: `1
-2
--2
--3
-0
-3
-4`,
-			index:        2,
-			delta:        -2,
-			expectedList: "1, 2, -3, 0, 3, 4, -2",
-		},
-		{
-			str: `1
-2
--3
-0
-3
-4
--2`,
-			index:        3,
-			delta:        0,
-			expectedList: "1, 2, -3, 0, 3, 4, -2",
-		},
-		{
-			str: `1
-2
--3
-0
-3
-4
--2`,
-			index:        5,
-			delta:        4,
-			expectedList: "1, 2, -3, 4, 0, 3, -2",
-		},
+		{text: firstExample, expectedProduct: 32000000},
+		{text: secondExample, expectedProduct: 11687500},
 	}
 
 	for _, test := range testCases {
-		wl := ParseWrappedList(test.str)
-		wl.Move(test.index, test.delta)
-		assert.Equal(t, test.expectedList, wl.Describe(), fmt.Sprintf("index=%d delta=%d", test.index, test.delta))
+		network, err := ParseNetwork(test.text)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedProduct, network.PulseProduct(1000))
 	}
 }
 
-func TestMix(t *testing.T) {
+func TestPressesUntilLowPulse(t *testing.T) {
 	type testCase struct {
-		str          string
-		expectedList string
+		periods         []int
+		expectedPresses int64
 	}
+
 	testCases := []testCase{
-		{
-			str: `1
-2
--3
-3
--2
-0
-4`,
-			expectedList: "1, 2, -3, 4, 0, 3, -2",
-		},
+		{periods: []int{3, 5}, expectedPresses: 15},
+		{periods: []int{7, 11, 13}, expectedPresses: 1001},
+		{periods: []int{9, 15}, expectedPresses: 45},
 	}
 
 	for _, test := range testCases {
-		wl := ParseWrappedList(test.str)
-		wl.Mix()
-		assert.Equal(t, test.expectedList, wl.Describe())
+		network, err := ParseNetwork(counterExample(test.periods))
+		assert.NoError(t, err)
+
+		presses, err := network.PressesUntilLowPulse(SandMachine)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedPresses, presses)
+
+		// Brute force the same answer.
+		network.Reset()
+		received := false
+
+		for !received && network.Presses < presses {
+			network.PressButton(func(s Signal) {
+				if s.Destination == SandMachine && s.Pulse == Low {
+					received = true
+				}
+			})
+		}
+
+		assert.True(t, received)
+		assert.Equal(t, presses, network.Presses)
 	}
+
+	network, err := ParseNetwork(firstExample)
+	assert.NoError(t, err)
+
+	_, err = network.PressesUntilLowPulse(SandMachine)
+	assert.Error(t, err)
+}
+
+func TestGraphviz(t *testing.T) {
+	network, err := ParseNetwork(firstExample)
+	assert.NoError(t, err)
+
+	assert.Equal(t, `digraph modules {
+	"broadcaster" [shape=doublecircle, label="broadcaster"];
+	"a" [shape=box, label="%a"];
+	"b" [shape=box, label="%b"];
+	"c" [shape=box, label="%c"];
+	"inv" [shape=diamond, label="&inv"];
+	"broadcaster" -> "a";
+	"broadcaster" -> "b";
+	"broadcaster" -> "c";
+	"a" -> "b";
+	"b" -> "c";
+	"c" -> "inv";
+	"inv" -> "a";
+}
+`, network.Graphviz())
 }
 
-func TestGetCoordinates(t *testing.T) {
-	str := `1
-2
--3
-4
-0
-3
--2`
-
-	wl := ParseWrappedList(str)
-	assert.Equal(t, [3]int{4, -3, 2}, wl.GetCoordinates())
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
 }
@@ -5,7 +5,6 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree_day21
 
 import (
-	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -19,7 +18,7 @@ import (
 // Day21Cmd represents the day21 command
 var Day21Cmd = &cobra.Command{
 	Use:   "day21",
-	Short: `Step Counter - NOT COMPLETED`,
+	Short: `Step Counter`,
 	Run: func(cmd *cobra.Command, args []string) {
 		df, err := os.Open(utilities.GetInputPath(cmd))
 		if err != nil {
@@ -39,349 +38,260 @@ var Day21Cmd = &cobra.Command{
 	},
 }
 
-type OperationFn func(a, b int) int
+const (
+	Steps         = 64
+	InfiniteSteps = 26501365
 
-type TreeNodeLookupMap map[string]*TreeNode
+	// StableSamples is how many equal second differences in a row are needed
+	// before the reachable plot counts are trusted to grow quadratically.
+	StableSamples = 3
 
-type TreeRoot struct {
-	Lookup TreeNodeLookupMap
-}
-
-func NewTreeRoot(lookup TreeNodeLookupMap) *TreeRoot {
-	return &TreeRoot{Lookup: lookup}
-}
+	// MaximumSamples bounds how many map widths the search walks looking for
+	// quadratic growth before giving up.
+	MaximumSamples = 40
+)
 
-func (t *TreeRoot) FindNode(nodeName string) *TreeNode {
-	return t.Lookup[nodeName]
-}
+type Tile byte
 
-func (t *TreeRoot) Evaluate(nodeName string) int {
-	node := t.FindNode(nodeName)
+const (
+	GardenPlot Tile = iota
+	Rock
+)
 
-	if node.Leaf {
-		return node.Value
+func (t Tile) Describe() string {
+	switch t {
+	case GardenPlot:
+		return "."
+	case Rock:
+		return "#"
 	}
 
-	// Parent node, evaluate the left and right children.
-	node.LeftValue = t.Evaluate(node.Left)
-	node.RightValue = t.Evaluate(node.Right)
+	log.Panicf("unknown tile: %d\n", t)
+	return "X"
+}
 
-	// If either of the children are poisoned, we can't evaluate.  Return a sentinel for debugging.
-	if node.LeftPoisoned || node.RightPoisoned {
-		return -1
-	}
+type Row []Tile
 
-	return node.Op(node.LeftValue, node.RightValue)
+type Garden struct {
+	Bounds utilities.Size2D
+	Start  utilities.Point2D
+	Rows   []Row
 }
 
-func (t *TreeRoot) Solve(nodeName string, result int) int {
-	// Follow the poisoned path, evaluating each node until
-	// we get to the poisoned node.
-
-	node := t.FindNode(nodeName)
+func ParseGarden(fileContents string) (*Garden, error) {
+	garden := &Garden{}
+	foundStart := false
+
+	for y, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		line = strings.TrimSpace(line)
+		row := make(Row, 0, len(line))
+
+		for x, c := range line {
+			switch c {
+			case '.':
+				row = append(row, GardenPlot)
+			case '#':
+				row = append(row, Rock)
+			case 'S':
+				if foundStart {
+					return nil, fmt.Errorf("multiple starting positions")
+				}
+
+				foundStart = true
+				garden.Start = utilities.NewPoint2D(x, y)
+				row = append(row, GardenPlot)
+			default:
+				return nil, fmt.Errorf("unknown tile '%c' at %d,%d", c, x, y)
+			}
+		}
 
-	// Handle root special.
-	if nodeName == RootMonkeyName {
-		if node.LeftPoisoned {
-			result = node.RightValue
-			node = t.FindNode(node.Left)
-		} else {
-			result = node.LeftValue
-			node = t.FindNode(node.Right)
+		if y > 0 && len(row) != garden.Bounds.Width {
+			return nil, fmt.Errorf("row %d has width %d, expected %d", y, len(row), garden.Bounds.Width)
 		}
-	}
 
-	if node.Poisoned {
-		return result
+		garden.Rows = append(garden.Rows, row)
+		garden.Bounds.Width = len(row)
+		garden.Bounds.Height++
 	}
 
-	// There should only be one poisoned child...
-	if node.LeftPoisoned {
-		newResult := NodeSolve(node, node.RightValue, result)
-		return t.Solve(node.Left, newResult)
-	} else {
-		newResult := NodeSolve(node, node.LeftValue, result)
-		return t.Solve(node.Right, newResult)
+	if !foundStart {
+		return nil, fmt.Errorf("no starting position")
 	}
+
+	return garden, nil
 }
 
-func (t *TreeRoot) PromotePoison(nodeName string) bool {
-	node := t.Lookup[nodeName]
+func (g *Garden) Describe() string {
+	description := ""
 
-	if !node.Leaf {
-		if t.PromotePoison(node.Left) {
-			node.LeftPoisoned = true
+	for y, r := range g.Rows {
+		if y != 0 {
+			description += "\n"
 		}
 
-		if t.PromotePoison(node.Right) {
-			node.RightPoisoned = true
+		for x, t := range r {
+			if g.Start == utilities.NewPoint2D(x, y) {
+				description += "S"
+			} else {
+				description += t.Describe()
+			}
 		}
-
-		return node.LeftPoisoned || node.RightPoisoned
 	}
 
-	return node.Poisoned
-}
-
-type TreeNode struct {
-	Poisoned bool
-
-	Name string
-
-	// Each node is either a leaf and has a value or has children and an operation.
-	Leaf bool
-
-	Value int
-
-	Op            OperationFn
-	InvertOpLeft  OperationFn
-	InvertOpRight OperationFn
-	Left          string
-	LeftPoisoned  bool
-	LeftValue     int
-	Right         string
-	RightPoisoned bool
-	RightValue    int
+	return description
 }
 
-const RootMonkeyName = "root"
-
-var AddOp = func(a, b int) int { return a + b }
-var InvertAddLeft = func(a, b int) int { return b - a }
-var InvertAddRight = func(a, b int) int { return b - a }
+// GetTile returns the tile at the position. With infinite set the map repeats in
+// every direction, otherwise positions off the map are rocks.
+func (g *Garden) GetTile(position utilities.Point2D, infinite bool) Tile {
+	if infinite {
+		x := ((position.X % g.Bounds.Width) + g.Bounds.Width) % g.Bounds.Width
+		y := ((position.Y % g.Bounds.Height) + g.Bounds.Height) % g.Bounds.Height
 
-var SubtractOp = func(a, b int) int { return a - b }
-var InvertSubtractLeft = func(a, b int) int { return b + a }
-var InvertSubtractRight = func(a, b int) int { return a - b }
-
-var MultiplyOp = func(a, b int) int { return a * b }
-var InvertMultiplyLeft = func(a, b int) int { return b / a }
-var InvertMultiplyRight = func(a, b int) int { return b / a }
-
-var DivideOp = func(a, b int) int { return a / b }
-var InvertDivideLeft = func(a, b int) int { return b * a }
-var InvertDivideRight = func(a, b int) int { return a / b }
-
-func NewLeafNode(name string, value int) *TreeNode {
-	return &TreeNode{Name: name, Leaf: true, Value: value}
-}
-
-func NodeSolve(node *TreeNode, a, result int) int {
-	if node.LeftPoisoned {
-		return node.InvertOpLeft(a, result)
+		return g.Rows[y][x]
 	}
-	return node.InvertOpRight(a, result)
-}
 
-func NewParentNode(name string, operation byte) *TreeNode {
-	n := &TreeNode{Name: name, Leaf: false}
-
-	switch operation {
-	case '+':
-		n.Op = AddOp
-		n.InvertOpLeft = InvertAddLeft
-		n.InvertOpRight = InvertAddRight
-	case '-':
-		n.Op = SubtractOp
-		n.InvertOpLeft = InvertSubtractLeft
-		n.InvertOpRight = InvertSubtractRight
-	case '*':
-		n.Op = MultiplyOp
-		n.InvertOpLeft = InvertMultiplyLeft
-		n.InvertOpRight = InvertMultiplyRight
-	case '/':
-		n.Op = DivideOp
-		n.InvertOpLeft = InvertDivideLeft
-		n.InvertOpRight = InvertDivideRight
-	default:
-		panic("invalid operation")
+	if position.X < 0 || position.X >= g.Bounds.Width || position.Y < 0 || position.Y >= g.Bounds.Height {
+		return Rock
 	}
 
-	return n
+	return g.Rows[position.Y][position.X]
 }
 
-func CreateTree(poisonName string, fileContents string) (*TreeRoot, error) {
-	lookupMap := make(TreeNodeLookupMap)
-	var rootNode *TreeNode
-
-	for _, line := range strings.Split(fileContents, "\n") {
-
-		var n string
-		var operation byte
-		var src1MonkeyName string
-		var src2MonkeyName string
-
-		// Try line of "monkeyName: monkeyName OP monkeyName" form first.
-		count, err := fmt.Sscanf(line, "%s %s %c %s", &n, &src1MonkeyName, &operation, &src2MonkeyName)
-		if err == nil && count == 4 {
-			monkeyName := strings.TrimSuffix(n, ":")
-
-			node := NewParentNode(monkeyName, operation)
-
-			node.Left = src1MonkeyName
-			node.Right = src2MonkeyName
-
-			// Remember root node.
-			if node.Name == RootMonkeyName {
-				rootNode = node
-			}
+// Walk does a breadth first search out from the start, one step at a time. After
+// every step it calls reached with the number of plots that can be stood on after
+// exactly that many steps, stopping when reached returns false or the limit is hit.
+// A plot first reached in d steps can be reached again in any later step count
+// of the same parity by stepping back and forth, so the count for step s is the
+// number of plots at a distance no greater than s with the same parity.
+func (g *Garden) Walk(limit int, infinite bool, reached func(step int, plots int) bool) {
+	visited := utilities.NewSetPoint2D()
+	visited.Add(g.Start)
+
+	frontier := []utilities.Point2D{g.Start}
+	plotsByParity := [2]int{1, 0}
+
+	if !reached(0, plotsByParity[0]) {
+		return
+	}
 
-			// Add the node to the lookup table.
-			lookupMap[monkeyName] = node
+	for step := 1; step <= limit; step++ {
+		next := make([]utilities.Point2D, 0, len(frontier))
 
-		} else {
-			// Try line of "monkeyName: number" form.
-			var number int
+		for _, p := range frontier {
+			for _, n := range []utilities.Point2D{p.Up(), p.Down(), p.Left(), p.Right()} {
+				if visited.Exists(n) || g.GetTile(n, infinite) == Rock {
+					continue
+				}
 
-			count, err = fmt.Sscanf(line, "%s %d", &n, &number)
-			if err != nil {
-				return nil, err
-			}
-			if count != 2 {
-				return nil, errors.New("invalid monkey line")
+				visited.Add(n)
+				next = append(next, n)
 			}
+		}
 
-			monkeyName := strings.TrimSuffix(n, ":")
-
-			node := NewLeafNode(monkeyName, number)
-
-			if monkeyName == poisonName {
-				node.Poisoned = true
+		plotsByParity[step%2] += len(next)
+		frontier = next
 
-				// Let's stick a sentinel value here for debugging...
-				node.Value = -1
-			}
-
-			// Add the node to the lookup table.
-			lookupMap[monkeyName] = node
+		if !reached(step, plotsByParity[step%2]) {
+			return
 		}
 	}
+}
 
-	treeRoot := NewTreeRoot(lookupMap)
+func (g *Garden) ReachablePlots(steps int, infinite bool) int {
+	plots := 0
 
-	// Now walk through the tree, bottom up, bringing up the poison flag.
-	treeRoot.PromotePoison(RootMonkeyName)
+	g.Walk(steps, infinite, func(step int, p int) bool {
+		plots = p
+		return true
+	})
 
-	if rootNode.LeftPoisoned && rootNode.RightPoisoned {
-		return nil, errors.New("both sides of the root tree are poisoned")
+	return plots
+}
+
+// InfiniteReachablePlots counts the plots reachable in exactly steps steps on the
+// infinitely repeating map without walking all of them. Once the walk has spread
+// across a few copies of the map, the count sampled every map width grows
+// quadratically, so after the second differences of the samples settle the rest
+// is extrapolated.
+func (g *Garden) InfiniteReachablePlots(steps int) (int, error) {
+	if g.Bounds.Width != g.Bounds.Height {
+		return 0, fmt.Errorf("garden must be square, not %dx%d", g.Bounds.Width, g.Bounds.Height)
 	}
 
-	return treeRoot, nil
-}
+	period := g.Bounds.Width
+	remainder := steps % period
 
-type MonkeyChannel chan int
+	samples := make([]int, 0)
+	plots := 0
+	stable := 0
 
-func GetOrCreateMonkeyChannel(monkeyChannelMap map[string]MonkeyChannel, monkeyName string) MonkeyChannel {
-	mc, ok := monkeyChannelMap[monkeyName]
-	if !ok {
-		// Need to create the channel.
-		mc = make(MonkeyChannel, 1)
-		monkeyChannelMap[monkeyName] = mc
-	}
+	g.Walk(steps, true, func(step int, p int) bool {
+		plots = p
 
-	return mc
-}
+		if step%period != remainder {
+			return true
+		}
 
-func CreateChannels(fileContents string) (MonkeyChannel, error) {
-	monkeyChannelMap := make(map[string]MonkeyChannel)
-
-	for _, line := range strings.Split(fileContents, "\n") {
-
-		var monkeyName string
-		var operation byte
-		var src1MonkeyName string
-		var src2MonkeyName string
-
-		// Try line of "monkeyName: monkeyName OP monkeyName" form first.
-		count, err := fmt.Sscanf(line, "%s %s %c %s", &monkeyName, &src1MonkeyName, &operation, &src2MonkeyName)
-		if err == nil && count == 4 {
-			// Has this monkey channel already been created?
-			monkeyChannel := GetOrCreateMonkeyChannel(monkeyChannelMap, strings.TrimSuffix(monkeyName, ":"))
-
-			// Get the channels for our dependencies.
-			src1MonkeyChannel := GetOrCreateMonkeyChannel(monkeyChannelMap, src1MonkeyName)
-			src2MonkeyChannel := GetOrCreateMonkeyChannel(monkeyChannelMap, src2MonkeyName)
-
-			// Now spin up a go routine to wait for our dependencies.
-			switch operation {
-			case '+':
-				go func() {
-					num1 := <-src1MonkeyChannel
-					num2 := <-src2MonkeyChannel
-					monkeyChannel <- num1 + num2
-				}()
-			case '-':
-				go func() {
-					num1 := <-src1MonkeyChannel
-					num2 := <-src2MonkeyChannel
-					monkeyChannel <- num1 - num2
-				}()
-			case '*':
-				go func() {
-					num1 := <-src1MonkeyChannel
-					num2 := <-src2MonkeyChannel
-					monkeyChannel <- num1 * num2
-				}()
-			case '/':
-				go func() {
-					num1 := <-src1MonkeyChannel
-					num2 := <-src2MonkeyChannel
-					monkeyChannel <- num1 / num2
-				}()
-			default:
-				return nil, errors.New("invalid operation")
-			}
-		} else {
-			// Try line of "monkeyName: number" form.
-			var number int
+		samples = append(samples, p)
 
-			count, err = fmt.Sscanf(line, "%s %d", &monkeyName, &number)
-			if err != nil {
-				return nil, err
-			}
-			if count != 2 {
-				return nil, errors.New("invalid monkey line")
+		if n := len(samples); n >= 4 {
+			previous := samples[n-2] - 2*samples[n-3] + samples[n-4]
+			current := samples[n-1] - 2*samples[n-2] + samples[n-3]
+
+			if previous == current {
+				stable++
+			} else {
+				stable = 0
 			}
+		}
 
-			// Has this monkey channel already been created?
-			monkeyChannel := GetOrCreateMonkeyChannel(monkeyChannelMap, strings.TrimSuffix(monkeyName, ":"))
+		return stable < StableSamples && len(samples) < MaximumSamples
+	})
 
-			// Push the number into the channel
-			monkeyChannel <- number
-		}
+	sampled := remainder + (len(samples)-1)*period
+	if sampled == steps {
+		return plots, nil
 	}
 
-	rootChannel, ok := monkeyChannelMap[RootMonkeyName]
-	if !ok {
-		return nil, errors.New("missing root channel")
+	if stable < StableSamples {
+		return 0, fmt.Errorf("reachable plots did not settle into quadratic growth after %d samples", len(samples))
 	}
 
-	return rootChannel, nil
+	n := len(samples)
+	firstDifference := samples[n-1] - samples[n-2]
+	secondDifference := samples[n-1] - 2*samples[n-2] + samples[n-3]
+	remaining := (steps - sampled) / period
+
+	return samples[n-1] + remaining*firstDifference + secondDifference*remaining*(remaining+1)/2, nil
 }
 
 func day(fileContents string) error {
+	return solve(fileContents, Steps, InfiniteSteps)
+}
 
-	// Part 1: Monkeys yell numbers.  Other monkeys listen for specific other monkeys and do math on the numbers they here.
-	// root is the alpha monkey.  What number will it yell?
-	rootChannel, err := CreateChannels(fileContents)
+// solve answers both parts for walks of the given numbers of steps. The puzzle's
+// examples take far fewer.
+func solve(fileContents string, steps int, infiniteSteps int) error {
+	garden, err := ParseGarden(fileContents)
 	if err != nil {
 		return err
 	}
 
-	// Now wait for the output of RootMonkeyName channel.
-	fmt.Printf("Root will yell: %d\n", <-rootChannel)
+	// Part 1: Starting from the garden plot marked S on your map, how many garden
+	// plots could the Elf reach in exactly 64 steps?
+	utilities.Answer(1, fmt.Sprintf("Garden plots reachable in %d steps", steps), garden.ReachablePlots(steps, false))
 
-	// Part 2: Confusion!  root monkey isn't doing math on its two dependent numbers: it's equality.  Both numbers need to be the same.
-	// And humn monkey isn't a monkey, it's you!  So what number do you have to yell such that root's two dependent numbers are equal?
-	t, err := CreateTree("humn", fileContents)
+	// Part 2: The actual number of steps he needs to get today is exactly 26501365.
+	// The map repeats infinitely in every direction. Starting from the garden plot
+	// marked S on your infinite map, how many garden plots could the Elf reach in
+	// exactly 26501365 steps?
+	plots, err := garden.InfiniteReachablePlots(infiniteSteps)
 	if err != nil {
 		return err
 	}
 
-	t.Evaluate(RootMonkeyName)
-
-	result := t.Solve(RootMonkeyName, 0)
-	fmt.Printf("You should yell: %d\n", result)
+	utilities.Answer(2, fmt.Sprintf("Garden plots reachable in %d steps", infiniteSteps), plots)
 
 	return nil
 }
@@ -5,186 +5,141 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyThree_day21
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestCreateChannels(t *testing.T) {
-	src := `root: pppw + sjmn
-dbpl: 5
-cczh: sllz + lgvd
-zczc: 2
-ptdq: humn - dvpt
-dvpt: 3
-lfqf: 4
-humn: 5
-ljgn: 2
-sjmn: drzm * dbpl
-sllz: 4
-pppw: cczh / lfqf
-lgvd: ljgn * ptdq
-drzm: hmdt - zczc
-hmdt: 32`
-
-	rootChannel, err := CreateChannels(src)
+const exampleGarden = `...........
+.....###.#.
+.###.##..#.
+..#.#...#..
+....#.#....
+.##..S####.
+.##..#...#.
+.......##..
+.##.#.####.
+.##..##.##.
+...........`
+
+// Like the puzzle inputs, this garden has an empty border and empty middle row
+// and column, so the walk reaches every copy of the map as quickly as possible.
+const openGarden = `...........
+.##..#..##.
+.#...#...#.
+...#.#.#...
+.#...#.#.#.
+.....S.....
+.#.#.#..##.
+..#..#.#...
+.##..#..#..
+.#...#...#.
+...........`
+
+func TestParseGarden(t *testing.T) {
+	garden, err := ParseGarden(exampleGarden)
 	assert.NoError(t, err)
-	if err != nil {
-		assert.FailNow(t, "CreateChannels error", err)
-	}
-
-	number := <-rootChannel
-	assert.Equal(t, 152, number)
-}
 
-func TestCreateTree(t *testing.T) {
-	src := `root: pppw + sjmn
-dbpl: 5
-cczh: sllz + lgvd
-zczc: 2
-ptdq: humn - dvpt
-dvpt: 3
-lfqf: 4
-humn: 5
-ljgn: 2
-sjmn: drzm * dbpl
-sllz: 4
-pppw: cczh / lfqf
-lgvd: ljgn * ptdq
-drzm: hmdt - zczc
-hmdt: 32`
-
-	_, err := CreateTree("humn", src)
-	assert.NoError(t, err)
-}
-
-func TestEvaluate(t *testing.T) {
-	src := `root: pppw + sjmn
-dbpl: 5
-cczh: sllz + lgvd
-zczc: 2
-ptdq: humn - dvpt
-dvpt: 3
-lfqf: 4
-humn: 5
-ljgn: 2
-sjmn: drzm * dbpl
-sllz: 4
-pppw: cczh / lfqf
-lgvd: ljgn * ptdq
-drzm: hmdt - zczc
-hmdt: 32`
-
-	treeRoot, err := CreateTree("humn", src)
-	assert.NoError(t, err)
+	assert.Equal(t, utilities.NewSize2D(11, 11), garden.Bounds)
+	assert.Equal(t, utilities.NewPoint2D(5, 5), garden.Start)
+	assert.Equal(t, exampleGarden, garden.Describe())
 
-	treeRoot.Evaluate(RootMonkeyName)
+	_, err = ParseGarden("...\n.#.\n...")
+	assert.Error(t, err)
 
-	rootNode := treeRoot.FindNode(RootMonkeyName)
+	_, err = ParseGarden("S..\n.S.\n...")
+	assert.Error(t, err)
 
-	if rootNode.LeftPoisoned {
-		assert.Equal(t, 150, rootNode.RightValue)
-	} else {
-		assert.Equal(t, 150, rootNode.LeftValue)
-	}
+	_, err = ParseGarden("S..\n.x.\n...")
+	assert.Error(t, err)
 }
 
-func TestSolve(t *testing.T) {
-	src := `root: pppw + sjmn
-dbpl: 5
-cczh: sllz + lgvd
-zczc: 2
-ptdq: humn - dvpt
-dvpt: 3
-lfqf: 4
-humn: 5
-ljgn: 2
-sjmn: drzm * dbpl
-sllz: 4
-pppw: cczh / lfqf
-lgvd: ljgn * ptdq
-drzm: hmdt - zczc
-hmdt: 32`
-
-	treeRoot, err := CreateTree("humn", src)
+func TestGetTile(t *testing.T) {
+	garden, err := ParseGarden(exampleGarden)
 	assert.NoError(t, err)
 
-	treeRoot.Evaluate(RootMonkeyName)
-
-	result := treeRoot.Solve(RootMonkeyName, 0)
-
-	assert.Equal(t, 301, result)
+	assert.Equal(t, Rock, garden.GetTile(utilities.NewPoint2D(5, 1), false))
+	assert.Equal(t, GardenPlot, garden.GetTile(utilities.NewPoint2D(0, 0), false))
+	assert.Equal(t, Rock, garden.GetTile(utilities.NewPoint2D(-1, 0), false))
+	assert.Equal(t, GardenPlot, garden.GetTile(utilities.NewPoint2D(-1, 0), true))
+	assert.Equal(t, Rock, garden.GetTile(utilities.NewPoint2D(5-11, 1+22), true))
 }
 
-func TestNewLeafNode(t *testing.T) {
+func TestReachablePlots(t *testing.T) {
+	garden, err := ParseGarden(exampleGarden)
+	assert.NoError(t, err)
+
 	type testCase struct {
-		name         string
-		value        int
-		expectedNode TreeNode
+		steps         int
+		infinite      bool
+		expectedPlots int
 	}
+
 	testCases := []testCase{
-		{"root", 100, TreeNode{Name: "root", Value: 100, Leaf: true}},
-		{"argb", 100, TreeNode{Name: "argb", Value: 100, Leaf: true}},
+		{steps: 1, expectedPlots: 2},
+		{steps: 2, expectedPlots: 4},
+		{steps: 3, expectedPlots: 6},
+		{steps: 6, expectedPlots: 16},
+		{steps: 6, infinite: true, expectedPlots: 16},
+		{steps: 10, infinite: true, expectedPlots: 50},
+		{steps: 50, infinite: true, expectedPlots: 1594},
+		{steps: 100, infinite: true, expectedPlots: 6536},
+		{steps: 500, infinite: true, expectedPlots: 167004},
 	}
 
 	for _, test := range testCases {
-		assert.Equal(t, test.expectedNode, *NewLeafNode(test.name, test.value))
+		assert.Equal(t, test.expectedPlots, garden.ReachablePlots(test.steps, test.infinite))
 	}
 }
 
-func TestNewParentNode(t *testing.T) {
+func TestInfiniteReachablePlots(t *testing.T) {
 	type testCase struct {
-		name          string
-		value         int
-		expectedName  string
-		expectedValue int
+		garden        string
+		steps         int
+		expectedPlots int
 	}
+
 	testCases := []testCase{
-		{"root", 100, "root", 0},
-		{"argb", 100, "argb", 0},
+		{garden: exampleGarden, steps: 6, expectedPlots: 16},
+		{garden: exampleGarden, steps: 500, expectedPlots: 167004},
+		{garden: exampleGarden, steps: 1000, expectedPlots: 668697},
+		{garden: exampleGarden, steps: 5000, expectedPlots: 16733044},
 	}
 
 	for _, test := range testCases {
-		n := NewParentNode(test.name, '+')
-		assert.Equal(t, test.expectedName, n.Name)
-		assert.Equal(t, test.expectedValue, n.Value)
+		garden, err := ParseGarden(test.garden)
+		assert.NoError(t, err)
+
+		plots, err := garden.InfiniteReachablePlots(test.steps)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedPlots, plots)
 	}
 }
 
-func TestNodeSolve(t *testing.T) {
-	type testCase struct {
-		knownValue           int
-		knownResult          int
-		leftSolve            bool
-		op                   byte
-		expectedUnknownValue int
-	}
+func TestInfiniteReachablePlotsMatchesBruteForce(t *testing.T) {
+	for _, text := range []string{exampleGarden, openGarden} {
+		garden, err := ParseGarden(text)
+		assert.NoError(t, err)
 
-	testCases := []testCase{
-		// x + a = b
-		{5, 100, true, '+', 95},
-		// x - a = b
-		{5, 100, true, '-', 105},
-		// x / a = b
-		{5, 100, true, '/', 500},
-		// x * a = b
-		{5, 100, true, '*', 20},
-		// a + x = b
-		{5, 100, false, '+', 95},
-		// a - x = b
-		{500, 100, false, '-', 400},
-		// a / x = b
-		{20, 5, false, '/', 4},
-		// a * x = b
-		{5, 100, false, '*', 20},
-	}
-	for _, test := range testCases {
-		n := NewParentNode("test", test.op)
-		if test.leftSolve {
-			n.LeftPoisoned = true
-		} else {
-			n.RightPoisoned = true
+		for _, steps := range []int{5, 16, 27, 65, 131, 200, 327, 401} {
+			plots, err := garden.InfiniteReachablePlots(steps)
+			assert.NoError(t, err)
+			assert.Equal(t, garden.ReachablePlots(steps, true), plots, "%d steps", steps)
 		}
-		assert.Equal(t, test.expectedUnknownValue, NodeSolve(n, test.knownValue, test.knownResult))
 	}
 }
+
+func TestInfiniteReachablePlotsNotSquare(t *testing.T) {
+	garden, err := ParseGarden(strings.Join([]string{"....", ".S..", "...."}, "\n"))
+	assert.NoError(t, err)
+
+	_, err = garden.InfiniteReachablePlots(100)
+	assert.Error(t, err)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return solve(input, 6, 5000) })
+}
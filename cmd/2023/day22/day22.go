@@ -0,0 +1,242 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyThree_day22
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day22Cmd represents the day22 command
+var Day22Cmd = &cobra.Command{
+	Use:   "day22",
+	Short: `Sand Slabs`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type Point3D struct {
+	X, Y, Z int
+}
+
+type Brick struct {
+	Start Point3D
+	End   Point3D
+}
+
+func (b Brick) Describe() string {
+	return fmt.Sprintf("%d,%d,%d~%d,%d,%d", b.Start.X, b.Start.Y, b.Start.Z, b.End.X, b.End.Y, b.End.Z)
+}
+
+// Footprint returns every x,y column the brick occupies.
+func (b Brick) Footprint() []utilities.Point2D {
+	footprint := make([]utilities.Point2D, 0)
+
+	for x := b.Start.X; x <= b.End.X; x++ {
+		for y := b.Start.Y; y <= b.End.Y; y++ {
+			footprint = append(footprint, utilities.NewPoint2D(x, y))
+		}
+	}
+
+	return footprint
+}
+
+func ParseBrick(line string) (Brick, error) {
+	numbers := utilities.ParseIntList(line)
+	if len(numbers) != 6 || !strings.Contains(line, "~") {
+		return Brick{}, fmt.Errorf("invalid brick '%s'", line)
+	}
+
+	brick := Brick{
+		Start: Point3D{X: min(numbers[0], numbers[3]), Y: min(numbers[1], numbers[4]), Z: min(numbers[2], numbers[5])},
+		End:   Point3D{X: max(numbers[0], numbers[3]), Y: max(numbers[1], numbers[4]), Z: max(numbers[2], numbers[5])},
+	}
+
+	if brick.Start.Z < 1 {
+		return Brick{}, fmt.Errorf("brick '%s' is below the ground", line)
+	}
+
+	return brick, nil
+}
+
+func ParseBricks(fileContents string) ([]Brick, error) {
+	bricks := make([]Brick, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		brick, err := ParseBrick(strings.TrimSpace(line))
+		if err != nil {
+			return nil, err
+		}
+
+		bricks = append(bricks, brick)
+	}
+
+	return bricks, nil
+}
+
+// Stack is a pile of bricks that have finished falling, along with which bricks
+// rest directly on which.
+type Stack struct {
+	Bricks      []Brick
+	Supports    [][]int
+	SupportedBy [][]int
+}
+
+// Settle drops every brick as far as it will go. Bricks are dropped lowest first
+// so each one only has to look at the highest settled brick under each of its
+// columns.
+func Settle(snapshot []Brick) *Stack {
+	bricks := slices.Clone(snapshot)
+	slices.SortStableFunc(bricks, func(a, b Brick) int {
+		return a.Start.Z - b.Start.Z
+	})
+
+	stack := &Stack{
+		Bricks:      bricks,
+		Supports:    make([][]int, len(bricks)),
+		SupportedBy: make([][]int, len(bricks)),
+	}
+
+	type column struct {
+		Height int
+		Brick  int
+	}
+
+	columns := make(map[utilities.Point2D]column)
+
+	for i := range stack.Bricks {
+		b := &stack.Bricks[i]
+		footprint := b.Footprint()
+
+		restingHeight := 0
+		for _, p := range footprint {
+			if c, ok := columns[p]; ok && c.Height > restingHeight {
+				restingHeight = c.Height
+			}
+		}
+
+		fall := b.Start.Z - (restingHeight + 1)
+		b.Start.Z -= fall
+		b.End.Z -= fall
+
+		for _, p := range footprint {
+			c, ok := columns[p]
+			if ok && c.Height == restingHeight && restingHeight > 0 && !slices.Contains(stack.SupportedBy[i], c.Brick) {
+				stack.SupportedBy[i] = append(stack.SupportedBy[i], c.Brick)
+				stack.Supports[c.Brick] = append(stack.Supports[c.Brick], i)
+			}
+
+			columns[p] = column{Height: b.End.Z, Brick: i}
+		}
+	}
+
+	return stack
+}
+
+// CanDisintegrate reports whether every brick resting on this one has another
+// brick holding it up.
+func (s *Stack) CanDisintegrate(brick int) bool {
+	for _, above := range s.Supports[brick] {
+		if len(s.SupportedBy[above]) == 1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Stack) SafeToDisintegrateCount() int {
+	count := 0
+
+	for i := range s.Bricks {
+		if s.CanDisintegrate(i) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ChainReaction returns how many other bricks fall if the brick is disintegrated.
+// A brick falls once every brick supporting it has fallen.
+func (s *Stack) ChainReaction(brick int) int {
+	fallen := make([]bool, len(s.Bricks))
+	fallen[brick] = true
+
+	removedSupports := make([]int, len(s.Bricks))
+
+	pending := utilities.NewFIFO[int]()
+	pending.Push(brick)
+
+	count := 0
+
+	for !pending.IsEmpty() {
+		b := pending.Pop()
+
+		for _, above := range s.Supports[b] {
+			removedSupports[above]++
+
+			if !fallen[above] && removedSupports[above] == len(s.SupportedBy[above]) {
+				fallen[above] = true
+				count++
+				pending.Push(above)
+			}
+		}
+	}
+
+	return count
+}
+
+func (s *Stack) TotalChainReaction() int {
+	total := 0
+
+	for i := range s.Bricks {
+		total += s.ChainReaction(i)
+	}
+
+	return total
+}
+
+func day(fileContents string) error {
+	bricks, err := ParseBricks(fileContents)
+	if err != nil {
+		return err
+	}
+
+	stack := Settle(bricks)
+
+	// Part 1: Figure how the blocks will settle based on the snapshot. Once they've
+	// settled, consider disintegrating a single brick; how many bricks could be safely
+	// chosen as the one to get disintegrated?
+	utilities.Answer(1, "Bricks safe to disintegrate", stack.SafeToDisintegrateCount())
+
+	// Part 2: For each brick, determine how many other bricks would fall if that brick
+	// were disintegrated. What is the sum of the number of other bricks that would fall?
+	utilities.Answer(2, "Sum of bricks that would fall", stack.TotalChainReaction())
+
+	return nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyThree_day22
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleSnapshot = `1,0,1~1,2,1
+0,0,2~2,0,2
+0,2,3~2,2,3
+0,0,4~0,2,4
+2,0,5~2,2,5
+0,1,6~2,1,6
+1,1,8~1,1,9`
+
+func TestParseBrick(t *testing.T) {
+	type testCase struct {
+		line          string
+		expectedBrick Brick
+	}
+
+	testCases := []testCase{
+		{line: "1,0,1~1,2,1", expectedBrick: Brick{Start: Point3D{1, 0, 1}, End: Point3D{1, 2, 1}}},
+		{line: "1,1,9~1,1,8", expectedBrick: Brick{Start: Point3D{1, 1, 8}, End: Point3D{1, 1, 9}}},
+	}
+
+	for _, test := range testCases {
+		brick, err := ParseBrick(test.line)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedBrick, brick)
+	}
+
+	_, err := ParseBrick("1,0,1,1,2,1")
+	assert.Error(t, err)
+
+	_, err = ParseBrick("1,0,0~1,2,0")
+	assert.Error(t, err)
+}
+
+func TestSettle(t *testing.T) {
+	bricks, err := ParseBricks(exampleSnapshot)
+	assert.NoError(t, err)
+
+	stack := Settle(bricks)
+
+	descriptions := make([]string, 0)
+	for _, b := range stack.Bricks {
+		descriptions = append(descriptions, b.Describe())
+	}
+
+	assert.Equal(t, []string{
+		"1,0,1~1,2,1",
+		"0,0,2~2,0,2",
+		"0,2,2~2,2,2",
+		"0,0,3~0,2,3",
+		"2,0,3~2,2,3",
+		"0,1,4~2,1,4",
+		"1,1,5~1,1,6",
+	}, descriptions)
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {3, 4}, {5}, {5}, {6}, nil}, stack.Supports)
+	assert.Equal(t, [][]int{nil, {0}, {0}, {1, 2}, {1, 2}, {3, 4}, {5}}, stack.SupportedBy)
+}
+
+func TestSafeToDisintegrateCount(t *testing.T) {
+	bricks, err := ParseBricks(exampleSnapshot)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 5, Settle(bricks).SafeToDisintegrateCount())
+}
+
+func TestChainReaction(t *testing.T) {
+	bricks, err := ParseBricks(exampleSnapshot)
+	assert.NoError(t, err)
+
+	stack := Settle(bricks)
+
+	assert.Equal(t, 6, stack.ChainReaction(0))
+	assert.Equal(t, 0, stack.ChainReaction(1))
+	assert.Equal(t, 1, stack.ChainReaction(5))
+	assert.Equal(t, 7, stack.TotalChainReaction())
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -0,0 +1,335 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyThree_day23
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day23Cmd represents the day23 command
+var Day23Cmd = &cobra.Command{
+	Use:   "day23",
+	Short: `A Long Walk`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+// MaximumJunctions is the most junctions the longest hike search can track, one
+// bit each in the visited mask.
+const MaximumJunctions = 64
+
+type Tile byte
+
+const (
+	Path Tile = iota
+	Forest
+	SlopeNorth
+	SlopeEast
+	SlopeSouth
+	SlopeWest
+)
+
+func (t Tile) Describe() string {
+	switch t {
+	case Path:
+		return "."
+	case Forest:
+		return "#"
+	case SlopeNorth:
+		return "^"
+	case SlopeEast:
+		return ">"
+	case SlopeSouth:
+		return "v"
+	case SlopeWest:
+		return "<"
+	}
+
+	log.Panicf("unknown tile: %d\n", t)
+	return "X"
+}
+
+type Row []Tile
+
+type Trails struct {
+	Bounds utilities.Size2D
+	Rows   []Row
+	Start  utilities.Point2D
+	End    utilities.Point2D
+}
+
+func ParseTrails(fileContents string) (*Trails, error) {
+	trails := &Trails{}
+
+	for y, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		line = strings.TrimSpace(line)
+		row := make(Row, 0, len(line))
+
+		for x, c := range line {
+			switch c {
+			case '.':
+				row = append(row, Path)
+			case '#':
+				row = append(row, Forest)
+			case '^':
+				row = append(row, SlopeNorth)
+			case '>':
+				row = append(row, SlopeEast)
+			case 'v':
+				row = append(row, SlopeSouth)
+			case '<':
+				row = append(row, SlopeWest)
+			default:
+				return nil, fmt.Errorf("unknown tile '%c' at %d,%d", c, x, y)
+			}
+		}
+
+		if y > 0 && len(row) != trails.Bounds.Width {
+			return nil, fmt.Errorf("row %d has width %d, expected %d", y, len(row), trails.Bounds.Width)
+		}
+
+		trails.Rows = append(trails.Rows, row)
+		trails.Bounds.Width = len(row)
+		trails.Bounds.Height++
+	}
+
+	findPath := func(y int) (utilities.Point2D, error) {
+		for x, t := range trails.Rows[y] {
+			if t == Path {
+				return utilities.NewPoint2D(x, y), nil
+			}
+		}
+
+		return utilities.Point2D{}, fmt.Errorf("no path in row %d", y)
+	}
+
+	var err error
+
+	if trails.Start, err = findPath(0); err != nil {
+		return nil, err
+	}
+
+	if trails.End, err = findPath(trails.Bounds.Height - 1); err != nil {
+		return nil, err
+	}
+
+	return trails, nil
+}
+
+func (t *Trails) GetTile(p utilities.Point2D) Tile {
+	if p.X < 0 || p.X >= t.Bounds.Width || p.Y < 0 || p.Y >= t.Bounds.Height {
+		return Forest
+	}
+
+	return t.Rows[p.Y][p.X]
+}
+
+type step struct {
+	Position utilities.Point2D
+	Slope    Tile
+}
+
+// Steps returns the neighbors that can be walked to from p. When slippery, steps
+// onto or off of a slope are only allowed in the direction the slope points.
+func (t *Trails) Steps(p utilities.Point2D, slippery bool) []utilities.Point2D {
+	steps := make([]utilities.Point2D, 0, 4)
+	current := t.GetTile(p)
+
+	for _, s := range []step{{p.Up(), SlopeNorth}, {p.Right(), SlopeEast}, {p.Down(), SlopeSouth}, {p.Left(), SlopeWest}} {
+		tile := t.GetTile(s.Position)
+
+		if tile == Forest {
+			continue
+		}
+
+		if slippery && ((tile != Path && tile != s.Slope) || (current != Path && current != s.Slope)) {
+			continue
+		}
+
+		steps = append(steps, s.Position)
+	}
+
+	return steps
+}
+
+// isJunction reports whether more than two paths meet at p, ignoring slopes.
+func (t *Trails) isJunction(p utilities.Point2D) bool {
+	return len(t.Steps(p, false)) > 2
+}
+
+type Edge struct {
+	To     int
+	Length int
+}
+
+// Graph is the trail map contracted down to its junctions, with the start and end
+// of the hike as extra nodes and the corridors between them as weighted edges.
+type Graph struct {
+	Junctions []utilities.Point2D
+	Edges     [][]Edge
+	Start     int
+	End       int
+}
+
+func (t *Trails) ContractedGraph(slippery bool) (*Graph, error) {
+	graph := &Graph{}
+	index := make(map[utilities.Point2D]int)
+
+	addJunction := func(p utilities.Point2D) int {
+		if i, ok := index[p]; ok {
+			return i
+		}
+
+		index[p] = len(graph.Junctions)
+		graph.Junctions = append(graph.Junctions, p)
+		graph.Edges = append(graph.Edges, nil)
+
+		return index[p]
+	}
+
+	graph.Start = addJunction(t.Start)
+	graph.End = addJunction(t.End)
+
+	for y := 0; y < t.Bounds.Height; y++ {
+		for x := 0; x < t.Bounds.Width; x++ {
+			p := utilities.NewPoint2D(x, y)
+			if t.GetTile(p) != Forest && t.isJunction(p) {
+				addJunction(p)
+			}
+		}
+	}
+
+	if len(graph.Junctions) > MaximumJunctions {
+		return nil, fmt.Errorf("%d junctions is more than the %d supported", len(graph.Junctions), MaximumJunctions)
+	}
+
+	// Follow each corridor leaving a junction until it reaches the next junction.
+	for from, junction := range graph.Junctions {
+		for _, first := range t.Steps(junction, slippery) {
+			previous := junction
+			current := first
+			length := 1
+			deadEnd := false
+
+			for {
+				if _, ok := index[current]; ok {
+					break
+				}
+
+				next := make([]utilities.Point2D, 0, 1)
+				for _, s := range t.Steps(current, slippery) {
+					if s != previous {
+						next = append(next, s)
+					}
+				}
+
+				if len(next) == 0 {
+					deadEnd = true
+					break
+				}
+
+				previous, current = current, next[0]
+				length++
+			}
+
+			if !deadEnd {
+				graph.Edges[from] = append(graph.Edges[from], Edge{To: index[current], Length: length})
+			}
+		}
+	}
+
+	return graph, nil
+}
+
+// LongestHike finds the longest path from start to end that never visits a
+// junction twice, or -1 when the end can't be reached.
+func (g *Graph) LongestHike() int {
+	var search func(node int, visited uint64) int
+
+	search = func(node int, visited uint64) int {
+		if node == g.End {
+			return 0
+		}
+
+		longest := -1
+
+		for _, e := range g.Edges[node] {
+			if visited&(1<<e.To) != 0 {
+				continue
+			}
+
+			if length := search(e.To, visited|(1<<e.To)); length >= 0 && length+e.Length > longest {
+				longest = length + e.Length
+			}
+		}
+
+		return longest
+	}
+
+	return search(g.Start, 1<<g.Start)
+}
+
+func (t *Trails) LongestHike(slippery bool) (int, error) {
+	graph, err := t.ContractedGraph(slippery)
+	if err != nil {
+		return 0, err
+	}
+
+	longest := graph.LongestHike()
+	if longest < 0 {
+		return 0, fmt.Errorf("no hike from %d,%d to %d,%d", t.Start.X, t.Start.Y, t.End.X, t.End.Y)
+	}
+
+	return longest, nil
+}
+
+func day(fileContents string) error {
+	trails, err := ParseTrails(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Find the longest hike you can take through the hiking trails listed
+	// on your map. How many steps long is the longest hike?
+	longest, err := trails.LongestHike(true)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(1, "Longest hike", longest)
+
+	// Part 2: Find the longest hike you can take through the surprisingly dry hiking
+	// trails listed on your map. How many steps long is the longest hike?
+	longest, err = trails.LongestHike(false)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(2, "Longest hike without slippery slopes", longest)
+
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyThree_day23
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleTrails = `#.#####################
+#.......#########...###
+#######.#########.#.###
+###.....#.>.>.###.#.###
+###v#####.#v#.###.#.###
+###.>...#.#.#.....#...#
+###v###.#.#.#########.#
+###...#.#.#.......#...#
+#####.#.#.#######.#.###
+#.....#.#.#.......#...#
+#.#####.#.#.#########v#
+#.#...#...#...###...>.#
+#.#.#v#######v###.###v#
+#...#.>.#...>.>.#.###.#
+#####v#.#.###v#.#.###.#
+#.....#...#...#.#.#...#
+#.#########.###.#.#.###
+#...###...#...#...#.###
+###.###.#.###v#####v###
+#...#...#.#.>.>.#.>.###
+#.###.###.#.###.#.#v###
+#.....###...###...#...#
+#####################.#`
+
+func TestParseTrails(t *testing.T) {
+	trails, err := ParseTrails(exampleTrails)
+	assert.NoError(t, err)
+
+	assert.Equal(t, utilities.NewSize2D(23, 23), trails.Bounds)
+	assert.Equal(t, utilities.NewPoint2D(1, 0), trails.Start)
+	assert.Equal(t, utilities.NewPoint2D(21, 22), trails.End)
+	assert.Equal(t, SlopeEast, trails.GetTile(utilities.NewPoint2D(10, 3)))
+	assert.Equal(t, Forest, trails.GetTile(utilities.NewPoint2D(-1, 3)))
+
+	_, err = ParseTrails("###\n#.#\n###")
+	assert.Error(t, err)
+
+	_, err = ParseTrails("#.#\n#x#\n#.#")
+	assert.Error(t, err)
+}
+
+func TestSteps(t *testing.T) {
+	trails, err := ParseTrails(exampleTrails)
+	assert.NoError(t, err)
+
+	// Junction at 11,3 is surrounded by slopes.
+	junction := utilities.NewPoint2D(11, 3)
+	assert.ElementsMatch(t, []utilities.Point2D{junction.Right(), junction.Down()}, trails.Steps(junction, true))
+	assert.ElementsMatch(t, []utilities.Point2D{junction.Left(), junction.Right(), junction.Down()}, trails.Steps(junction, false))
+
+	// Standing on a slope only allows going downhill.
+	slope := utilities.NewPoint2D(10, 3)
+	assert.Equal(t, []utilities.Point2D{slope.Right()}, trails.Steps(slope, true))
+}
+
+func TestContractedGraph(t *testing.T) {
+	trails, err := ParseTrails(exampleTrails)
+	assert.NoError(t, err)
+
+	graph, err := trails.ContractedGraph(false)
+	assert.NoError(t, err)
+
+	assert.Len(t, graph.Junctions, 9)
+	assert.Len(t, graph.Edges[graph.Start], 1)
+	assert.Equal(t, utilities.NewPoint2D(3, 5), graph.Junctions[graph.Edges[graph.Start][0].To])
+	assert.Equal(t, 15, graph.Edges[graph.Start][0].Length)
+}
+
+func TestLongestHike(t *testing.T) {
+	trails, err := ParseTrails(exampleTrails)
+	assert.NoError(t, err)
+
+	longest, err := trails.LongestHike(true)
+	assert.NoError(t, err)
+	assert.Equal(t, 94, longest)
+
+	longest, err = trails.LongestHike(false)
+	assert.NoError(t, err)
+	assert.Equal(t, 154, longest)
+}
+
+func TestLongestHikeUnreachable(t *testing.T) {
+	trails, err := ParseTrails(`#.###
+#.<.#
+###.#`)
+	assert.NoError(t, err)
+
+	_, err = trails.LongestHike(true)
+	assert.Error(t, err)
+
+	longest, err := trails.LongestHike(false)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, longest)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
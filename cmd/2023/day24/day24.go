@@ -0,0 +1,279 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyThree_day24
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day24Cmd represents the day24 command
+var Day24Cmd = &cobra.Command{
+	Use:   "day24",
+	Short: `Never Tell Me The Odds`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	TestAreaMinimum = 200000000000000
+	TestAreaMaximum = 400000000000000
+)
+
+type Vector3D struct {
+	X, Y, Z int64
+}
+
+func (v Vector3D) Sub(o Vector3D) Vector3D {
+	return Vector3D{X: v.X - o.X, Y: v.Y - o.Y, Z: v.Z - o.Z}
+}
+
+type Hailstone struct {
+	Position Vector3D
+	Velocity Vector3D
+}
+
+func (h Hailstone) Describe() string {
+	return fmt.Sprintf("%d, %d, %d @ %d, %d, %d", h.Position.X, h.Position.Y, h.Position.Z, h.Velocity.X, h.Velocity.Y, h.Velocity.Z)
+}
+
+func ParseHailstone(line string) (Hailstone, error) {
+	numbers := utilities.ParseIntList(line)
+	if len(numbers) != 6 || !strings.Contains(line, "@") {
+		return Hailstone{}, fmt.Errorf("invalid hailstone '%s'", line)
+	}
+
+	return Hailstone{
+		Position: Vector3D{X: int64(numbers[0]), Y: int64(numbers[1]), Z: int64(numbers[2])},
+		Velocity: Vector3D{X: int64(numbers[3]), Y: int64(numbers[4]), Z: int64(numbers[5])},
+	}, nil
+}
+
+func ParseHailstones(fileContents string) ([]Hailstone, error) {
+	hailstones := make([]Hailstone, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		h, err := ParseHailstone(line)
+		if err != nil {
+			return nil, err
+		}
+
+		hailstones = append(hailstones, h)
+	}
+
+	return hailstones, nil
+}
+
+func ratInt(i int64) *big.Rat {
+	return new(big.Rat).SetInt64(i)
+}
+
+// IntersectXY finds where the paths of two hailstones cross, ignoring the Z axis.
+// Both hailstones must reach the crossing in the future. The crossing point is
+// exact, since the coordinates are far too large to trust to a float64.
+func IntersectXY(a, b Hailstone) (x, y *big.Rat, ok bool) {
+	// Solve a.P + t*a.V = b.P + s*b.V with Cramer's rule.
+	determinant := a.Velocity.X*b.Velocity.Y - a.Velocity.Y*b.Velocity.X
+	if determinant == 0 {
+		return nil, nil, false
+	}
+
+	dx := ratInt(b.Position.X - a.Position.X)
+	dy := ratInt(b.Position.Y - a.Position.Y)
+	det := ratInt(determinant)
+
+	// t = (dx*b.Vy - dy*b.Vx) / det
+	t := new(big.Rat).Sub(new(big.Rat).Mul(dx, ratInt(b.Velocity.Y)), new(big.Rat).Mul(dy, ratInt(b.Velocity.X)))
+	t.Quo(t, det)
+
+	// s = (dx*a.Vy - dy*a.Vx) / det
+	s := new(big.Rat).Sub(new(big.Rat).Mul(dx, ratInt(a.Velocity.Y)), new(big.Rat).Mul(dy, ratInt(a.Velocity.X)))
+	s.Quo(s, det)
+
+	if t.Sign() < 0 || s.Sign() < 0 {
+		return nil, nil, false
+	}
+
+	x = new(big.Rat).Add(ratInt(a.Position.X), new(big.Rat).Mul(t, ratInt(a.Velocity.X)))
+	y = new(big.Rat).Add(ratInt(a.Position.Y), new(big.Rat).Mul(t, ratInt(a.Velocity.Y)))
+
+	return x, y, true
+}
+
+func IntersectionsInTestArea(hailstones []Hailstone, minimum, maximum int64) int {
+	low := ratInt(minimum)
+	high := ratInt(maximum)
+
+	inside := func(v *big.Rat) bool {
+		return v.Cmp(low) >= 0 && v.Cmp(high) <= 0
+	}
+
+	count := 0
+
+	for i := 0; i < len(hailstones); i++ {
+		for j := i + 1; j < len(hailstones); j++ {
+			if x, y, ok := IntersectXY(hailstones[i], hailstones[j]); ok && inside(x) && inside(y) {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+// crossRows returns the three linear equations in the unknown rock position P and
+// velocity V that come from requiring the rock to hit both hailstones. The rock hits
+// hailstone i when (P - p_i) x (V - v_i) = 0. Subtracting that expansion for two
+// hailstones cancels the non-linear P x V term, leaving
+//
+//	P x (v_i - v_j) + (p_i - p_j) x V = p_i x v_i - p_j x v_j
+func crossRows(a, b Hailstone) [][]*big.Rat {
+	dv := a.Velocity.Sub(b.Velocity)
+	dp := a.Position.Sub(b.Position)
+
+	cross := func(u, v Vector3D) [3]*big.Int {
+		mul := func(x, y int64) *big.Int {
+			return new(big.Int).Mul(big.NewInt(x), big.NewInt(y))
+		}
+
+		return [3]*big.Int{
+			new(big.Int).Sub(mul(u.Y, v.Z), mul(u.Z, v.Y)),
+			new(big.Int).Sub(mul(u.Z, v.X), mul(u.X, v.Z)),
+			new(big.Int).Sub(mul(u.X, v.Y), mul(u.Y, v.X)),
+		}
+	}
+
+	ca := cross(a.Position, a.Velocity)
+	cb := cross(b.Position, b.Velocity)
+
+	rhs := [3]*big.Rat{}
+	for i := range rhs {
+		rhs[i] = new(big.Rat).SetInt(new(big.Int).Sub(ca[i], cb[i]))
+	}
+
+	// Unknowns are Px, Py, Pz, Vx, Vy, Vz followed by the right hand side.
+	return [][]*big.Rat{
+		{ratInt(0), ratInt(dv.Z), ratInt(-dv.Y), ratInt(0), ratInt(-dp.Z), ratInt(dp.Y), rhs[0]},
+		{ratInt(-dv.Z), ratInt(0), ratInt(dv.X), ratInt(dp.Z), ratInt(0), ratInt(-dp.X), rhs[1]},
+		{ratInt(dv.Y), ratInt(-dv.X), ratInt(0), ratInt(-dp.Y), ratInt(dp.X), ratInt(0), rhs[2]},
+	}
+}
+
+// SolveLinearSystem solves an augmented matrix with Gauss-Jordan elimination over
+// the rationals.
+func SolveLinearSystem(matrix [][]*big.Rat) ([]*big.Rat, error) {
+	n := len(matrix)
+
+	for column := 0; column < n; column++ {
+		pivot := -1
+		for row := column; row < n; row++ {
+			if matrix[row][column].Sign() != 0 {
+				pivot = row
+				break
+			}
+		}
+
+		if pivot < 0 {
+			return nil, fmt.Errorf("singular system")
+		}
+
+		matrix[column], matrix[pivot] = matrix[pivot], matrix[column]
+
+		for row := 0; row < n; row++ {
+			if row == column || matrix[row][column].Sign() == 0 {
+				continue
+			}
+
+			factor := new(big.Rat).Quo(matrix[row][column], matrix[column][column])
+
+			for k := column; k <= n; k++ {
+				matrix[row][k] = new(big.Rat).Sub(matrix[row][k], new(big.Rat).Mul(factor, matrix[column][k]))
+			}
+		}
+	}
+
+	solution := make([]*big.Rat, n)
+	for i := range solution {
+		solution[i] = new(big.Rat).Quo(matrix[i][n], matrix[i][i])
+	}
+
+	return solution, nil
+}
+
+// ThrowRock finds the position and velocity to throw a rock from so that it hits
+// every hailstone. Three hailstones are enough to pin the rock down, so the first
+// three that give an independent system are used.
+func ThrowRock(hailstones []Hailstone) (Hailstone, error) {
+	for k := 2; k < len(hailstones); k++ {
+		matrix := append(crossRows(hailstones[0], hailstones[1]), crossRows(hailstones[0], hailstones[k])...)
+
+		solution, err := SolveLinearSystem(matrix)
+		if err != nil {
+			continue
+		}
+
+		values := make([]int64, len(solution))
+		for i, s := range solution {
+			if !s.IsInt() || !s.Num().IsInt64() {
+				return Hailstone{}, fmt.Errorf("rock %s is not at integer coordinates", s.RatString())
+			}
+
+			values[i] = s.Num().Int64()
+		}
+
+		return Hailstone{
+			Position: Vector3D{X: values[0], Y: values[1], Z: values[2]},
+			Velocity: Vector3D{X: values[3], Y: values[4], Z: values[5]},
+		}, nil
+	}
+
+	return Hailstone{}, fmt.Errorf("no independent set of hailstones")
+}
+
+func day(fileContents string) error {
+	hailstones, err := ParseHailstones(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Considering only the X and Y axes, check all pairs of hailstones'
+	// future paths for intersections. How many of these intersections occur within
+	// the test area?
+	utilities.Answer(1, "Intersections within the test area", IntersectionsInTestArea(hailstones, TestAreaMinimum, TestAreaMaximum))
+
+	// Part 2: Determine the exact position and velocity the rock needs to have at
+	// time 0 so that it perfectly collides with every hailstone. What do you get if
+	// you add up the X, Y, and Z coordinates of that initial position?
+	rock, err := ThrowRock(hailstones)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(2, "Sum of rock starting coordinates", rock.Position.X+rock.Position.Y+rock.Position.Z)
+
+	return nil
+}
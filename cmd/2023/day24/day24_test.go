@@ -0,0 +1,97 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyThree_day24
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleHailstones = `19, 13, 30 @ -2,  1, -2
+18, 19, 22 @ -1, -1, -2
+20, 25, 34 @ -2, -2, -4
+12, 31, 28 @ -1, -2, -1
+20, 19, 15 @  1, -5, -3`
+
+func TestParseHailstone(t *testing.T) {
+	h, err := ParseHailstone("19, 13, 30 @ -2,  1, -2")
+	assert.NoError(t, err)
+	assert.Equal(t, Hailstone{Position: Vector3D{19, 13, 30}, Velocity: Vector3D{-2, 1, -2}}, h)
+	assert.Equal(t, "19, 13, 30 @ -2, 1, -2", h.Describe())
+
+	_, err = ParseHailstone("19, 13, 30, -2, 1, -2")
+	assert.Error(t, err)
+}
+
+func TestIntersectXY(t *testing.T) {
+	hailstones, err := ParseHailstones(exampleHailstones)
+	assert.NoError(t, err)
+
+	type testCase struct {
+		a, b       int
+		expectedOK bool
+		expectedX  string
+		expectedY  string
+	}
+
+	testCases := []testCase{
+		{a: 0, b: 1, expectedOK: true, expectedX: "43/3", expectedY: "46/3"},
+		{a: 0, b: 2, expectedOK: true, expectedX: "35/3", expectedY: "50/3"},
+		{a: 0, b: 3, expectedOK: true, expectedX: "31/5", expectedY: "97/5"},
+		{a: 0, b: 4, expectedOK: false},
+		{a: 1, b: 2, expectedOK: false},
+		{a: 1, b: 3, expectedOK: true, expectedX: "-6", expectedY: "-5"},
+		{a: 3, b: 4, expectedOK: false},
+	}
+
+	for _, test := range testCases {
+		x, y, ok := IntersectXY(hailstones[test.a], hailstones[test.b])
+		assert.Equal(t, test.expectedOK, ok)
+
+		if ok {
+			assert.Equal(t, test.expectedX, x.RatString())
+			assert.Equal(t, test.expectedY, y.RatString())
+		}
+	}
+}
+
+func TestIntersectionsInTestArea(t *testing.T) {
+	hailstones, err := ParseHailstones(exampleHailstones)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, IntersectionsInTestArea(hailstones, 7, 27))
+}
+
+func TestSolveLinearSystem(t *testing.T) {
+	r := func(i int64) *big.Rat {
+		return big.NewRat(i, 1)
+	}
+
+	// x + y = 3, x - y = 1
+	solution, err := SolveLinearSystem([][]*big.Rat{{r(1), r(1), r(3)}, {r(1), r(-1), r(1)}})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", solution[0].RatString())
+	assert.Equal(t, "1", solution[1].RatString())
+
+	_, err = SolveLinearSystem([][]*big.Rat{{r(1), r(1), r(3)}, {r(2), r(2), r(6)}})
+	assert.Error(t, err)
+}
+
+func TestThrowRock(t *testing.T) {
+	hailstones, err := ParseHailstones(exampleHailstones)
+	assert.NoError(t, err)
+
+	rock, err := ThrowRock(hailstones)
+	assert.NoError(t, err)
+	assert.Equal(t, Hailstone{Position: Vector3D{24, 13, 10}, Velocity: Vector3D{-3, 1, 2}}, rock)
+	assert.Equal(t, int64(47), rock.Position.X+rock.Position.Y+rock.Position.Z)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
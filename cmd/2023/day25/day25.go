@@ -0,0 +1,225 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyThree_day25
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day25Cmd represents the day25 command
+var Day25Cmd = &cobra.Command{
+	Use:   "day25",
+	Short: `Snowverload`,
+	Run: func(cmd *cobra.Command, args []string) {
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer df.Close()
+
+		fileContent, err := io.ReadAll(df)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = day(string(fileContent))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type Graph struct {
+	Names     []string
+	Index     map[string]int
+	Adjacency []map[int]int
+}
+
+func NewGraph() *Graph {
+	return &Graph{Index: make(map[string]int)}
+}
+
+func (g *Graph) AddComponent(name string) int {
+	if i, ok := g.Index[name]; ok {
+		return i
+	}
+
+	g.Index[name] = len(g.Names)
+	g.Names = append(g.Names, name)
+	g.Adjacency = append(g.Adjacency, make(map[int]int))
+
+	return g.Index[name]
+}
+
+func (g *Graph) AddWire(a, b string) {
+	i := g.AddComponent(a)
+	j := g.AddComponent(b)
+
+	g.Adjacency[i][j]++
+	g.Adjacency[j][i]++
+}
+
+func ParseComponents(fileContents string) (*Graph, error) {
+	graph := NewGraph()
+
+	lineRE := regexp.MustCompile(`^([a-z]+): ([a-z ]+)$`)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		matches := lineRE.FindStringSubmatch(strings.TrimSpace(line))
+		if matches == nil {
+			return nil, fmt.Errorf("invalid component '%s'", line)
+		}
+
+		for _, other := range strings.Fields(matches[2]) {
+			graph.AddWire(matches[1], other)
+		}
+	}
+
+	return graph, nil
+}
+
+// MinimumCut uses the Stoer-Wagner algorithm to find the fewest wires that split
+// the components into two groups. It returns the number of wires cut and the
+// components on one side of the cut.
+func (g *Graph) MinimumCut() (int, []string, error) {
+	n := len(g.Names)
+	if n < 2 {
+		return 0, nil, fmt.Errorf("need at least two components to cut")
+	}
+
+	// Each vertex of the shrinking graph is a group of merged components.
+	members := make([][]int, n)
+	weights := make([]map[int]int, n)
+	active := make([]bool, n)
+
+	for i := 0; i < n; i++ {
+		members[i] = []int{i}
+		weights[i] = make(map[int]int, len(g.Adjacency[i]))
+		for j, w := range g.Adjacency[i] {
+			weights[i][j] = w
+		}
+		active[i] = true
+	}
+
+	type candidate struct {
+		Vertex int
+		Weight int
+	}
+
+	bestCut := math.MaxInt
+	var bestSide []int
+
+	for remaining := n; remaining > 1; remaining-- {
+		// Grow a set from an arbitrary vertex, always adding the vertex most
+		// tightly connected to the set. The last two vertices added are s and t.
+		added := make([]bool, n)
+		connection := make([]int, n)
+
+		queue := utilities.NewPriorityQueue(func(a, b candidate) bool {
+			return a.Weight > b.Weight
+		})
+
+		for v := 0; v < n; v++ {
+			if active[v] {
+				queue.Push(candidate{Vertex: v, Weight: 0})
+				break
+			}
+		}
+
+		s, t := -1, -1
+
+		for !queue.IsEmpty() {
+			c := queue.Pop()
+			if added[c.Vertex] || c.Weight != connection[c.Vertex] {
+				continue
+			}
+
+			added[c.Vertex] = true
+			s, t = t, c.Vertex
+
+			for u, w := range weights[c.Vertex] {
+				if !added[u] {
+					connection[u] += w
+					queue.Push(candidate{Vertex: u, Weight: connection[u]})
+				}
+			}
+		}
+
+		if s < 0 {
+			return 0, nil, fmt.Errorf("components are not all connected")
+		}
+
+		// The cut of the phase separates t from everything else.
+		if connection[t] < bestCut {
+			bestCut = connection[t]
+			bestSide = append([]int{}, members[t]...)
+		}
+
+		// Merge t into s.
+		members[s] = append(members[s], members[t]...)
+
+		for u, w := range weights[t] {
+			delete(weights[u], t)
+
+			if u == s {
+				continue
+			}
+
+			weights[s][u] += w
+			weights[u][s] += w
+		}
+
+		weights[t] = nil
+		active[t] = false
+	}
+
+	side := make([]string, 0, len(bestSide))
+	for _, v := range bestSide {
+		side = append(side, g.Names[v])
+	}
+
+	return bestCut, side, nil
+}
+
+func (g *Graph) GroupSizeProduct() (int, int, error) {
+	cut, side, err := g.MinimumCut()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return cut, len(side) * (len(g.Names) - len(side)), nil
+}
+
+func day(fileContents string) error {
+	graph, err := ParseComponents(fileContents)
+	if err != nil {
+		return err
+	}
+
+	// Part 1: Find the three wires you need to disconnect in order to divide the
+	// components into two separate groups. What do you get if you multiply the sizes
+	// of these two groups together?
+	cut, product, err := graph.GroupSizeProduct()
+	if err != nil {
+		return err
+	}
+
+	if cut != 3 {
+		return fmt.Errorf("expected to cut 3 wires, but the minimum cut is %d", cut)
+	}
+
+	utilities.Answer(1, "Product of group sizes", product)
+
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyThree_day25
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleComponents = `jqt: rhn xhk nvd
+rsh: frs pzl lsr
+xhk: hfx
+cmg: qnr nvd lhk bvb
+rhn: xhk bvb hfx
+bvb: xhk hfx
+pzl: lsr hfx nvd
+qnr: nvd
+ntq: jqt hfx bvb xhk
+nvd: lhk
+lsr: lhk
+rzs: qnr cmg lsr rsh
+frs: qnr lhk lsr`
+
+func TestParseComponents(t *testing.T) {
+	graph, err := ParseComponents(exampleComponents)
+	assert.NoError(t, err)
+
+	assert.Len(t, graph.Names, 15)
+	assert.Equal(t, map[int]int{graph.Index["rhn"]: 1, graph.Index["xhk"]: 1, graph.Index["nvd"]: 1, graph.Index["ntq"]: 1}, graph.Adjacency[graph.Index["jqt"]])
+
+	_, err = ParseComponents("jqt rhn xhk nvd")
+	assert.Error(t, err)
+}
+
+func TestMinimumCut(t *testing.T) {
+	graph, err := ParseComponents(exampleComponents)
+	assert.NoError(t, err)
+
+	cut, side, err := graph.MinimumCut()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, cut)
+
+	groups := [][]string{
+		{"cmg", "frs", "lhk", "lsr", "nvd", "pzl", "qnr", "rsh", "rzs"},
+		{"bvb", "hfx", "jqt", "ntq", "rhn", "xhk"},
+	}
+
+	if len(side) == len(groups[0]) {
+		assert.ElementsMatch(t, groups[0], side)
+	} else {
+		assert.ElementsMatch(t, groups[1], side)
+	}
+}
+
+func TestGroupSizeProduct(t *testing.T) {
+	graph, err := ParseComponents(exampleComponents)
+	assert.NoError(t, err)
+
+	cut, product, err := graph.GroupSizeProduct()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, cut)
+	assert.Equal(t, 54, product)
+}
+
+func TestMinimumCutSingleBridge(t *testing.T) {
+	bridged := `aa: bb cc
+bb: cc
+cc: dd
+dd: ee ff
+ee: ff`
+
+	graph, err := ParseComponents(bridged)
+	assert.NoError(t, err)
+
+	cut, product, err := graph.GroupSizeProduct()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cut)
+	assert.Equal(t, 9, product)
+
+	assert.ErrorContains(t, day(bridged), "the minimum cut is 1")
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
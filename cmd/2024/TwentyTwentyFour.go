@@ -5,6 +5,8 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour
 
 import (
+	"embed"
+
 	TwentyTwentyFour_day01 "github.com/d1r7y/adventofcode/cmd/2024/day01"
 	TwentyTwentyFour_day02 "github.com/d1r7y/adventofcode/cmd/2024/day02"
 	TwentyTwentyFour_day03 "github.com/d1r7y/adventofcode/cmd/2024/day03"
@@ -20,6 +22,17 @@ import (
 	TwentyTwentyFour_day13 "github.com/d1r7y/adventofcode/cmd/2024/day13"
 	TwentyTwentyFour_day14 "github.com/d1r7y/adventofcode/cmd/2024/day14"
 	TwentyTwentyFour_day15 "github.com/d1r7y/adventofcode/cmd/2024/day15"
+	TwentyTwentyFour_day16 "github.com/d1r7y/adventofcode/cmd/2024/day16"
+	TwentyTwentyFour_day17 "github.com/d1r7y/adventofcode/cmd/2024/day17"
+	TwentyTwentyFour_day18 "github.com/d1r7y/adventofcode/cmd/2024/day18"
+	TwentyTwentyFour_day19 "github.com/d1r7y/adventofcode/cmd/2024/day19"
+	TwentyTwentyFour_day20 "github.com/d1r7y/adventofcode/cmd/2024/day20"
+	TwentyTwentyFour_day21 "github.com/d1r7y/adventofcode/cmd/2024/day21"
+	TwentyTwentyFour_day22 "github.com/d1r7y/adventofcode/cmd/2024/day22"
+	TwentyTwentyFour_day23 "github.com/d1r7y/adventofcode/cmd/2024/day23"
+	TwentyTwentyFour_day24 "github.com/d1r7y/adventofcode/cmd/2024/day24"
+	TwentyTwentyFour_day25 "github.com/d1r7y/adventofcode/cmd/2024/day25"
+	"github.com/d1r7y/adventofcode/utilities/metadata"
 	"github.com/spf13/cobra"
 )
 
@@ -30,7 +43,14 @@ var TwentyTwentyFourCmd = &cobra.Command{
 	Long:  ``,
 }
 
+// puzzles holds each day's puzzle.yaml
+//
+//go:embed day*/puzzle.yaml
+var puzzles embed.FS
+
 func init() {
+	metadata.MustRegisterYear(2024, puzzles)
+
 	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day01.Day01Cmd)
 	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day02.Day02Cmd)
 	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day03.Day03Cmd)
@@ -46,4 +66,14 @@ func init() {
 	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day13.Day13Cmd)
 	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day14.Day14Cmd)
 	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day15.Day15Cmd)
+	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day16.Day16Cmd)
+	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day17.Day17Cmd)
+	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day18.Day18Cmd)
+	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day19.Day19Cmd)
+	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day20.Day20Cmd)
+	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day21.Day21Cmd)
+	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day22.Day22Cmd)
+	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day23.Day23Cmd)
+	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day24.Day24Cmd)
+	TwentyTwentyFourCmd.AddCommand(TwentyTwentyFour_day25.Day25Cmd)
 }
@@ -32,7 +32,7 @@ var Day01Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(cmd, string(fileContent))
+		err = day(string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -73,12 +73,8 @@ func CalculateSimilarity(left []int, right []int) int {
 	return totalSimilarity
 }
 
-func day(_ *cobra.Command, fileContents string) error {
-	// Part 1: Pair up the smallest number in the left list with the
-	// smallest number in the right list, then the second-smallest left
-	// number with the second-smallest right number, and so on.
-	//
-	// Find the total distance between all the numbers.
+func day(fileContents string) error {
+	// Part 1: Find the total distance between all the numbers.
 	left, right, err := ParseLocationIDs(fileContents)
 	if err != nil {
 		return err
@@ -91,7 +87,7 @@ func day(_ *cobra.Command, fileContents string) error {
 		totalDistance += distance
 	}
 
-	fmt.Printf("Total distance: %d.\n", totalDistance)
+	utilities.Answer(1, "Total distance", totalDistance)
 
 	// Part 2: This time, you'll need to figure out exactly how often each
 	// number from the left list appears in the right list. Calculate a total
@@ -100,7 +96,7 @@ func day(_ *cobra.Command, fileContents string) error {
 
 	similarity := CalculateSimilarity(left, right)
 
-	fmt.Printf("Similarity: %d.\n", similarity)
+	utilities.Answer(2, "Similarity", similarity)
 
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -78,3 +79,7 @@ func TestCalculateSimilarity(t *testing.T) {
 	similarity := CalculateSimilarity(left, right)
 	assert.Equal(t, 31, similarity)
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
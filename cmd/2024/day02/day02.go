@@ -32,7 +32,7 @@ var Day02Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(cmd, string(fileContent))
+		err = day(string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -135,16 +135,8 @@ func CheckReportSafetyProblemDamper(levels []int) bool {
 	return false
 }
 
-func day(_ *cobra.Command, fileContents string) error {
-	// Part 1: The engineers are trying to figure out which reports are safe.
-	// The Red-Nosed reactor safety systems can only tolerate levels that are
-	// either gradually increasing or gradually decreasing. So, a report only
-	// counts as safe if both of the following are true:
-	//
-	//	- The levels are either all increasing or all decreasing.
-	//	- Any two adjacent levels differ by at least one and at most three
-	//
-	// Analyze the unusual data from the engineers. How many reports are safe?
+func day(fileContents string) error {
+	// Part 1: Analyze the unusual data from the engineers. How many reports are safe?
 
 	numSafeReports := 0
 	numDampenedSafeReports := 0
@@ -163,19 +155,12 @@ func day(_ *cobra.Command, fileContents string) error {
 		}
 	}
 
-	fmt.Printf("Number of safe reports: %d.\n", numSafeReports)
+	utilities.Answer(1, "Number of safe reports", numSafeReports)
 
-	// Part 2: The Problem Dampener is a reactor-mounted module that lets the reactor
-	// safety systems tolerate a single bad level in what would otherwise be a safe
-	// report. It's like the bad level never happened!
-	//
-	// Now, the same rules apply as before, except if removing a single level from an
-	// unsafe report would make it safe, the report instead counts as safe.
-	//
-	// Update your analysis by handling situations where the Problem Dampener can remove
-	// a single level from unsafe reports. How many reports are now safe?
+	// Part 2: Update your analysis by handling situations where the Problem Dampener can
+	// remove a single level from unsafe reports. How many reports are now safe?
 
-	fmt.Printf("Number of dampened safe reports: %d.\n", numDampenedSafeReports)
+	utilities.Answer(2, "Number of dampened safe reports", numDampenedSafeReports)
 
 	return nil
 }
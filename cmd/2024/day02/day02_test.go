@@ -7,6 +7,7 @@ package TwentyTwentyFour_day02
 import (
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -172,3 +173,7 @@ func TestCheckReportSafetyProblemDamper(t *testing.T) {
 		assert.Equal(t, test.expectedSafety, CheckReportSafetyProblemDamper(test.levels))
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -5,16 +5,15 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day03
 
 import (
-	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
-	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -34,15 +33,13 @@ var Day03Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(cmd, string(fileContent))
+		err = day(utilities.GetLogger(cmd), string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
 	},
 }
 
-var cmd *cobra.Command
-
 type MulInstruction struct {
 	factorA int
 	factorB int
@@ -73,7 +70,7 @@ func ScanMulInstructions(fileContents string) []MulInstruction {
 	return instructions
 }
 
-func ScanEnabledMulInstructions(fileContents string) []MulInstruction {
+func ScanEnabledMulInstructions(logger *slog.Logger, fileContents string) []MulInstruction {
 	instructions := make([]MulInstruction, 0)
 	mulRE := regexp.MustCompile(`mul\(([0-9]{1,3}),([0-9]{1,3})\)|do\(\)|don't\(\)`)
 
@@ -96,17 +93,7 @@ func ScanEnabledMulInstructions(fileContents string) []MulInstruction {
 					return instructions
 				}
 
-				if utilities.GetVerbosity(cmd) > 0 {
-					if enabled {
-						color.Set(color.FgGreen)
-					} else {
-						color.Set(color.FgRed)
-					}
-
-					fmt.Printf("%d*%d ", factorA, factorB)
-
-					color.Unset()
-				}
+				logger.Debug("mul", "a", factorA, "b", factorB, "enabled", enabled)
 
 				if enabled {
 					instructions = append(instructions, MulInstruction{factorA: factorA, factorB: factorB})
@@ -115,10 +102,6 @@ func ScanEnabledMulInstructions(fileContents string) []MulInstruction {
 		}
 	}
 
-	if utilities.GetVerbosity(cmd) > 0 {
-		fmt.Println()
-	}
-
 	return instructions
 }
 
@@ -131,48 +114,26 @@ func SumMultiplicationInstructions(inst []MulInstruction) int {
 	return total
 }
 
-func day(command *cobra.Command, fileContents string) error {
-	cmd = command
-
-	// Part 1: It seems like the goal of the program is just to multiply some numbers. It
-	// does that with instructions like mul(X,Y), where X and Y are each 1-3 digit numbers.
-	// For instance, mul(44,46) multiplies 44 by 46 to get a result of 2024. Similarly,
-	// mul(123,4) would multiply 123 by 4.
-	//
-	// However, because the program's memory has been corrupted, there are also many invalid
-	// characters that should be ignored, even if they look like part of a mul instruction.
-	// Sequences like mul(4*, mul(6,9!, ?(12,34), or mul ( 2 , 4 ) do nothing.
-	//
-	// Scan the corrupted memory for uncorrupted mul instructions. What do you get if you add
-	// up all of the results of the multiplications?
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Scan the corrupted memory for uncorrupted mul instructions. What do you get if
+	// you add up all of the results of the multiplications?
 
 	totalSum := 0
 
 	instructions := ScanMulInstructions(fileContents)
 	totalSum += SumMultiplicationInstructions(instructions)
 
-	fmt.Printf("Multiplications sum: %d\n", totalSum)
-
-	// Part 2: As you scan through the corrupted memory, you notice that some of the conditional
-	// statements are also still intact. If you handle some of the uncorrupted conditional
-	// statements in the program, you might be able to get an even more accurate result.
-	//
-	// There are two new instructions you'll need to handle:
-	//	- The do() instruction enables future mul instructions.
-	//	- The don't() instruction disables future mul instructions.
-	//
-	// Only the most recent do() or don't() instruction applies. At the beginning of the program,
-	// mul instructions are enabled.
-	//
-	// Handle the new instructions; what do you get if you add up all of the results of just the
-	// enabled multiplications?
+	utilities.Answer(1, "Multiplications sum", totalSum)
+
+	// Part 2: Handle the new instructions; what do you get if you add up all of the results of
+	// just the enabled multiplications?
 
 	totalSum = 0
 
-	instructions = ScanEnabledMulInstructions(fileContents)
+	instructions = ScanEnabledMulInstructions(logger, fileContents)
 	totalSum += SumMultiplicationInstructions(instructions)
 
-	fmt.Printf("Multiplications sum of enabled instructions: %d\n", totalSum)
+	utilities.Answer(2, "Multiplications sum of enabled instructions", totalSum)
 
 	return nil
 }
@@ -5,8 +5,12 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day03
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,8 +44,18 @@ func TestScanEnabledMulInstructions(t *testing.T) {
 	}
 
 	for _, test := range testCases {
-		assert.Equal(t, test.expectedInstructions, ScanEnabledMulInstructions(test.line))
+		assert.Equal(t, test.expectedInstructions, ScanEnabledMulInstructions(utilities.DiscardLogger, test.line))
 	}
+
+	var out bytes.Buffer
+	ScanEnabledMulInstructions(utilities.NewLogger(&out, utilities.LogOptions{Verbosity: 1}), testCases[0].line)
+
+	assert.Equal(t, strings.Join([]string{
+		"DEBUG mul a=2 b=4 enabled=true",
+		"DEBUG mul a=5 b=5 enabled=false",
+		"DEBUG mul a=11 b=8 enabled=false",
+		"DEBUG mul a=8 b=5 enabled=true",
+	}, "\n")+"\n", out.String())
 }
 
 func TestSumMultiplicationInstructions(t *testing.T) {
@@ -60,3 +74,7 @@ func TestSumMultiplicationInstructions(t *testing.T) {
 		assert.Equal(t, test.expectedSum, SumMultiplicationInstructions(test.instructions))
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
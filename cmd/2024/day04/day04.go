@@ -5,7 +5,6 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day04
 
 import (
-	"fmt"
 	"io"
 	"log"
 	"os"
@@ -31,7 +30,7 @@ var Day04Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(cmd, string(fileContent))
+		err = day(string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -204,36 +203,20 @@ func (lg *LetterGrid) FindString(str string) []utilities.Point2D {
 	return locations
 }
 
-func day(_ *cobra.Command, fileContents string) error {
-	// Part 1: "Looks like the Chief's not here. Next!" One of The Historians pulls out a
-	// device and pushes the only button on it. After a brief flash, you recognize the interior
-	// of the Ceres monitoring station!
-	//
-	// As the search for the Chief continues, a small Elf who lives on the station tugs on your
-	// shirt; she'd like to know if you could help her with her word search (your puzzle input).
-	// She only has to find one word: XMAS.
-	//
-	// This word search allows words to be horizontal, vertical, diagonal, written backwards, or
-	// even overlapping other words. It's a little unusual, though, as you don't merely need to find
-	// one instance of XMAS - you need to find all of them.
-	//
-	// Take a look at the little Elf's word search. How many times does XMAS appear?
+func day(fileContents string) error {
+	// Part 1: Take a look at the little Elf's word search. How many times does XMAS appear?
 
 	lg := ParseLetterGrid(fileContents)
 	locations := lg.FindString("XMAS")
 
-	fmt.Printf("'XMAS' appears: %d times\n", len(locations))
+	utilities.Answer(1, "Times 'XMAS' appears", len(locations))
 
-	// Part 2: Looking for the instructions, you flip over the word search to find that this isn't
-	// actually an XMAS puzzle; it's an X-MAS puzzle in which you're supposed to find two MAS in the
-	// shape of an X.
-	//
-	// Flip the word search from the instructions back over to the word search side and try again.
-	// How many times does an X-MAS appear?
+	// Part 2: Flip the word search from the instructions back over to the word search side and
+	// try again. How many times does an X-MAS appear?
 
 	locations = lg.FindXMAS()
 
-	fmt.Printf("X-MAS appears: %d times\n", len(locations))
+	utilities.Answer(2, "Times X-MAS appears", len(locations))
 
 	return nil
 }
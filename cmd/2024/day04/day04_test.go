@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -181,3 +182,7 @@ MXMXAXMASX`,
 		assert.Equal(t, test.expectedPositions, lg.FindXMAS())
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -5,7 +5,6 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day05
 
 import (
-	"fmt"
 	"io"
 	"log"
 	"os"
@@ -34,7 +33,7 @@ var Day05Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(cmd, string(fileContent))
+		err = day(string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -188,18 +187,10 @@ func ParseUpdate(line string) *Update {
 	return update
 }
 
-func day(_ *cobra.Command, fileContents string) error {
-	// Part 1: The Elf must recognize you, because they waste no time explaining that the
-	// new sleigh launch safety manual updates won't print correctly. Failure to update the
-	// safety manuals would be dire indeed, so you offer your services.
-	//
-	// Safety protocols clearly indicate that new pages for the safety manuals must be printed
-	// in a very specific order. The notation X|Y means that if both page number X and page
-	// number Y are to be produced as part of an update, page number X must be printed at some
-	// point before page number Y.
-	//
-	// The Elf has for you both the page ordering rules and the pages to produce in each update
-	// (your puzzle input), but can't figure out whether each update has the pages in the right order.
+func day(fileContents string) error {
+	// Part 1: The Elf has for you both the page ordering rules and the pages to produce in
+	// each update (your puzzle input), but can't figure out whether each update has the pages
+	// in the right order.
 
 	orderingRules := NewOrderingRules()
 
@@ -227,15 +218,12 @@ func day(_ *cobra.Command, fileContents string) error {
 		}
 	}
 
-	fmt.Printf("Valid order middle page number sum: %d\n", validMiddlePageTotal)
+	utilities.Answer(1, "Valid order middle page number sum", validMiddlePageTotal)
 
-	// Part 2: For each of the incorrectly-ordered updates, use the page ordering rules to put the
-	// page numbers in the right order.
-	//
-	// Find the updates which are not in the correct order. What do you get if you add up the middle
-	// page numbers after correctly ordering just those updates?
+	// Part 2: Find the updates which are not in the correct order. What do you get if you add
+	// up the middle page numbers after correctly ordering just those updates?
 
-	fmt.Printf("Invalid order middle page number sum: %d\n", invalidMiddlePageTotal)
+	utilities.Answer(2, "Invalid order middle page number sum", invalidMiddlePageTotal)
 
 	return nil
 }
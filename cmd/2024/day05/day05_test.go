@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
 )
 
 func TestUpdateValidity(t *testing.T) {
@@ -72,3 +74,7 @@ func TestUpdateValidity(t *testing.T) {
 		}
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
@@ -5,13 +5,20 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day06
 
 import (
+	"context"
 	"fmt"
+	"image/color"
 	"io"
 	"log"
+	"log/slog"
+	"math/bits"
 	"os"
 	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/debugger"
+	"github.com/d1r7y/adventofcode/utilities/render"
+	termcolor "github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -31,18 +38,35 @@ var Day06Cmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = day(cmd, string(fileContent))
+		err = day(utilities.GetContext(cmd), utilities.GetLogger(cmd), string(fileContent))
 		if err != nil {
 			log.Fatal(err)
 		}
 	},
 }
 
+var animatePath string
+var showLoops bool
+
+func init() {
+	Day06Cmd.Flags().StringVar(&animatePath, "animate", "", "write an animation of the guard's walk to a .gif file or numbered .png files")
+	Day06Cmd.Flags().BoolVar(&showLoops, "show-loops", false, "draw the loop each looping obstruction traps the guard in")
+
+	debugger.Register(2024, 6, func(input string) (debugger.Simulation, error) {
+		return &WalkSimulation{Map: ParseMap(strings.TrimSpace(input))}, nil
+	})
+}
+
+// StepsPerFrame keeps animations of long walks to a reasonable size.
+const StepsPerFrame = 20
+
 type Cell int
 
 const (
 	Empty Cell = iota
 	Obstruction
+	// AddedObstruction is one put in the guard's way for part 2.
+	AddedObstruction
 )
 
 type Row []Cell
@@ -58,6 +82,25 @@ const (
 	West  Direction = 8
 )
 
+// Right is the way the guard faces after turning right.
+func (d Direction) Right() Direction {
+	if d == West {
+		return North
+	}
+
+	return d << 1
+}
+
+func (d Direction) index() int {
+	return bits.TrailingZeros(uint(d))
+}
+
+// Guard is where the guard is and which way they're facing.
+type Guard struct {
+	Position utilities.Point2D
+	Facing   Direction
+}
+
 type Map struct {
 	Bounds       utilities.Size2D
 	Position     utilities.Point2D
@@ -91,7 +134,21 @@ func (m *Map) SetVisited(location utilities.Point2D, facing Direction) {
 }
 
 func (m *Map) AddObstruction(location utilities.Point2D) {
-	m.Columns[location.Y][location.X] = Obstruction
+	m.Columns[location.Y][location.X] = AddedObstruction
+}
+
+// Clone copies the map, along with where the guard is and where they've been.
+func (m *Map) Clone() *Map {
+	clone := *m
+	clone.Columns = make([]Row, len(m.Columns))
+	clone.Visited = make([]VisitedRow, len(m.Visited))
+
+	for y := range m.Columns {
+		clone.Columns[y] = append(Row(nil), m.Columns[y]...)
+		clone.Visited[y] = append(VisitedRow(nil), m.Visited[y]...)
+	}
+
+	return &clone
 }
 
 func (m *Map) AreLooping() bool {
@@ -106,7 +163,7 @@ func (m *Map) Walk() bool {
 		}
 
 		c := m.GetCell(m.Position.Up())
-		if c == Obstruction {
+		if c != Empty {
 			m.Facing = East
 		} else {
 			m.Position = m.Position.Up()
@@ -118,7 +175,7 @@ func (m *Map) Walk() bool {
 		}
 
 		c := m.GetCell(m.Position.Right())
-		if c == Obstruction {
+		if c != Empty {
 			m.Facing = South
 		} else {
 			m.Position = m.Position.Right()
@@ -130,7 +187,7 @@ func (m *Map) Walk() bool {
 		}
 
 		c := m.GetCell(m.Position.Down())
-		if c == Obstruction {
+		if c != Empty {
 			m.Facing = West
 		} else {
 			m.Position = m.Position.Down()
@@ -142,7 +199,7 @@ func (m *Map) Walk() bool {
 		}
 
 		c := m.GetCell(m.Position.Left())
-		if c == Obstruction {
+		if c != Empty {
 			m.Facing = North
 		} else {
 			m.Position = m.Position.Left()
@@ -153,6 +210,103 @@ func (m *Map) Walk() bool {
 	return false
 }
 
+// Pixel describes a position the way the puzzle draws it: # for an obstruction,
+// O for an added one, ^ for the guard and X for a visited position.
+func (m *Map) Pixel(location utilities.Point2D) rune {
+	switch {
+	case m.GetCell(location) == Obstruction:
+		return '#'
+	case m.GetCell(location) == AddedObstruction:
+		return 'O'
+	case location == m.Position:
+		return '^'
+	case m.GetVisited(location):
+		return 'X'
+	}
+
+	return '.'
+}
+
+func NewRecorder() *render.Recorder[rune] {
+	palette := render.NewPalette[rune](color.RGBA{15, 15, 35, 255}).
+		Set('#', color.RGBA{204, 204, 204, 255}).
+		Set('X', color.RGBA{0, 153, 0, 255}).
+		Set('O', color.RGBA{255, 153, 0, 255}).
+		Set('^', color.RGBA{255, 255, 102, 255})
+
+	recorder := render.NewRecorder(palette)
+	recorder.Scale = 2
+	recorder.Every = StepsPerFrame
+
+	return recorder
+}
+
+func (d Direction) Describe() string {
+	switch d {
+	case North:
+		return "^"
+	case East:
+		return ">"
+	case South:
+		return "v"
+	}
+
+	return "<"
+}
+
+func NewTerminal() *render.Terminal[rune] {
+	return render.NewTerminal[rune]().
+		Set('#', termcolor.FgHiBlack).
+		Set('X', termcolor.FgGreen).
+		Set('O', termcolor.FgHiYellow, termcolor.Bold).
+		Set('^', termcolor.FgHiRed, termcolor.Bold)
+}
+
+// Render draws the map as the puzzle does, with the guard pointing the way they
+// are facing.
+func (m *Map) Render(terminal *render.Terminal[rune]) string {
+	return terminal.Draw(m.Bounds, func(location utilities.Point2D) string {
+		if location == m.Position {
+			return m.Facing.Describe()
+		}
+
+		return string(m.Pixel(location))
+	}, m.Pixel)
+}
+
+// WalkSimulation steps through the guard's walk in the debugger.
+type WalkSimulation struct {
+	Map    *Map
+	Steps  int
+	turned bool
+}
+
+func (s *WalkSimulation) Step() bool {
+	facing := s.Map.Facing
+	done := s.Map.Walk()
+
+	s.Steps++
+	s.turned = s.Map.Facing != facing
+
+	return done
+}
+
+func (s *WalkSimulation) Render() string {
+	return s.Map.Render(NewTerminal())
+}
+
+func (s *WalkSimulation) Inspect() string {
+	return fmt.Sprintf("step %d: guard at %d,%d facing %s, %d positions visited, looping %t",
+		s.Steps, s.Map.Position.X, s.Map.Position.Y, s.Map.Facing.Describe(), s.Map.VisitedCells, s.Map.Looping)
+}
+
+func (s *WalkSimulation) Breakpoints() map[string]func() bool {
+	return map[string]func() bool{
+		"turn": func() bool { return s.turned },
+		"loop": s.Map.AreLooping,
+	}
+}
+
 func ParseMap(fileContents string) *Map {
 	m := &Map{}
 	m.Columns = make([]Row, 0)
@@ -160,7 +314,7 @@ func ParseMap(fileContents string) *Map {
 	m.Looping = false
 	m.VisitedCells = 0
 
-	for y, line := range strings.Split(fileContents, "\n") {
+	for y, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
 		row := make(Row, 0)
 		visitedRow := make(VisitedRow, 0)
 		for x, r := range line {
@@ -188,81 +342,106 @@ func ParseMap(fileContents string) *Map {
 	return m
 }
 
-func day(cmd *cobra.Command, fileContents string) error {
-	// Part 1: The map shows the current position of the guard with ^ (to indicate
-	// the guard is currently facing up from the perspective of the map). Any
-	// obstructions - crates, desks, alchemical reactors, etc. - are shown as #.
-	//
-	// Lab guards in 1518 follow a very strict patrol protocol which involves repeatedly
-	// following these steps:
-	//
-	//	If there is something directly in front of you, turn right 90 degrees.
-	//	Otherwise, take a step forward.
-	//
-	// By predicting the guard's route, you can determine which specific positions in the
-	// lab will be in the patrol path. Including the guard's starting position, the positions
-	// visited by the guard before leaving the area are marked with an X.
-	//
-	// Predict the path of the guard. How many distinct positions will the guard visit
+// Entry is the guard about to step onto a position for the first time.
+type Entry struct {
+	Guard    Guard
+	Position utilities.Point2D
+}
+
+// LoopingObstructions finds the positions on the guard's path where an added
+// obstruction traps them in a loop. An obstruction anywhere else never gets in
+// their way. The guard walks the same path up to the first time they'd step onto
+// the obstruction, so each check starts from there.
+func LoopingObstructions(ctx context.Context, roomMap *Map, path []Entry) ([]utilities.Point2D, error) {
+	table := NewJumpTable(roomMap)
+
+	loops, err := utilities.ParallelMap(ctx, path, func(entry Entry) bool {
+		return table.Loops(entry.Guard, entry.Position)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	obstructions := make([]utilities.Point2D, 0)
+
+	for i, entry := range path {
+		if loops[i] {
+			obstructions = append(obstructions, entry.Position)
+		}
+	}
+
+	return obstructions, nil
+}
+
+// Trap walks the guard with an obstruction added at location until they're looping.
+func (m *Map) Trap(location utilities.Point2D) {
+	m.AddObstruction(location)
+
+	for !m.Walk() && !m.AreLooping() {
+	}
+}
+
+func day(ctx context.Context, logger *slog.Logger, fileContents string) error {
+	// Part 1: Predict the path of the guard. How many distinct positions will the guard visit
 	// before leaving the mapped area?
 
 	roomMap := ParseMap(fileContents)
+	unwalkedRoomMap := roomMap.Clone()
 
-	guardStartingLocation := roomMap.Position
+	var recorder *render.Recorder[rune]
+	if animatePath != "" {
+		recorder = NewRecorder()
+	}
+
+	path := make([]Entry, 0)
 
 	for {
+		recorder.Snapshot(roomMap.Bounds, roomMap.Pixel)
+
+		guard := Guard{Position: roomMap.Position, Facing: roomMap.Facing}
+		visitedCells := roomMap.VisitedCells
+
 		if roomMap.Walk() {
 			break
 		}
+
+		if roomMap.VisitedCells > visitedCells {
+			path = append(path, Entry{Guard: guard, Position: roomMap.Position})
+		}
 	}
 
-	fmt.Printf("Total distinct positions visited by guard: %d\n", roomMap.VisitedCells)
-
-	// Part 2: Returning after what seems like only a few seconds to The Historians, they
-	// explain that the guard's patrol area is simply too large for them to safely search
-	// the lab without getting caught.
-	//
-	// Fortunately, they are pretty sure that adding a single new obstruction won't cause a
-	// time paradox. They'd like to place the new obstruction in such a way that the guard
-	// will get stuck in a loop, making the rest of the lab safe to search.
-	//
-	// To have the lowest chance of creating a time paradox, The Historians would like to know
-	// all of the possible positions for such an obstruction. The new obstruction can't be placed
-	// at the guard's starting position - the guard is there right now and would notice.
-	//
-	// You need to get the guard stuck in a loop by adding a single new obstruction. How many
-	// different positions could you choose for this obstruction?
-
-	loopingObstructionCount := 0
-
-	for y := 0; y < roomMap.Bounds.Height; y++ {
-		for x := 0; x < roomMap.Bounds.Width; x++ {
-			obstructionLocation := utilities.NewPoint2D(x, y)
-
-			if guardStartingLocation == obstructionLocation {
-				continue
-			}
+	recorder.Capture(roomMap.Bounds, roomMap.Pixel)
 
-			obstructedRoomMap := ParseMap(fileContents)
-			obstructedRoomMap.AddObstruction(obstructionLocation)
-
-			for {
-				if obstructedRoomMap.Walk() {
-					break
-				}
-
-				if obstructedRoomMap.AreLooping() {
-					if utilities.GetVerbosity(cmd) > 0 {
-						fmt.Printf("Obstruction @ %dx%d loops guard\n", x, y)
-					}
-					loopingObstructionCount++
-					break
-				}
-			}
+	if animatePath != "" {
+		if err := recorder.Save(animatePath); err != nil {
+			return err
+		}
+
+		fmt.Printf("Wrote %d frames to %s\n", recorder.FrameCount(), animatePath)
+	}
+
+	utilities.Answer(1, "Total distinct positions visited by guard", roomMap.VisitedCells)
+
+	// Part 2: You need to get the guard stuck in a loop by adding a single new obstruction.
+	// How many different positions could you choose for this obstruction?
+
+	obstructions, err := LoopingObstructions(ctx, unwalkedRoomMap, path)
+	if err != nil {
+		return err
+	}
+
+	for _, obstruction := range obstructions {
+		logger.Debug("obstruction loops guard", "x", obstruction.X, "y", obstruction.Y)
+
+		if showLoops {
+			trapped := unwalkedRoomMap.Clone()
+			trapped.Trap(obstruction)
+
+			fmt.Printf("Obstruction at %d,%d:\n%s\n", obstruction.X, obstruction.Y, trapped.Render(NewTerminal()))
 		}
 	}
 
-	fmt.Printf("Number of different positions to place obstruction to loop guard: %d\n", loopingObstructionCount)
+	utilities.Answer(2, "Number of different positions to place obstruction to loop guard", len(obstructions))
 
 	return nil
 }
@@ -5,9 +5,13 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day06
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/debugger"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -221,3 +225,172 @@ func TestLoopingObstructionCount(t *testing.T) {
 		assert.Equal(t, test.expectedLoopingObstructionCount, loopingObstructionCount)
 	}
 }
+
+const exampleMap = `....#.....
+.........#
+..........
+..#.......
+.......#..
+..........
+.#..^.....
+........#.
+#.........
+......#...`
+
+func walkPath(roomMap *Map) []Entry {
+	path := make([]Entry, 0)
+
+	for {
+		guard := Guard{Position: roomMap.Position, Facing: roomMap.Facing}
+		visitedCells := roomMap.VisitedCells
+
+		if roomMap.Walk() {
+			return path
+		}
+
+		if roomMap.VisitedCells > visitedCells {
+			path = append(path, Entry{Guard: guard, Position: roomMap.Position})
+		}
+	}
+}
+
+func TestJumpTable(t *testing.T) {
+	roomMap := ParseMap(exampleMap)
+	table := NewJumpTable(roomMap)
+	nowhere := utilities.NewPoint2D(-1, -1)
+
+	type testCase struct {
+		guard            Guard
+		extra            utilities.Point2D
+		expectedPosition utilities.Point2D
+		expectedStopped  bool
+	}
+
+	testCases := []testCase{
+		{Guard{utilities.NewPoint2D(4, 6), North}, nowhere, utilities.NewPoint2D(4, 1), true},
+		{Guard{utilities.NewPoint2D(4, 1), East}, nowhere, utilities.NewPoint2D(8, 1), true},
+		{Guard{utilities.NewPoint2D(8, 1), South}, nowhere, utilities.NewPoint2D(8, 6), true},
+		{Guard{utilities.NewPoint2D(7, 7), South}, nowhere, utilities.NewPoint2D(7, Leaves), false},
+		{Guard{utilities.NewPoint2D(5, 5), West}, nowhere, utilities.NewPoint2D(Leaves, 5), false},
+		// The added obstruction stops the guard short.
+		{Guard{utilities.NewPoint2D(4, 6), North}, utilities.NewPoint2D(4, 3), utilities.NewPoint2D(4, 4), true},
+		{Guard{utilities.NewPoint2D(7, 7), South}, utilities.NewPoint2D(7, 9), utilities.NewPoint2D(7, 8), true},
+		{Guard{utilities.NewPoint2D(5, 5), West}, utilities.NewPoint2D(5, 5), utilities.NewPoint2D(Leaves, 5), false},
+		// But not when it's behind them or past the obstruction they'd stop at.
+		{Guard{utilities.NewPoint2D(4, 6), North}, utilities.NewPoint2D(4, 7), utilities.NewPoint2D(4, 1), true},
+		{Guard{utilities.NewPoint2D(4, 1), East}, utilities.NewPoint2D(9, 1), utilities.NewPoint2D(8, 1), true},
+	}
+
+	for _, test := range testCases {
+		position, stopped := table.Jump(test.guard, test.extra)
+		assert.Equal(t, test.expectedPosition, position, test)
+		assert.Equal(t, test.expectedStopped, stopped, test)
+	}
+}
+
+func TestLoopingObstructions(t *testing.T) {
+	roomMap := ParseMap(exampleMap)
+	unwalked := roomMap.Clone()
+
+	path := walkPath(roomMap)
+	assert.Len(t, path, 40)
+
+	obstructions, err := LoopingObstructions(context.Background(), unwalked, path)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []utilities.Point2D{
+		utilities.NewPoint2D(3, 6), utilities.NewPoint2D(6, 7), utilities.NewPoint2D(7, 7),
+		utilities.NewPoint2D(1, 8), utilities.NewPoint2D(3, 8), utilities.NewPoint2D(7, 9),
+	}, obstructions)
+
+	// Every one of them traps the guard when walked a step at a time too.
+	for _, obstruction := range obstructions {
+		trapped := unwalked.Clone()
+		trapped.Trap(obstruction)
+
+		assert.True(t, trapped.AreLooping(), obstruction)
+		assert.Equal(t, 'O', trapped.Pixel(obstruction))
+	}
+
+	// Cloning left the unwalked map alone.
+	assert.Equal(t, 1, unwalked.VisitedCells)
+	assert.Equal(t, Empty, unwalked.GetCell(utilities.NewPoint2D(3, 6)))
+}
+
+func TestParseMapTrailingNewline(t *testing.T) {
+	roomMap := ParseMap(exampleMap + "\n")
+
+	assert.Equal(t, utilities.NewSize2D(10, 10), roomMap.Bounds)
+	assert.Len(t, roomMap.Columns, 10)
+	assert.Len(t, walkPath(roomMap), 40)
+}
+
+func TestAnimateWalk(t *testing.T) {
+	roomMap := ParseMap(`....#.....
+.........#
+..........
+..#.......
+.......#..
+..........
+.#..^.....
+........#.
+#.........
+......#...`)
+
+	assert.Equal(t, '#', roomMap.Pixel(utilities.NewPoint2D(4, 0)))
+	assert.Equal(t, '^', roomMap.Pixel(utilities.NewPoint2D(4, 6)))
+	assert.Equal(t, '.', roomMap.Pixel(utilities.NewPoint2D(4, 5)))
+
+	recorder := NewRecorder()
+	steps := 0
+
+	for {
+		recorder.Snapshot(roomMap.Bounds, roomMap.Pixel)
+		steps++
+
+		if roomMap.Walk() {
+			break
+		}
+	}
+
+	recorder.Capture(roomMap.Bounds, roomMap.Pixel)
+
+	assert.Equal(t, 'X', roomMap.Pixel(utilities.NewPoint2D(4, 5)))
+	assert.Equal(t, (steps+StepsPerFrame-1)/StepsPerFrame+1, recorder.FrameCount())
+	assert.Equal(t, 2*roomMap.Bounds.Width, recorder.Frames[0].Bounds().Dx())
+}
+
+func TestWalkSimulation(t *testing.T) {
+	factory, ok := debugger.Lookup(2024, 6)
+	assert.True(t, ok)
+
+	simulation, err := factory(`....#.....
+.........#
+..........
+..#.......
+.......#..
+..........
+.#..^.....
+........#.
+#.........
+......#...
+`)
+	assert.NoError(t, err)
+
+	session := debugger.NewSession(simulation)
+	session.Toggle("turn")
+
+	// The guard walks up to the obstruction and turns to face east.
+	assert.Equal(t, "turn", session.Continue(nil))
+	assert.Equal(t, 6, session.Step())
+	assert.Equal(t, "step 6: guard at 4,1 facing >, 6 positions visited, looping false", session.Inspect())
+	assert.Equal(t, "....>....#", strings.Split(session.Render(), "\n")[1])
+
+	session.Toggle("turn")
+	session.Continue(nil)
+	assert.True(t, session.Finished)
+	assert.Contains(t, session.Inspect(), "41 positions visited")
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(context.Background(), utilities.DiscardLogger, input) })
+}
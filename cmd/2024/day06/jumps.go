@@ -0,0 +1,127 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day06
+
+import "github.com/d1r7y/adventofcode/utilities"
+
+// Leaves marks a jump that takes the guard off the map.
+const Leaves = -1
+
+// JumpTable holds, for every position and direction, how far the guard gets
+// walking that way before the next obstruction: the row they stop in going north
+// or south, or the column going east or west. Leaves if nothing stops them.
+type JumpTable struct {
+	Bounds utilities.Size2D
+	stops  [4][]int
+}
+
+// NewJumpTable builds the table from the obstructions on m.
+func NewJumpTable(m *Map) *JumpTable {
+	t := &JumpTable{Bounds: m.Bounds}
+
+	for i := range t.stops {
+		t.stops[i] = make([]int, m.Bounds.Width*m.Bounds.Height)
+	}
+
+	blocked := func(x, y int) bool { return m.Columns[y][x] != Empty }
+
+	for x := 0; x < m.Bounds.Width; x++ {
+		stop := Leaves
+		for y := 0; y < m.Bounds.Height; y++ {
+			t.stops[North.index()][t.offset(x, y)] = stop
+			if blocked(x, y) {
+				stop = y + 1
+			}
+		}
+
+		stop = Leaves
+		for y := m.Bounds.Height - 1; y >= 0; y-- {
+			t.stops[South.index()][t.offset(x, y)] = stop
+			if blocked(x, y) {
+				stop = y - 1
+			}
+		}
+	}
+
+	for y := 0; y < m.Bounds.Height; y++ {
+		stop := Leaves
+		for x := 0; x < m.Bounds.Width; x++ {
+			t.stops[West.index()][t.offset(x, y)] = stop
+			if blocked(x, y) {
+				stop = x + 1
+			}
+		}
+
+		stop = Leaves
+		for x := m.Bounds.Width - 1; x >= 0; x-- {
+			t.stops[East.index()][t.offset(x, y)] = stop
+			if blocked(x, y) {
+				stop = x - 1
+			}
+		}
+	}
+
+	return t
+}
+
+func (t *JumpTable) offset(x, y int) int {
+	return y*t.Bounds.Width + x
+}
+
+// Jump walks the guard straight ahead until something's in their way, which might
+// be the obstruction added at extra. It returns where they stop, or false if they
+// walk off the map.
+func (t *JumpTable) Jump(guard Guard, extra utilities.Point2D) (utilities.Point2D, bool) {
+	position := guard.Position
+	stop := t.stops[guard.Facing.index()][t.offset(position.X, position.Y)]
+
+	switch guard.Facing {
+	case North:
+		if extra.X == position.X && extra.Y < position.Y && (stop == Leaves || extra.Y >= stop) {
+			stop = extra.Y + 1
+		}
+		position.Y = stop
+	case South:
+		if extra.X == position.X && extra.Y > position.Y && (stop == Leaves || extra.Y <= stop) {
+			stop = extra.Y - 1
+		}
+		position.Y = stop
+	case West:
+		if extra.Y == position.Y && extra.X < position.X && (stop == Leaves || extra.X >= stop) {
+			stop = extra.X + 1
+		}
+		position.X = stop
+	case East:
+		if extra.Y == position.Y && extra.X > position.X && (stop == Leaves || extra.X <= stop) {
+			stop = extra.X - 1
+		}
+		position.X = stop
+	}
+
+	return position, stop != Leaves
+}
+
+// Loops reports whether an obstruction at extra traps the guard, starting from
+// guard. The guard only changes direction at obstructions, so they're looping as
+// soon as they turn somewhere facing the same way as they did before.
+func (t *JumpTable) Loops(guard Guard, extra utilities.Point2D) bool {
+	turns := make([]Direction, t.Bounds.Width*t.Bounds.Height)
+
+	for {
+		position, stopped := t.Jump(guard, extra)
+		if !stopped {
+			return false
+		}
+
+		turn := &turns[t.offset(position.X, position.Y)]
+		if *turn&guard.Facing != 0 {
+			return true
+		}
+
+		*turn |= guard.Facing
+
+		guard = Guard{Position: position, Facing: guard.Facing.Right()}
+	}
+}
@@ -5,9 +5,11 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day07
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"os"
 	"strings"
 
@@ -40,7 +42,7 @@ var Day07Cmd = &cobra.Command{
 		}
 
 		if fileContent != nil {
-			err = day(cmd, string(fileContent))
+			err = day(utilities.GetContext(cmd), utilities.GetLogger(cmd), string(fileContent))
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -64,8 +66,6 @@ type Operator struct {
 	f func(a, b int64) int64
 }
 
-var cmd *cobra.Command
-
 var concatOp = Operator{"||", func(a, b int64) int64 {
 	return utilities.Concatenate(a, b)
 }}
@@ -78,20 +78,16 @@ var multOp = Operator{"*", func(a, b int64) int64 {
 	return a * b
 }}
 
-func (e *Equation) EvaluateValidity(operators []Operator) bool {
+// EvaluateValidity tries each operator between each pair of numbers, logging every
+// equation it finishes at trace level.
+func (e *Equation) EvaluateValidity(logger *slog.Logger, operators []Operator) bool {
 
 	var evaluate func(equation string, requiredValue int64, currentValue int64, currentIndex int) bool
 
 	evaluate = func(equation string, requiredValue int64, currentValue int64, currentIndex int) bool {
 		// Reached the end of the numbers?
 		if currentIndex == len(e.Numbers) {
-			if utilities.GetVerbosity(cmd) > 2 {
-				if currentValue == requiredValue {
-					fmt.Printf("%d == %s\n", requiredValue, equation)
-				} else {
-					fmt.Printf("%d != %s (%d)\n", requiredValue, equation, currentValue)
-				}
-			}
+			logger.Log(context.Background(), utilities.LevelTrace, "evaluated", "equation", equation, "required", requiredValue, "value", currentValue)
 			return currentValue == requiredValue
 		}
 
@@ -138,26 +134,9 @@ func SprintEquation(e *Equation) string {
 	return str
 }
 
-func day(command *cobra.Command, fileContents string) error {
-	cmd = command
-
-	// Part 1: You ask how long it'll take; the engineers tell you that it only needs final calibrations,
-	// but some young elephants were playing nearby and stole all the operators from their calibration
-	// equations! They could finish the calibrations if only someone could determine which test values
-	// could possibly be produced by placing any combination of operators into their calibration equations
-	// (your puzzle input).
-	//
-	// Each line represents a single equation. The test value appears before the colon on each line; it is
-	// your job to determine whether the remaining numbers can be combined with operators to produce the test value.
-	//
-	// Operators are always evaluated left-to-right, not according to precedence rules. Furthermore, numbers
-	// in the equations cannot be rearranged. Glancing into the jungle, you can see elephants holding two
-	// different types of operators: add (+) and multiply (*).
-	//
-	// The engineers just need the total calibration result, which is the sum of the test values from just
-	// the equations that could possibly be true.
-	//
-	// Determine which equations could possibly be true. What is their total calibration result?
+func day(ctx context.Context, logger *slog.Logger, fileContents string) error {
+	// Part 1: Determine which equations could possibly be true. What is their total
+	// calibration result?
 
 	equations := make([]*Equation, 0)
 
@@ -167,50 +146,55 @@ func day(command *cobra.Command, fileContents string) error {
 		equations = append(equations, equation)
 	}
 
-	validEquations := make([]bool, len(equations))
+	// Equations evaluated side by side would interleave their traces.
+	if logger.Enabled(ctx, utilities.LevelTrace) {
+		ctx = utilities.WithWorkers(ctx, 1)
+	}
+
+	validEquations, err := utilities.ParallelMap(ctx, equations, func(equation *Equation) bool {
+		return equation.EvaluateValidity(logger, []Operator{addOp, multOp})
+	})
+	if err != nil {
+		return err
+	}
 
 	totalCalibrationResult := int64(0)
 
 	for i, equation := range equations {
-		if equation.EvaluateValidity([]Operator{addOp, multOp}) {
+		if validEquations[i] {
 			totalCalibrationResult += equation.TestValue
-			validEquations[i] = true
 		}
 	}
 
-	fmt.Printf("Total calibration result: %d\n", totalCalibrationResult)
+	utilities.Answer(1, "Total calibration result", totalCalibrationResult)
 
-	// Part 2: The engineers seem concerned; the total calibration result you gave them is nowhere close to
-	// being within safety tolerances. Just then, you spot your mistake: some well-hidden elephants are holding
-	// a third type of operator.
-	//
-	// The concatenation operator (||) combines the digits from its left and right inputs into a single number.
-	// For example, 12 || 345 would become 12345. All operators are still evaluated left-to-right.
-	//
-	// Using your new knowledge of elephant hiding spots, determine which equations could possibly be true.
-	// What is their total calibration result?
+	// Part 2: Using your new knowledge of elephant hiding spots, determine which equations
+	// could possibly be true. What is their total calibration result?
 
 	totalCalibrationConcatResult := int64(0)
 
+	validConcatEquations, err := utilities.ParallelMap(ctx, equations, func(equation *Equation) bool {
+		return equation.EvaluateValidity(logger, []Operator{concatOp, addOp, multOp})
+	})
+	if err != nil {
+		return err
+	}
+
 	for i, equation := range equations {
-		if equation.EvaluateValidity([]Operator{concatOp, addOp, multOp}) {
-			if utilities.GetVerbosity(cmd) > 1 {
-				if !validEquations[i] {
-					// This equation wasn't valid before but it is now with the additional concatenation operator,
-					// log it.
-					fmt.Printf("%snow valid\n", SprintEquation(equation))
-				}
+		if validConcatEquations[i] {
+			if !validEquations[i] {
+				// This equation wasn't valid before but it is now with the additional concatenation operator,
+				// log it.
+				logger.Log(ctx, utilities.LevelDetail, "now valid", "equation", SprintEquation(equation))
 			}
 
 			totalCalibrationConcatResult += int64(equation.TestValue)
 		} else {
-			if utilities.GetVerbosity(cmd) > 0 {
-				fmt.Printf("%snot valid\n", SprintEquation(equation))
-			}
+			logger.Debug("not valid", "equation", SprintEquation(equation))
 		}
 	}
 
-	fmt.Printf("Total calibration result with concatenation operator: %d\n", totalCalibrationConcatResult)
+	utilities.Answer(2, "Total calibration result with concatenation operator", totalCalibrationConcatResult)
 
 	return nil
 }
@@ -5,9 +5,13 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day07
 
 import (
+	"bytes"
+	"context"
 	"strings"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -110,7 +114,7 @@ func TestEvaluateValidity(t *testing.T) {
 
 	for _, test := range testCases {
 		e := ParseEquation(test.line)
-		assert.Equal(t, test.expectedValidity, e.EvaluateValidity([]Operator{addOp, multOp}))
+		assert.Equal(t, test.expectedValidity, e.EvaluateValidity(utilities.DiscardLogger, []Operator{addOp, multOp}))
 	}
 }
 
@@ -164,7 +168,7 @@ func TestEvaluateValidityConcatenate(t *testing.T) {
 
 	for _, test := range testCases {
 		e := ParseEquation(test.line)
-		assert.Equal(t, test.expectedValidity, e.EvaluateValidity([]Operator{addOp, multOp, concatOp}))
+		assert.Equal(t, test.expectedValidity, e.EvaluateValidity(utilities.DiscardLogger, []Operator{addOp, multOp, concatOp}))
 	}
 }
 
@@ -200,7 +204,7 @@ func TestTotalCalibration(t *testing.T) {
 		totalCalibrationResult := int64(0)
 
 		for _, equation := range equations {
-			if equation.EvaluateValidity([]Operator{addOp, multOp}) {
+			if equation.EvaluateValidity(utilities.DiscardLogger, []Operator{addOp, multOp}) {
 				totalCalibrationResult += equation.TestValue
 			}
 		}
@@ -241,7 +245,7 @@ func TestTotalCalibrationConcat(t *testing.T) {
 		totalCalibrationResult := int64(0)
 
 		for _, equation := range equations {
-			if equation.EvaluateValidity([]Operator{addOp, multOp, concatOp}) {
+			if equation.EvaluateValidity(utilities.DiscardLogger, []Operator{addOp, multOp, concatOp}) {
 				totalCalibrationResult += equation.TestValue
 			}
 		}
@@ -249,3 +253,26 @@ func TestTotalCalibrationConcat(t *testing.T) {
 		assert.Equal(t, test.expectedTotalCalibration, totalCalibrationResult)
 	}
 }
+
+func TestDayLogging(t *testing.T) {
+	example := "190: 10 19\n3267: 81 40 27\n83: 17 5\n156: 15 6\n7290: 6 8 6 15\n161011: 16 10 13\n192: 17 8 14\n21037: 9 7 18 13\n292: 11 6 16 20"
+
+	var out bytes.Buffer
+	logger := utilities.NewLogger(&out, utilities.LogOptions{Verbosity: 2})
+
+	_, err := utilities.CaptureOutput(func() { assert.NoError(t, day(context.Background(), logger, example)) })
+	assert.NoError(t, err)
+
+	assert.Equal(t, strings.Join([]string{
+		`DEBUG not valid equation="83: 17 5 "`,
+		`DETAIL now valid equation="156: 15 6 "`,
+		`DETAIL now valid equation="7290: 6 8 6 15 "`,
+		`DEBUG not valid equation="161011: 16 10 13 "`,
+		`DETAIL now valid equation="192: 17 8 14 "`,
+		`DEBUG not valid equation="21037: 9 7 18 13 "`,
+	}, "\n")+"\n", out.String())
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(context.Background(), utilities.DiscardLogger, input) })
+}
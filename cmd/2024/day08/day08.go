@@ -5,9 +5,11 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day08
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"math"
 	"os"
 	"strings"
@@ -38,7 +40,7 @@ var Day08Cmd = &cobra.Command{
 		}
 
 		if fileContent != nil {
-			err = day(cmd, string(fileContent))
+			err = day(utilities.GetLogger(cmd), string(fileContent))
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -55,8 +57,6 @@ type AntennaMap struct {
 	Antinodes AntinodeLocations
 }
 
-var cmd *cobra.Command
-
 func GenerateCollinearLocations(pointA utilities.Point2D, pointB utilities.Point2D, bounds utilities.Size2D) []utilities.Point2D {
 
 	m, b := utilities.CalculateSlopeIntercept(pointA, pointB)
@@ -81,7 +81,7 @@ func GenerateCollinearLocations(pointA utilities.Point2D, pointB utilities.Point
 	return collinearLocations
 }
 
-func ParseAntennaMap(fileContents string, harmonics bool) *AntennaMap {
+func ParseAntennaMap(logger *slog.Logger, fileContents string, harmonics bool) *AntennaMap {
 	antennaMap := &AntennaMap{}
 
 	antennaMap.Antennas = make(AntennaLocations)
@@ -112,9 +112,7 @@ func ParseAntennaMap(fileContents string, harmonics bool) *AntennaMap {
 			// Generate collinear points.
 			collinearPoints := GenerateCollinearLocations(pair.One, pair.Two, antennaMap.Bounds)
 
-			if utilities.GetVerbosity(cmd) > 0 {
-				fmt.Printf("Collinear points for (%d,%d) (%d,%d): %# v\n", pair.One.X, pair.One.Y, pair.Two.X, pair.Two.Y, pretty.Formatter(collinearPoints))
-			}
+			logger.Debug("collinear points", "one", pair.One, "two", pair.Two, "points", collinearPoints)
 
 			// Find antinode locations
 			for i := 0; i < len(collinearPoints); i++ {
@@ -126,18 +124,14 @@ func ParseAntennaMap(fileContents string, harmonics bool) *AntennaMap {
 						// This is the first point of pair.
 						// First antinode is the previous collinear point.
 						if i > 0 {
-							if utilities.GetVerbosity(cmd) > 0 {
-								fmt.Printf("Antinode location: (%d,%d)\n", collinearPoints[i-1].X, collinearPoints[i-1].Y)
-							}
+							logger.Debug("antinode", "location", collinearPoints[i-1])
 							antennaMap.Antinodes[collinearPoints[i-1]] = true
 						}
 					} else if point == pair.Two {
 						// This is the second point of pair.
 						// Second antinode is the subsequent collinear point.
 						if i < len(collinearPoints)-1 {
-							if utilities.GetVerbosity(cmd) > 0 {
-								fmt.Printf("Antinode location: (%d,%d)\n", collinearPoints[i+1].X, collinearPoints[i+1].Y)
-							}
+							logger.Debug("antinode", "location", collinearPoints[i+1])
 							antennaMap.Antinodes[collinearPoints[i+1]] = true
 						}
 						break
@@ -150,54 +144,28 @@ func ParseAntennaMap(fileContents string, harmonics bool) *AntennaMap {
 	return antennaMap
 }
 
-func day(command *cobra.Command, fileContents string) error {
-	cmd = command
-
-	// Part 1: While The Historians do their thing, you take a look at the familiar huge
-	// antenna. Much to your surprise, it seems to have been reconfigured to emit a signal
-	// that makes people 0.1% more likely to buy Easter Bunny brand Imitation Mediocre
-	// Chocolate as a Christmas gift! Unthinkable!
-	//
-	// Scanning across the city, you find that there are actually many such antennas. Each
-	// antenna is tuned to a specific frequency indicated by a single lowercase letter,
-	// uppercase letter, or digit.
-	//
-	// The signal only applies its nefarious effect at specific antinodes based on the resonant
-	// frequencies of the antennas. In particular, an antinode occurs at any point that is
-	// perfectly in line with two antennas of the same frequency - but only when one of the
-	// antennas is twice as far away as the other. This means that for any pair of antennas with
-	// the same frequency, there are two antinodes, one on either side of them.
-	//
-	// Calculate the impact of the signal. How many unique locations within the bounds of the map contain an antinode?
-
-	antennaMap := ParseAntennaMap(fileContents, false)
-
-	if utilities.GetVerbosity(cmd) > 0 {
-		fmt.Printf("%# v\n", pretty.Formatter(antennaMap))
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Calculate the impact of the signal. How many unique locations within the bounds
+	// of the map contain an antinode?
+
+	antennaMap := ParseAntennaMap(logger, fileContents, false)
+
+	if logger.Enabled(context.Background(), utilities.LevelVerbose) {
+		logger.Debug("parsed map", "map", fmt.Sprintf("%# v", pretty.Formatter(antennaMap)))
 	}
 
-	fmt.Printf("Number of unique antinode locations: %d\n", len(antennaMap.Antinodes))
-
-	// Part 2: Watching over your shoulder as you work, one of The Historians asks if you took
-	// the effects of resonant harmonics into your calculations.
-	//
-	// Whoops!
-	//
-	// After updating your model, it turns out that an antinode occurs at any grid position exactly in
-	// line with at least two antennas of the same frequency, regardless of distance. This means that
-	// some of the new antinodes will occur at the position of each antenna (unless that antenna is the
-	// only one of its frequency).
-	//
-	// Calculate the impact of the signal using this updated model. How many unique locations within the
-	// bounds of the map contain an antinode?
-
-	antennaMapHarmonics := ParseAntennaMap(fileContents, true)
-
-	if utilities.GetVerbosity(cmd) > 0 {
-		fmt.Printf("%# v\n", pretty.Formatter(antennaMapHarmonics))
+	utilities.Answer(1, "Number of unique antinode locations", len(antennaMap.Antinodes))
+
+	// Part 2: Calculate the impact of the signal using this updated model. How many unique
+	// locations within the bounds of the map contain an antinode?
+
+	antennaMapHarmonics := ParseAntennaMap(logger, fileContents, true)
+
+	if logger.Enabled(context.Background(), utilities.LevelVerbose) {
+		logger.Debug("parsed map with harmonics", "map", fmt.Sprintf("%# v", pretty.Formatter(antennaMapHarmonics)))
 	}
 
-	fmt.Printf("Number of unique antinode locations accounting for harmonics: %d\n", len(antennaMapHarmonics.Antinodes))
+	utilities.Answer(2, "Number of unique antinode locations accounting for harmonics", len(antennaMapHarmonics.Antinodes))
 
 	return nil
 }
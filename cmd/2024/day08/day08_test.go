@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -61,7 +62,7 @@ func TestParseAntennaMap(t *testing.T) {
 	}
 
 	for _, test := range testCases {
-		antennaMap := ParseAntennaMap(test.text, false)
+		antennaMap := ParseAntennaMap(utilities.DiscardLogger, test.text, false)
 		assert.Equal(t, test.expectedBounds, antennaMap.Bounds)
 		assert.True(t, reflect.DeepEqual(test.expectedAntennas, antennaMap.Antennas))
 		assert.True(t, reflect.DeepEqual(test.expectedAntinodes, antennaMap.Antinodes))
@@ -137,7 +138,7 @@ func TestParseAntennaMapHarmonics(t *testing.T) {
 	}
 
 	for _, test := range testCases {
-		antennaMap := ParseAntennaMap(test.text, true)
+		antennaMap := ParseAntennaMap(utilities.DiscardLogger, test.text, true)
 		assert.Equal(t, test.expectedBounds, antennaMap.Bounds)
 		assert.True(t, reflect.DeepEqual(test.expectedAntennas, antennaMap.Antennas))
 		assert.True(t, reflect.DeepEqual(test.expectedAntinodes, antennaMap.Antinodes))
@@ -173,3 +174,7 @@ func TestGenerateCollinearLocations(t *testing.T) {
 		assert.True(t, reflect.DeepEqual(test.expectedLocations, GenerateCollinearLocations(test.pointA, test.pointB, test.bounds)))
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
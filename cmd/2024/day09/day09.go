@@ -5,12 +5,16 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day09
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"os"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/render"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -32,7 +36,7 @@ var Day09Cmd = &cobra.Command{
 		}
 
 		if fileContent != nil {
-			err = day(string(fileContent))
+			err = day(utilities.GetLogger(cmd), string(fileContent))
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -73,6 +77,26 @@ func (d *Disk) Describe() string {
 	return str
 }
 
+// Render draws the disk like Describe, with each file in its own colour so the
+// files that were split up by compaction stand out.
+func (d *Disk) Render(terminal *render.Terminal[int]) string {
+	terminal.Set(Unallocated, color.FgHiBlack)
+
+	for _, f := range d.Files {
+		terminal.Set(f.ID, render.Distinct(f.ID))
+	}
+
+	return terminal.Draw(utilities.NewSize2D(len(d.BlockAllocations), 1), func(p utilities.Point2D) string {
+		if d.BlockAllocations[p.X] == Unallocated {
+			return "."
+		}
+
+		return fmt.Sprintf("%d", d.BlockAllocations[p.X])
+	}, func(p utilities.Point2D) int {
+		return d.BlockAllocations[p.X]
+	})
+}
+
 func (d *Disk) CompactBlocks() {
 
 	getNextFreeBlock := func(currentFreeBlock int) (bool, int) {
@@ -301,54 +325,31 @@ func ParseDisk(fileContent string) *Disk {
 	return disk
 }
 
-func day(fileContents string) error {
-	// Part 1: While The Historians quickly figure out how to pilot these things, you notice an amphipod
-	// in the corner struggling with his computer. He's trying to make more contiguous free space by
-	// compacting all of the files, but his program isn't working; you offer to help.
-	//
-	// The disk map uses a dense format to represent the layout of files and free space on the disk. The
-	// digits alternate between indicating the length of a file and the length of free space.
-	//
-	// So, a disk map like 12345 would represent a one-block file, two blocks of free space, a three-block
-	// file, four blocks of free space, and then a five-block file. A disk map like 90909 would represent
-	// three nine-block files in a row (with no free space between them).
-	//
-	// Each file on disk also has an ID number based on the order of the files as they appear before they are
-	// rearranged, starting with ID 0. So, the disk map 12345 has three files: a one-block file with ID 0, a
-	// three-block file with ID 1, and a five-block file with ID 2.
-	//
-	// The amphipod would like to move file blocks one at a time from the end of the disk to the leftmost free
-	// space block (until there are no gaps remaining between file blocks).
-	//
-	// The final step of this file-compacting process is to update the filesystem checksum. To calculate the
-	// checksum, add up the result of multiplying each of these blocks' position with the file ID number it
-	// contains. The leftmost block is in position 0. If a block contains free space, skip it instead.
-	//
-	// Compact the amphipod's hard drive using the process he requested. What is the resulting filesystem checksum?
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Compact the amphipod's hard drive using the process he requested. What is the
+	// resulting filesystem checksum?
 
 	disk := ParseDisk(fileContents)
 	disk.CompactBlocks()
 	checksum := disk.CalculateChecksum()
 
-	fmt.Printf("Filesystem checksum after block compaction: %d\n", checksum)
+	logger.Log(context.Background(), utilities.LevelDetail, "compacted blocks", "disk", utilities.Lazy(func() any { return disk.Render(render.NewTerminal[int]()) }))
 
-	// Part 2: Upon completion, two things immediately become clear. First, the disk definitely has a lot more
-	// contiguous free space, just like the amphipod hoped. Second, the computer is running much more slowly!
-	// Maybe introducing all of that file system fragmentation was a bad idea?
-	//
-	// The eager amphipod already has a new plan: rather than move individual blocks, he'd like to try compacting
-	// the files on his disk by moving whole files instead.
-	//
-	// This time, attempt to move whole files to the leftmost span of free space blocks that could fit the file.
-	// Attempt to move each file exactly once in order of decreasing file ID number starting with the file with the
-	// highest file ID number. If there is no span of free space to the left of a file that is large enough to fit the
-	// file, the file does not move.
+	utilities.Answer(1, "Filesystem checksum after block compaction", checksum)
+
+	// Part 2: This time, attempt to move whole files to the leftmost span of free space blocks
+	// that could fit the file. Attempt to move each file exactly once in order of decreasing
+	// file ID number starting with the file with the highest file ID number. If there is no
+	// span of free space to the left of a file that is large enough to fit the file, the file
+	// does not move.
 
 	disk2 := ParseDisk(fileContents)
 	disk2.CompactFiles()
 	checksum2 := disk2.CalculateChecksum()
 
-	fmt.Printf("Filesystem checksum after file compaction: %d\n", checksum2)
+	logger.Log(context.Background(), utilities.LevelDetail, "compacted files", "disk", utilities.Lazy(func() any { return disk2.Render(render.NewTerminal[int]()) }))
+
+	utilities.Answer(2, "Filesystem checksum after file compaction", checksum2)
 
 	return nil
 }
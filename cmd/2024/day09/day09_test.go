@@ -5,8 +5,12 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day09
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/d1r7y/adventofcode/utilities/render"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -223,3 +227,23 @@ func TestCalculateChecksumFiles(t *testing.T) {
 		assert.Equal(t, test.expectedChecksum, disk.CalculateChecksum())
 	}
 }
+
+func TestRender(t *testing.T) {
+	disk := ParseDisk("12345")
+	terminal := render.NewTerminal[int]()
+
+	terminal.Enabled = false
+	assert.Equal(t, disk.Describe(), disk.Render(terminal))
+
+	terminal.Enabled = true
+
+	paint := func(id int, text string) string {
+		return fmt.Sprintf("\x1b[%dm%s\x1b[0m", render.Distinct(id), text)
+	}
+
+	assert.Equal(t, paint(0, "0")+"\x1b[90m..\x1b[0m"+paint(1, "111")+"\x1b[90m....\x1b[0m"+paint(2, "22222"), disk.Render(terminal))
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
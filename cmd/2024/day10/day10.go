@@ -5,7 +5,6 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day10
 
 import (
-	"fmt"
 	"io"
 	"log"
 	"os"
@@ -224,24 +223,7 @@ func ParseTopoMap(fileContents string) *TopoMap {
 }
 
 func day(fileContents string) error {
-	// Part 1: The topographic map indicates the height at each position using a scale from
-	// 0 (lowest) to 9 (highest).
-	//
-	// Based on un-scorched scraps of the book, you determine that a good hiking trail is as long
-	// as possible and has an even, gradual, uphill slope. For all practical purposes, this means
-	// that a hiking trail is any path that starts at height 0, ends at height 9, and always
-	// increases by a height of exactly 1 at each step. Hiking trails never include diagonal
-	// steps - only up, down, left, or right (from the perspective of the map).
-	//
-	// You look up from the map and notice that the reindeer has helpfully begun to construct a small
-	// pile of pencils, markers, rulers, compasses, stickers, and other equipment you might need to
-	// update the map with hiking trails.
-	//
-	// A trailhead is any position that starts one or more hiking trails - here, these positions will
-	// always have height 0. Assembling more fragments of pages, you establish that a trailhead's score
-	// is the number of 9-height positions reachable from that trailhead via a hiking trail.
-	//
-	// What is the sum of the scores of all trailheads on your topographic map?
+	// Part 1: What is the sum of the scores of all trailheads on your topographic map?
 
 	topoMap := ParseTopoMap(fileContents)
 
@@ -253,17 +235,11 @@ func day(fileContents string) error {
 		totalTrailheadScores += score
 	}
 
-	fmt.Printf("Sum of scores of all trailheads: %d\n", totalTrailheadScores)
+	utilities.Answer(1, "Sum of scores of all trailheads", totalTrailheadScores)
 
-	// Part 2: The reindeer spends a few minutes reviewing your hiking trail map before realizing something,
-	// disappearing for a few minutes, and finally returning with yet another slightly-charred piece of paper.
-	//
-	// The paper describes a second way to measure a trailhead called its rating. A trailhead's rating is the
-	// number of distinct hiking trails which begin at that trailhead.
-	//
-	// You're not sure how, but the reindeer seems to have crafted some tiny flags out of toothpicks and bits of
-	// paper and is using them to mark trailheads on your topographic map. What is the sum of the ratings of all
-	// trailheads?
+	// Part 2: You're not sure how, but the reindeer seems to have crafted some tiny flags out
+	// of toothpicks and bits of paper and is using them to mark trailheads on your topographic
+	// map. What is the sum of the ratings of all trailheads?
 
 	trailHeadRatings := topoMap.HikeRatings()
 
@@ -273,7 +249,7 @@ func day(fileContents string) error {
 		totalTrailheadRatings += rating
 	}
 
-	fmt.Printf("Sum of ratings of all trailheads: %d\n", totalTrailheadRatings)
+	utilities.Answer(2, "Sum of ratings of all trailheads", totalTrailheadRatings)
 
 	return nil
 }
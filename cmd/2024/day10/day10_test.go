@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -166,3 +167,7 @@ func TestHikeRatings(t *testing.T) {
 		assert.Equal(t, test.expectedTotalRatings, totalTrailheadRatings)
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, day)
+}
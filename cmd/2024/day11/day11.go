@@ -5,6 +5,7 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day11
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
@@ -40,7 +41,7 @@ var Day11Cmd = &cobra.Command{
 			fileContents = string(fileBytes)
 		}
 
-		err := day(string(fileContents))
+		err := day(utilities.GetContext(cmd), string(fileContents))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -110,41 +111,9 @@ func ParseStones(fileContents string) *StoneList {
 	return stoneList
 }
 
-func day(fileContents string) error {
-	// Part 1: The ancient civilization on Pluto was known for its ability to manipulate
-	// spacetime, and while The Historians explore their infinite corridors, you've
-	// noticed a strange set of physics-defying stones.
-	//
-	// At first glance, they seem like normal stones: they're arranged in a perfectly
-	// straight line, and each stone has a number engraved on it.
-	//
-	// The strange part is that every time you blink, the stones change.
-	//
-	// Sometimes, the number engraved on a stone changes. Other times, a stone might split
-	// in two, causing all the other stones to shift over a bit to make room in their perfectly
-	// straight line.
-	//
-	// As you observe them for a while, you find that the stones have a consistent behavior.
-	// Every time you blink, the stones each simultaneously change according to the first
-	// applicable rule in this list:
-	//
-	//	- If the stone is engraved with the number 0, it is replaced by a stone engraved with the
-	//		number 1.
-	//	- If the stone is engraved with a number that has an even number of digits, it is replaced
-	//		by two stones. The left half of the digits are engraved on the new left stone, and the
-	//		right half of the digits are engraved on the new right stone. (The new numbers don't keep
-	//		extra leading zeroes: 1000 would become stones 10 and 0.)
-	//	- If none of the other rules apply, the stone is replaced by a new stone; the old stone's
-	//		number multiplied by 2024 is engraved on the new stone.
-	//
-	// No matter how the stones change, their order is preserved, and they stay on their perfectly
-	// straight line.
-	//
-	// How will the stones evolve if you keep blinking at them? You take a note of the number engraved
-	// on each stone in the line (your puzzle input).
-	//
-	// Consider the arrangement of stones in front of you. How many stones will you have after
-	// blinking 25 times?
+func day(ctx context.Context, fileContents string) error {
+	// Part 1: Consider the arrangement of stones in front of you. How many stones will you
+	// have after blinking 25 times?
 
 	if analytics {
 		if startingStones != "" {
@@ -179,15 +148,19 @@ func day(fileContents string) error {
 			stoneList.Blink()
 		}
 
-		fmt.Printf("Stone list size after 25 blinks: %d\n", len(stoneList.Stones))
+		utilities.Answer(1, "Stone list size after 25 blinks", len(stoneList.Stones))
 
 		stoneList2 := ParseStones(fileContents)
 
 		for i := 0; i < 75; i++ {
+			if err := utilities.Interrupted(ctx); err != nil {
+				return fmt.Errorf("stopped after %d of 75 blinks with %d stones: %w", i, len(stoneList2.Stones), err)
+			}
+
 			stoneList2.Blink()
 		}
 
-		fmt.Printf("Stone list size after 75 blinks: %d\n", len(stoneList2.Stones))
+		utilities.Answer(2, "Stone list size after 75 blinks", len(stoneList2.Stones))
 	}
 
 	return nil
@@ -5,13 +5,15 @@ Copyright © 2021-2024 Cameron Esfahani
 package TwentyTwentyFour_day12
 
 import (
-	"fmt"
+	"context"
 	"io"
 	"log"
+	"log/slog"
 	"os"
 	"strings"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/render"
 	"github.com/spf13/cobra"
 )
 
@@ -40,7 +42,7 @@ var Day12Cmd = &cobra.Command{
 			fileContents = string(fileBytes)
 		}
 
-		err := day(string(fileContents))
+		err := day(utilities.GetLogger(cmd), string(fileContents))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -171,6 +173,29 @@ func (m *Map) RegionPerimeterSides(regionID int) int {
 	return perimeter
 }
 
+// Render draws the map with each region in its own colour, so neighbouring
+// regions of the same plant elsewhere on the map are easy to tell apart.
+func (m *Map) Render(terminal *render.Terminal[int]) string {
+	regionIDs := make([][]int, m.Bounds.Height)
+	for y := range regionIDs {
+		regionIDs[y] = make([]int, m.Bounds.Width)
+	}
+
+	for id, region := range m.Regions {
+		terminal.Set(id, render.Distinct(id))
+
+		for p := range region.Plots.All() {
+			regionIDs[p.Y][p.X] = id
+		}
+	}
+
+	return terminal.Draw(m.Bounds, func(p utilities.Point2D) string {
+		return string(m.GetPlant(p))
+	}, func(p utilities.Point2D) int {
+		return regionIDs[p.Y][p.X]
+	})
+}
+
 func ParseMap(fileContents string) *Map {
 	gardenMap := &Map{}
 
@@ -277,41 +302,24 @@ func ParseMap(fileContents string) *Map {
 	return gardenMap
 }
 
-func day(fileContents string) error {
-	// Part 1: You're about to settle near a complex arrangement of garden plots when some
-	// Elves ask if you can lend a hand. They'd like to set up fences around each region of
-	// garden plots, but they can't figure out how much fence they need to order or how much
-	// it will cost. They hand you a map (your puzzle input) of the garden plots.
-	//
-	// Each garden plot grows only a single type of plant and is indicated by a single letter
-	// on your map. When multiple garden plots are growing the same type of plant and are
-	// touching (horizontally or vertically), they form a region.
-	//
-	// In order to accurately calculate the cost of the fence around a single region, you
-	// need to know that region's area and perimeter.
-	//
-	// Due to "modern" business practices, the price of fence required for a region is found
-	// by multiplying that region's area by its perimeter. The total price of fencing all
-	// regions on a map is found by adding together the price of fence for every region on the map.
-	//
-	// What is the total price of fencing all regions on your map?
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: What is the total price of fencing all regions on your map?
 
 	gardenMap := ParseMap(fileContents)
 
+	logger.Log(context.Background(), utilities.LevelDetail, "regions", "garden", utilities.Lazy(func() any { return gardenMap.Render(render.NewTerminal[int]()) }))
+
 	totalFencingPrice := 0
 
 	for i := 0; i < gardenMap.NumRegions(); i++ {
 		totalFencingPrice += gardenMap.RegionFencingPrice(i)
 	}
 
-	fmt.Printf("Total cost of fencing: %d\n", totalFencingPrice)
+	utilities.Answer(1, "Total cost of fencing", totalFencingPrice)
 
-	// Part 2: Fortunately, the Elves are trying to order so much fence that they qualify for a
-	// bulk discount!
-	//
-	// Under the bulk discount, instead of using the perimeter to calculate the price, you need to
-	// use the number of sides each region has. Each straight section of fence counts as a side,
-	// regardless of how long it is.
+	// Part 2: Under the bulk discount, instead of using the perimeter to calculate the price,
+	// you need to use the number of sides each region has. Each straight section of fence
+	// counts as a side, regardless of how long it is.
 
 	totalFencingPriceBulkDiscount := 0
 
@@ -319,7 +327,7 @@ func day(fileContents string) error {
 		totalFencingPriceBulkDiscount += gardenMap.RegionFencingPriceBulkDiscount(i)
 	}
 
-	fmt.Printf("Total cost of fencing with bulk discount: %d\n", totalFencingPriceBulkDiscount)
+	utilities.Answer(2, "Total cost of fencing with bulk discount", totalFencingPriceBulkDiscount)
 
 	return nil
 }
@@ -7,9 +7,12 @@ package TwentyTwentyFour_day12
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/d1r7y/adventofcode/utilities/render"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -495,3 +498,34 @@ AAAAAA`,
 		assert.Equal(t, test.expectedTotalFencingPrice, totalFencingPrice)
 	}
 }
+
+func TestRender(t *testing.T) {
+	text := `OOOOO
+OXOXO
+OOOOO
+OXOXO
+OOOOO`
+
+	gardenMap := ParseMap(text)
+
+	terminal := render.NewTerminal[int]()
+
+	terminal.Enabled = false
+	assert.Equal(t, text, gardenMap.Render(terminal))
+
+	terminal.Enabled = true
+	rendered := strings.Split(gardenMap.Render(terminal), "\n")
+
+	// The O region surrounds four X regions, and each of them has its own colour.
+	paint := func(id int, text string) string {
+		return fmt.Sprintf("\x1b[%dm%s\x1b[0m", render.Distinct(id), text)
+	}
+
+	assert.Equal(t, paint(0, "OOOOO"), rendered[0])
+	assert.Equal(t, paint(0, "O")+paint(1, "X")+paint(0, "O")+paint(2, "X")+paint(0, "O"), rendered[1])
+	assert.Equal(t, paint(0, "O")+paint(3, "X")+paint(0, "O")+paint(4, "X")+paint(0, "O"), rendered[3])
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"math"
 	"os"
 	"regexp"
@@ -43,7 +44,7 @@ var Day13Cmd = &cobra.Command{
 			fileContents = string(fileBytes)
 		}
 
-		err := day(cmd, string(fileContents))
+		err := day(utilities.GetLogger(cmd), string(fileContents))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -148,34 +149,9 @@ func ParseClawMachines(fileContents string, correctPrizePosition bool) []ClawMac
 	return machines
 }
 
-func day(command *cobra.Command, fileContents string) error {
-	// Part 1: Next up: the lobby of a resort on a tropical island. The Historians
-	// take a moment to admire the hexagonal floor tiles before spreading out.
-	//
-	// Fortunately, it looks like the resort has a new arcade! Maybe you can win some
-	// prizes from the claw machines?
-	//
-	// The claw machines here are a little unusual. Instead of a joystick or directional
-	// buttons to control the claw, these machines have two buttons labeled A and B. Worse,
-	// you can't just put in a token and play; it costs 3 tokens to push the A button and
-	// 1 token to push the B button.
-	//
-	// With a little experimentation, you figure out that each machine's buttons are configured
-	// to move the claw a specific amount to the right (along the X axis) and a specific
-	// amount forward (along the Y axis) each time that button is pressed.
-	//
-	// Each machine contains one prize; to win the prize, the claw must be positioned exactly
-	// above the prize on both the X and Y axes.
-	//
-	// You wonder: what is the smallest number of tokens you would have to spend to win as many
-	// prizes as possible? You assemble a list of every machine's button behavior and prize
-	// location (your puzzle input).
-	//
-	// You estimate that each button would need to be pressed no more than 100 times to win a
-	// prize. How else would someone be expected to play?
-	//
-	// Figure out how to win as many prizes as possible. What is the fewest tokens you would
-	// have to spend to win all possible prizes?
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Figure out how to win as many prizes as possible. What is the fewest tokens you
+	// would have to spend to win all possible prizes?
 
 	machines := ParseClawMachines(fileContents, false)
 
@@ -185,17 +161,9 @@ func day(command *cobra.Command, fileContents string) error {
 		totalWinnablePrizeCost += m.WinningPrizeCost()
 	}
 
-	fmt.Printf("Fewest number of tokens spent to win all possible prizes: %d\n", totalWinnablePrizeCost)
+	utilities.Answer(1, "Fewest number of tokens spent to win all possible prizes", totalWinnablePrizeCost)
 
-	// Part 2: As you go to win the first prize, you discover that the claw is nowhere near
-	// where you expected it would be. Due to a unit conversion error in your measurements,
-	// the position of every prize is actually 10000000000000 higher on both the X and Y axis!
-	//
-	// Add 10000000000000 to the X and Y position of every prize.
-	//
-	// Using the corrected prize coordinates, figure out how to win as many prizes as possible.
-	//
-	// What is the fewest tokens you would have to spend to win all possible prizes?
+	// Part 2: What is the fewest tokens you would have to spend to win all possible prizes?
 
 	machinesCorrected := ParseClawMachines(fileContents, true)
 
@@ -216,18 +184,14 @@ func day(command *cobra.Command, fileContents string) error {
 			solvability = "Solvable"
 		}
 
-		if utilities.GetVerbosity(command) > 0 {
-			fmt.Printf("%s: %dx + %dy = %d; %dx + %dy = %d\n", solvability, m.MovementA.X, m.MovementB.X, m.PrizeLocation.X, m.MovementA.Y, m.MovementB.Y, m.PrizeLocation.Y)
-		}
+		logger.Debug(solvability, "x", fmt.Sprintf("%dx + %dy = %d", m.MovementA.X, m.MovementB.X, m.PrizeLocation.X), "y", fmt.Sprintf("%dx + %dy = %d", m.MovementA.Y, m.MovementB.Y, m.PrizeLocation.Y))
 
 		totalWinnablePrizeCostCorrected += cost
 	}
 
-	if utilities.GetVerbosity(command) > 0 {
-		fmt.Printf("%d unsolvable with corrected prize coordinates\n", totalUnsolvable)
-	}
+	logger.Debug("corrected prize coordinates", "unsolvable", totalUnsolvable)
 
-	fmt.Printf("Fewest number of tokens spent to win all possible prizes with corrected prize coordinates: %d\n", totalWinnablePrizeCostCorrected)
+	utilities.Answer(2, "Fewest number of tokens spent to win all possible prizes with corrected prize coordinates", totalWinnablePrizeCostCorrected)
 
 	return nil
 }
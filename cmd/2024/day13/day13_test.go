@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -355,3 +356,7 @@ Prize: X=18641, Y=10279`,
 		assert.Equal(t, test.expectedTotalWinnablePrizeCost, totalWinnablePrizeCost)
 	}
 }
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
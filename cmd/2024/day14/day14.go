@@ -0,0 +1,271 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day14
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day14Cmd represents the day14 command
+var Day14Cmd = &cobra.Command{
+	Use:   "day14",
+	Short: `Restroom Redoubt`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	BathroomWidth  = 101
+	BathroomHeight = 103
+	SafetySeconds  = 100
+
+	// ChristmasTreeThreshold is the fraction of robots that need a neighbouring
+	// robot before a frame is considered a picture rather than noise.
+	ChristmasTreeThreshold = 0.5
+)
+
+type Robot struct {
+	Position utilities.Point2D
+	Velocity utilities.Point2D
+}
+
+type Bathroom struct {
+	Bounds utilities.Size2D
+	Robots []Robot
+}
+
+func ParseRobots(fileContents string) ([]Robot, error) {
+	robotRE := regexp.MustCompile(`^p=(-?[0-9]+),(-?[0-9]+) v=(-?[0-9]+),(-?[0-9]+)$`)
+
+	robots := make([]Robot, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		matches := robotRE.FindStringSubmatch(strings.TrimSpace(line))
+		if matches == nil {
+			return nil, fmt.Errorf("invalid robot '%s'", line)
+		}
+
+		values := make([]int, 4)
+		for i := range values {
+			v, err := strconv.Atoi(matches[i+1])
+			if err != nil {
+				return nil, err
+			}
+
+			values[i] = v
+		}
+
+		robots = append(robots, Robot{
+			Position: utilities.NewPoint2D(values[0], values[1]),
+			Velocity: utilities.NewPoint2D(values[2], values[3]),
+		})
+	}
+
+	return robots, nil
+}
+
+func NewBathroom(bounds utilities.Size2D, robots []Robot) (*Bathroom, error) {
+	for _, r := range robots {
+		if r.Position.X < 0 || r.Position.X >= bounds.Width || r.Position.Y < 0 || r.Position.Y >= bounds.Height {
+			return nil, fmt.Errorf("robot at %d,%d is outside the %dx%d bathroom", r.Position.X, r.Position.Y, bounds.Width, bounds.Height)
+		}
+	}
+
+	return &Bathroom{Bounds: bounds, Robots: robots}, nil
+}
+
+func wrap(value, size int) int {
+	return ((value % size) + size) % size
+}
+
+// PositionsAfter returns where every robot is after the given number of seconds.
+// Robots teleport to the other side of the bathroom when they walk off an edge, so
+// their position is just their straight line motion wrapped around.
+func (b *Bathroom) PositionsAfter(seconds int) []utilities.Point2D {
+	positions := make([]utilities.Point2D, 0, len(b.Robots))
+
+	for _, r := range b.Robots {
+		positions = append(positions, utilities.NewPoint2D(
+			wrap(r.Position.X+r.Velocity.X*seconds, b.Bounds.Width),
+			wrap(r.Position.Y+r.Velocity.Y*seconds, b.Bounds.Height)))
+	}
+
+	return positions
+}
+
+// SafetyFactor multiplies the number of robots in each quadrant after the given
+// number of seconds. Robots exactly on the middle row or column don't count.
+func (b *Bathroom) SafetyFactor(seconds int) int {
+	middleX := b.Bounds.Width / 2
+	middleY := b.Bounds.Height / 2
+
+	var quadrants [4]int
+
+	for _, p := range b.PositionsAfter(seconds) {
+		if p.X == middleX || p.Y == middleY {
+			continue
+		}
+
+		q := 0
+		if p.X > middleX {
+			q |= 1
+		}
+		if p.Y > middleY {
+			q |= 2
+		}
+
+		quadrants[q]++
+	}
+
+	return quadrants[0] * quadrants[1] * quadrants[2] * quadrants[3]
+}
+
+// ClusterScore is the fraction of robots with another robot directly next to
+// them. Random noise scores low; a picture drawn with the robots scores high.
+func ClusterScore(positions []utilities.Point2D) float64 {
+	if len(positions) == 0 {
+		return 0
+	}
+
+	occupied := utilities.NewSetPoint2D()
+	for _, p := range positions {
+		occupied.Add(p)
+	}
+
+	clustered := 0
+
+	for _, p := range positions {
+		if occupied.Exists(p.Up()) || occupied.Exists(p.Down()) || occupied.Exists(p.Left()) || occupied.Exists(p.Right()) {
+			clustered++
+		}
+	}
+
+	return float64(clustered) / float64(len(positions))
+}
+
+// FindChristmasTree searches every distinct frame for the one where the robots are
+// most clustered together. The robots' positions repeat after width × height
+// seconds, so that is as far as the search needs to go.
+func (b *Bathroom) FindChristmasTree() (int, error) {
+	bestSeconds := 0
+	bestScore := -1.0
+
+	for seconds := 0; seconds < b.Bounds.Width*b.Bounds.Height; seconds++ {
+		score := ClusterScore(b.PositionsAfter(seconds))
+
+		if score > bestScore {
+			bestSeconds = seconds
+			bestScore = score
+		}
+	}
+
+	if bestScore < ChristmasTreeThreshold {
+		return 0, fmt.Errorf("no frame looks like a picture (best cluster score %.2f)", bestScore)
+	}
+
+	return bestSeconds, nil
+}
+
+func (b *Bathroom) Describe(seconds int) string {
+	counts := make(map[utilities.Point2D]int)
+	for _, p := range b.PositionsAfter(seconds) {
+		counts[p]++
+	}
+
+	rows := make([]string, 0, b.Bounds.Height)
+
+	for y := 0; y < b.Bounds.Height; y++ {
+		var row strings.Builder
+
+		for x := 0; x < b.Bounds.Width; x++ {
+			count := counts[utilities.NewPoint2D(x, y)]
+
+			switch {
+			case count == 0:
+				row.WriteRune('.')
+			case count > 9:
+				row.WriteRune('*')
+			default:
+				row.WriteString(strconv.Itoa(count))
+			}
+		}
+
+		rows = append(rows, row.String())
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	return solve(logger, fileContents, utilities.NewSize2D(BathroomWidth, BathroomHeight))
+}
+
+// solve answers both parts for a bathroom of the given size. The puzzle's example
+// is only 11 tiles wide and 7 tall.
+func solve(logger *slog.Logger, fileContents string, bounds utilities.Size2D) error {
+	// Part 1: Predict the motion of the robots in your list within a space which is 101 tiles
+	// wide and 103 tiles tall. What will the safety factor be after exactly 100 seconds have
+	// elapsed?
+
+	robots, err := ParseRobots(fileContents)
+	if err != nil {
+		return err
+	}
+
+	bathroom, err := NewBathroom(bounds, robots)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(1, fmt.Sprintf("Safety factor after %d seconds", SafetySeconds), bathroom.SafetyFactor(SafetySeconds))
+
+	// Part 2: What is the fewest number of seconds that must elapse for the robots to display
+	// the Easter egg?
+
+	seconds, err := bathroom.FindChristmasTree()
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("easter egg", "bathroom", utilities.Lazy(func() any { return bathroom.Describe(seconds) }))
+
+	utilities.Answer(2, "Fewest seconds until the Easter egg", seconds)
+
+	return nil
+}
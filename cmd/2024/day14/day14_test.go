@@ -0,0 +1,133 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day14
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleRobots = `p=0,4 v=3,-3
+p=6,3 v=-1,-3
+p=10,3 v=-1,2
+p=2,0 v=2,-1
+p=0,0 v=1,3
+p=3,0 v=-2,-2
+p=7,6 v=-1,-3
+p=3,0 v=-1,-2
+p=9,3 v=2,3
+p=7,3 v=-1,2
+p=2,4 v=2,-3
+p=9,5 v=-3,-3`
+
+var exampleBounds = utilities.NewSize2D(11, 7)
+
+func TestParseRobots(t *testing.T) {
+	robots, err := ParseRobots(exampleRobots)
+	assert.NoError(t, err)
+	assert.Len(t, robots, 12)
+	assert.Equal(t, Robot{Position: utilities.NewPoint2D(0, 4), Velocity: utilities.NewPoint2D(3, -3)}, robots[0])
+
+	_, err = ParseRobots("p=0,4 v=3")
+	assert.Error(t, err)
+
+	_, err = NewBathroom(exampleBounds, []Robot{{Position: utilities.NewPoint2D(11, 0)}})
+	assert.Error(t, err)
+}
+
+func TestPositionsAfter(t *testing.T) {
+	bathroom, err := NewBathroom(exampleBounds, []Robot{{Position: utilities.NewPoint2D(2, 4), Velocity: utilities.NewPoint2D(2, -3)}})
+	assert.NoError(t, err)
+
+	expected := []utilities.Point2D{
+		utilities.NewPoint2D(2, 4),
+		utilities.NewPoint2D(4, 1),
+		utilities.NewPoint2D(6, 5),
+		utilities.NewPoint2D(8, 2),
+		utilities.NewPoint2D(10, 6),
+		utilities.NewPoint2D(1, 3),
+	}
+
+	for seconds, p := range expected {
+		assert.Equal(t, []utilities.Point2D{p}, bathroom.PositionsAfter(seconds))
+	}
+}
+
+func TestSafetyFactor(t *testing.T) {
+	robots, err := ParseRobots(exampleRobots)
+	assert.NoError(t, err)
+
+	bathroom, err := NewBathroom(exampleBounds, robots)
+	assert.NoError(t, err)
+
+	assert.Equal(t, `......2..1.
+...........
+1..........
+.11........
+.....1.....
+...12......
+.1....1....`, bathroom.Describe(100))
+	assert.Equal(t, 12, bathroom.SafetyFactor(100))
+}
+
+func TestFindChristmasTree(t *testing.T) {
+	bounds := utilities.NewSize2D(BathroomWidth, BathroomHeight)
+	random := rand.New(rand.NewSource(14))
+
+	// Draw a filled triangle at a known time, and scatter a few stray robots
+	// that aren't part of the picture.
+	const pictureSeconds = 6587
+
+	picture := make([]utilities.Point2D, 0)
+	for y := 0; y < 20; y++ {
+		for x := -y; x <= y; x++ {
+			picture = append(picture, utilities.NewPoint2D(50+x, 30+y))
+		}
+	}
+	for i := 0; i < 100; i++ {
+		picture = append(picture, utilities.NewPoint2D(random.Intn(BathroomWidth), random.Intn(BathroomHeight)))
+	}
+
+	robots := make([]Robot, 0, len(picture))
+
+	for _, p := range picture {
+		v := utilities.NewPoint2D(random.Intn(199)-99, random.Intn(199)-99)
+
+		robots = append(robots, Robot{
+			Position: utilities.NewPoint2D(wrap(p.X-v.X*pictureSeconds, bounds.Width), wrap(p.Y-v.Y*pictureSeconds, bounds.Height)),
+			Velocity: v,
+		})
+	}
+
+	bathroom, err := NewBathroom(bounds, robots)
+	assert.NoError(t, err)
+
+	seconds, err := bathroom.FindChristmasTree()
+	assert.NoError(t, err)
+	assert.Equal(t, pictureSeconds, seconds)
+}
+
+func TestFindChristmasTreeNoise(t *testing.T) {
+	robots := []Robot{
+		{Position: utilities.NewPoint2D(0, 0), Velocity: utilities.NewPoint2D(0, 0)},
+		{Position: utilities.NewPoint2D(5, 5), Velocity: utilities.NewPoint2D(0, 0)},
+	}
+
+	bathroom, err := NewBathroom(exampleBounds, robots)
+	assert.NoError(t, err)
+
+	_, err = bathroom.FindChristmasTree()
+	assert.Error(t, err)
+}
+
+// TestExamples checks part 1 of the puzzle's example, in its 11x7 bathroom, through
+// the whole solver. The example has no picture for part 2.
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return solve(utilities.DiscardLogger, input, exampleBounds) })
+}
@@ -0,0 +1,344 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day15
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/render"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// Day15Cmd represents the day15 command
+var Day15Cmd = &cobra.Command{
+	Use:   "day15",
+	Short: `Warehouse Woes`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type Tile rune
+
+const (
+	Wall     Tile = '#'
+	Empty    Tile = '.'
+	Box      Tile = 'O'
+	BoxLeft  Tile = '['
+	BoxRight Tile = ']'
+	Robot    Tile = '@'
+)
+
+type Direction rune
+
+const (
+	Up    Direction = '^'
+	Down  Direction = 'v'
+	Left  Direction = '<'
+	Right Direction = '>'
+)
+
+func (d Direction) Step(p utilities.Point2D) utilities.Point2D {
+	switch d {
+	case Up:
+		return p.Up()
+	case Down:
+		return p.Down()
+	case Left:
+		return p.Left()
+	default:
+		return p.Right()
+	}
+}
+
+func (d Direction) IsVertical() bool {
+	return d == Up || d == Down
+}
+
+type Row []Tile
+
+type Warehouse struct {
+	Bounds utilities.Size2D
+	Robot  utilities.Point2D
+	Rows   []Row
+}
+
+// Widen doubles the width of everything in the map except the robot, as the
+// second warehouse does.
+func Widen(warehouseMap string) string {
+	replacer := strings.NewReplacer("#", "##", "O", "[]", ".", "..", "@", "@.")
+
+	return replacer.Replace(warehouseMap)
+}
+
+// ParseWarehouse reads the map and the robot's moves. When wide is set the map is
+// widened before being parsed.
+func ParseWarehouse(fileContents string, wide bool) (*Warehouse, []Direction, error) {
+	sections := strings.Split(strings.TrimSpace(fileContents), "\n\n")
+	if len(sections) != 2 {
+		return nil, nil, fmt.Errorf("expected a map and moves separated by a blank line")
+	}
+
+	warehouseMap := sections[0]
+	if wide {
+		warehouseMap = Widen(warehouseMap)
+	}
+
+	warehouse := &Warehouse{}
+	robots := 0
+
+	for y, line := range strings.Split(warehouseMap, "\n") {
+		row := make(Row, 0, len(line))
+
+		for x, c := range strings.TrimSpace(line) {
+			switch Tile(c) {
+			case Robot:
+				warehouse.Robot = utilities.NewPoint2D(x, y)
+				robots++
+				c = rune(Empty)
+			case Wall, Empty, Box, BoxLeft, BoxRight:
+			default:
+				return nil, nil, fmt.Errorf("invalid map tile '%c'", c)
+			}
+
+			row = append(row, Tile(c))
+		}
+
+		if y > 0 && len(row) != len(warehouse.Rows[0]) {
+			return nil, nil, fmt.Errorf("map row %d has length %d, expected %d", y, len(row), len(warehouse.Rows[0]))
+		}
+
+		warehouse.Rows = append(warehouse.Rows, row)
+	}
+
+	if robots != 1 {
+		return nil, nil, fmt.Errorf("expected 1 robot, found %d", robots)
+	}
+
+	warehouse.Bounds = utilities.NewSize2D(len(warehouse.Rows[0]), len(warehouse.Rows))
+
+	moves := make([]Direction, 0)
+
+	for _, c := range sections[1] {
+		switch Direction(c) {
+		case Up, Down, Left, Right:
+			moves = append(moves, Direction(c))
+		case '\n', '\r':
+		default:
+			return nil, nil, fmt.Errorf("invalid move '%c'", c)
+		}
+	}
+
+	return warehouse, moves, nil
+}
+
+func (w *Warehouse) GetTile(p utilities.Point2D) Tile {
+	if p.X < 0 || p.X >= w.Bounds.Width || p.Y < 0 || p.Y >= w.Bounds.Height {
+		return Wall
+	}
+
+	return w.Rows[p.Y][p.X]
+}
+
+func (w *Warehouse) setTile(p utilities.Point2D, t Tile) {
+	w.Rows[p.Y][p.X] = t
+}
+
+// canPush reports whether whatever is at p can move one step in the direction.
+// A wide box pushed up or down moves both of its halves, and each half may push a
+// different box, so the check branches.
+func (w *Warehouse) canPush(p utilities.Point2D, d Direction) bool {
+	switch w.GetTile(p) {
+	case Empty:
+		return true
+	case Wall:
+		return false
+	case BoxLeft:
+		if d.IsVertical() {
+			return w.canPush(d.Step(p), d) && w.canPush(d.Step(p.Right()), d)
+		}
+	case BoxRight:
+		if d.IsVertical() {
+			return w.canPush(d.Step(p), d) && w.canPush(d.Step(p.Left()), d)
+		}
+	}
+
+	return w.canPush(d.Step(p), d)
+}
+
+// push moves whatever is at p one step in the direction, pushing anything in the
+// way first. It must only be called after canPush has agreed.
+func (w *Warehouse) push(p utilities.Point2D, d Direction) {
+	tile := w.GetTile(p)
+	if tile == Empty {
+		return
+	}
+
+	if d.IsVertical() && (tile == BoxLeft || tile == BoxRight) {
+		other := p.Right()
+		if tile == BoxRight {
+			other = p.Left()
+		}
+
+		otherTile := w.GetTile(other)
+
+		w.push(d.Step(p), d)
+		w.push(d.Step(other), d)
+
+		w.setTile(d.Step(p), tile)
+		w.setTile(d.Step(other), otherTile)
+		w.setTile(p, Empty)
+		w.setTile(other, Empty)
+
+		return
+	}
+
+	w.push(d.Step(p), d)
+	w.setTile(d.Step(p), tile)
+	w.setTile(p, Empty)
+}
+
+// Move tries to move the robot one step, pushing any boxes in the way. Nothing
+// moves if a wall is in the way of the robot or any of the boxes.
+func (w *Warehouse) Move(d Direction) bool {
+	next := d.Step(w.Robot)
+
+	if !w.canPush(next, d) {
+		return false
+	}
+
+	w.push(next, d)
+	w.Robot = next
+
+	return true
+}
+
+func (w *Warehouse) MoveAll(moves []Direction) {
+	for _, d := range moves {
+		w.Move(d)
+	}
+}
+
+// GPSSum adds up the GPS coordinate of every box, measured from its left edge.
+func (w *Warehouse) GPSSum() int {
+	sum := 0
+
+	for y, row := range w.Rows {
+		for x, t := range row {
+			if t == Box || t == BoxLeft {
+				sum += 100*y + x
+			}
+		}
+	}
+
+	return sum
+}
+
+func (w *Warehouse) Describe() string {
+	rows := make([]string, 0, w.Bounds.Height)
+
+	for y, row := range w.Rows {
+		var b strings.Builder
+
+		for x, t := range row {
+			if w.Robot == utilities.NewPoint2D(x, y) {
+				b.WriteRune(rune(Robot))
+			} else {
+				b.WriteRune(rune(t))
+			}
+		}
+
+		rows = append(rows, b.String())
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// Pixel is the tile at p, or the robot if it is standing there.
+func (w *Warehouse) Pixel(p utilities.Point2D) Tile {
+	if p == w.Robot {
+		return Robot
+	}
+
+	return w.GetTile(p)
+}
+
+func NewTerminal() *render.Terminal[Tile] {
+	return render.NewTerminal[Tile]().
+		Set(Wall, color.FgHiBlack).
+		Set(Box, color.FgYellow).
+		Set(BoxLeft, color.FgYellow).
+		Set(BoxRight, color.FgYellow).
+		Set(Robot, color.FgHiRed, color.Bold)
+}
+
+// Render draws the warehouse like Describe, coloured by terminal.
+func (w *Warehouse) Render(terminal *render.Terminal[Tile]) string {
+	return terminal.Draw(w.Bounds, func(p utilities.Point2D) string {
+		return string(w.Pixel(p))
+	}, w.Pixel)
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Predict the motion of the robot and boxes in the warehouse. After the robot is
+	// finished moving, what is the sum of all boxes' GPS coordinates?
+
+	warehouse, moves, err := ParseWarehouse(fileContents, false)
+	if err != nil {
+		return err
+	}
+
+	warehouse.MoveAll(moves)
+
+	logger.Debug("moved", "warehouse", utilities.Lazy(func() any { return warehouse.Render(NewTerminal()) }))
+
+	utilities.Answer(1, "Sum of all boxes' GPS coordinates", warehouse.GPSSum())
+
+	// Part 2: Predict the motion of the robot and boxes in this new, scaled-up warehouse. What
+	// is the sum of all boxes' final GPS coordinates?
+
+	warehouse, moves, err = ParseWarehouse(fileContents, true)
+	if err != nil {
+		return err
+	}
+
+	warehouse.MoveAll(moves)
+
+	logger.Debug("moved", "warehouse", utilities.Lazy(func() any { return warehouse.Render(NewTerminal()) }))
+
+	utilities.Answer(2, "Sum of all boxes' GPS coordinates in the wide warehouse", warehouse.GPSSum())
+
+	return nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day15
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const smallExample = `########
+#..O.O.#
+##@.O..#
+#...O..#
+#.#.O..#
+#...O..#
+#......#
+########
+
+<^^>>>vv<v>>v<<`
+
+const largeExample = `##########
+#..O..O.O#
+#......O.#
+#.OO..O.O#
+#..O@..O.#
+#O#..O...#
+#O..O..O.#
+#.OO.O.OO#
+#....O...#
+##########
+
+<vv>^<v^>v>^vv^v>v<>v^v<v<^vv<<<^><<><>>v<vvv<>^v^>^<<<><<v<<<v^vv^v>^
+vvv<<^>^v^^><<>>><>^<<><^vv^^<>vvv<>><^^v>^>vv<>v<<<<v<^v>^<^^>>>^<v<v
+><>vv>v^v^<>><>>>><^^>vv>v<^^^>>v^v^<^^>v^^>v^<^v>v<>>v^v^<v>v^^<^^vv<
+<<v<^>>^^^^>>>v^<>vvv^><v<<<>^^^vv^<vvv>^>v<^^^^v<>^>vvvv><>>v^<<^^^^^
+^><^><>>><>^^<<^^v>>><^<v>^<vv>>v>>>^v><>^v><<<<v>>v<v<v>vvv>^<><<>^><
+^>><>^v<><^vvv<^^<><v<<<<<><^v<<<><<<^^<v<^^^><^>>^<v^><<<^>>^v<v^v<v^
+>^>>^v>vv>^<<^v<>><<><<v<<v><>v<^vv<<<>^^v^>^^>>><<^v>>v^v><^^>>^<>vv^
+<><^^>^^^<><vvvvv^v<v<<>^v<v>v<<^><<><<><<<^^<<<^<<>><<><^^^>^^<>^>v<>
+^^>vv<^v^v<vv>^<><v<^v>^^^>>>^^vvv^>vvv<>>>^<^>>>>>^<<^v>^vvv<>^<><<v>
+v^^>>><<^^<>>^v^<v^vv<>v^<<>^<^v^v><^<<<><<^<v><v<>vv>>v><v^<vv<>v^<<^`
+
+func TestParseWarehouse(t *testing.T) {
+	warehouse, moves, err := ParseWarehouse(smallExample, false)
+	assert.NoError(t, err)
+	assert.Equal(t, utilities.NewSize2D(8, 8), warehouse.Bounds)
+	assert.Equal(t, utilities.NewPoint2D(2, 2), warehouse.Robot)
+	assert.Equal(t, Box, warehouse.GetTile(utilities.NewPoint2D(3, 1)))
+	assert.Equal(t, Empty, warehouse.GetTile(warehouse.Robot))
+	assert.Len(t, moves, 15)
+
+	warehouse, _, err = ParseWarehouse(smallExample, true)
+	assert.NoError(t, err)
+	assert.Equal(t, utilities.NewSize2D(16, 8), warehouse.Bounds)
+	assert.Equal(t, utilities.NewPoint2D(4, 2), warehouse.Robot)
+	assert.Equal(t, "##....[]..[]..##", strings.Split(warehouse.Describe(), "\n")[1])
+
+	_, _, err = ParseWarehouse("#.@#\n\n<x", false)
+	assert.Error(t, err)
+
+	_, _, err = ParseWarehouse("#.@@#\n\n<", false)
+	assert.Error(t, err)
+}
+
+func TestMove(t *testing.T) {
+	warehouse, moves, err := ParseWarehouse(smallExample, false)
+	assert.NoError(t, err)
+
+	warehouse.MoveAll(moves)
+	assert.Equal(t, `########
+#....OO#
+##.....#
+#.....O#
+#.#O@..#
+#...O..#
+#...O..#
+########`, warehouse.Describe())
+	assert.Equal(t, 2028, warehouse.GPSSum())
+}
+
+func TestMoveWide(t *testing.T) {
+	warehouse, moves, err := ParseWarehouse(`#######
+#...#.#
+#.....#
+#..OO@#
+#..O..#
+#.....#
+#######
+
+<vv<<^^<<^^`, true)
+	assert.NoError(t, err)
+
+	warehouse.MoveAll(moves)
+	assert.Equal(t, `##############
+##...[].##..##
+##...@.[]...##
+##....[]....##
+##..........##
+##..........##
+##############`, warehouse.Describe())
+	assert.Equal(t, 105+207+306, warehouse.GPSSum())
+}
+
+func TestMoveWideBlocked(t *testing.T) {
+	// The lower box can move up, but the box it pushes is under a wall, so
+	// nothing moves.
+	warehouse, _, err := ParseWarehouse(`##########
+##......##
+##....#.##
+##...[].##
+##..[]..##
+##...@..##
+##########
+
+^`, false)
+	assert.NoError(t, err)
+
+	before := warehouse.Describe()
+	assert.False(t, warehouse.Move(Up))
+	assert.Equal(t, before, warehouse.Describe())
+
+	// Without the wall both boxes move together.
+	warehouse, _, err = ParseWarehouse(`##########
+##......##
+##......##
+##...[].##
+##..[]..##
+##...@..##
+##########
+
+^`, false)
+	assert.NoError(t, err)
+
+	assert.True(t, warehouse.Move(Up))
+	assert.Equal(t, `##########
+##......##
+##...[].##
+##..[]..##
+##...@..##
+##......##
+##########`, warehouse.Describe())
+}
+
+func TestGPSSum(t *testing.T) {
+	warehouse, moves, err := ParseWarehouse(largeExample, false)
+	assert.NoError(t, err)
+
+	warehouse.MoveAll(moves)
+	assert.Equal(t, 10092, warehouse.GPSSum())
+
+	warehouse, moves, err = ParseWarehouse(largeExample, true)
+	assert.NoError(t, err)
+
+	warehouse.MoveAll(moves)
+	assert.Equal(t, 9021, warehouse.GPSSum())
+}
+
+func TestRender(t *testing.T) {
+	warehouse, _, err := ParseWarehouse(smallExample, false)
+	assert.NoError(t, err)
+
+	terminal := NewTerminal()
+
+	terminal.Enabled = false
+	assert.Equal(t, warehouse.Describe(), warehouse.Render(terminal))
+
+	terminal.Enabled = true
+	rendered := strings.Split(warehouse.Render(terminal), "\n")
+	assert.Len(t, rendered, warehouse.Bounds.Height)
+	assert.Contains(t, rendered[2], "\x1b[91;1m@")
+	assert.Contains(t, rendered[1], "\x1b[33mO\x1b[0m")
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
@@ -0,0 +1,267 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day16
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day16Cmd represents the day16 command
+var Day16Cmd = &cobra.Command{
+	Use:   "day16",
+	Short: `Reindeer Maze`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	StepCost = 1
+	TurnCost = 1000
+)
+
+type Tile rune
+
+const (
+	Wall  Tile = '#'
+	Empty Tile = '.'
+	Start Tile = 'S'
+	End   Tile = 'E'
+)
+
+type Direction int
+
+const (
+	East Direction = iota
+	South
+	West
+	North
+)
+
+func (d Direction) Step(p utilities.Point2D) utilities.Point2D {
+	switch d {
+	case East:
+		return p.Right()
+	case South:
+		return p.Down()
+	case West:
+		return p.Left()
+	default:
+		return p.Up()
+	}
+}
+
+func (d Direction) Clockwise() Direction {
+	return (d + 1) % 4
+}
+
+func (d Direction) CounterClockwise() Direction {
+	return (d + 3) % 4
+}
+
+func (d Direction) Reverse() Direction {
+	return (d + 2) % 4
+}
+
+type Row []Tile
+
+type Maze struct {
+	Bounds utilities.Size2D
+	Start  utilities.Point2D
+	End    utilities.Point2D
+	Rows   []Row
+}
+
+func ParseMaze(fileContents string) (*Maze, error) {
+	maze := &Maze{}
+	starts, ends := 0, 0
+
+	for y, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		row := make(Row, 0, len(line))
+
+		for x, c := range strings.TrimSpace(line) {
+			switch Tile(c) {
+			case Start:
+				maze.Start = utilities.NewPoint2D(x, y)
+				starts++
+			case End:
+				maze.End = utilities.NewPoint2D(x, y)
+				ends++
+			case Wall, Empty:
+			default:
+				return nil, fmt.Errorf("invalid maze tile '%c'", c)
+			}
+
+			row = append(row, Tile(c))
+		}
+
+		if y > 0 && len(row) != len(maze.Rows[0]) {
+			return nil, fmt.Errorf("maze row %d has length %d, expected %d", y, len(row), len(maze.Rows[0]))
+		}
+
+		maze.Rows = append(maze.Rows, row)
+	}
+
+	if starts != 1 || ends != 1 {
+		return nil, fmt.Errorf("expected 1 start and 1 end, found %d and %d", starts, ends)
+	}
+
+	maze.Bounds = utilities.NewSize2D(len(maze.Rows[0]), len(maze.Rows))
+
+	return maze, nil
+}
+
+func (m *Maze) IsOpen(p utilities.Point2D) bool {
+	return p.X >= 0 && p.X < m.Bounds.Width && p.Y >= 0 && p.Y < m.Bounds.Height && m.Rows[p.Y][p.X] != Wall
+}
+
+// State is a reindeer's position and the direction it faces.
+type State struct {
+	Position utilities.Point2D
+	Facing   Direction
+}
+
+// scores runs Dijkstra's algorithm from the starting states. Searching backwards
+// steps opposite to the way the reindeer faces, so the score of a state is the
+// cost of finishing from it rather than of reaching it.
+func (m *Maze) scores(starts []State, backwards bool) map[State]int {
+	type candidate struct {
+		State State
+		Score int
+	}
+
+	best := make(map[State]int)
+	queue := utilities.NewPriorityQueue(func(a, b candidate) bool {
+		return a.Score < b.Score
+	})
+
+	for _, s := range starts {
+		best[s] = 0
+		queue.Push(candidate{State: s, Score: 0})
+	}
+
+	for !queue.IsEmpty() {
+		current := queue.Pop()
+		if current.Score > best[current.State] {
+			continue
+		}
+
+		step := current.State.Facing
+		if backwards {
+			step = step.Reverse()
+		}
+
+		next := []candidate{
+			{State: State{Position: step.Step(current.State.Position), Facing: current.State.Facing}, Score: current.Score + StepCost},
+			{State: State{Position: current.State.Position, Facing: current.State.Facing.Clockwise()}, Score: current.Score + TurnCost},
+			{State: State{Position: current.State.Position, Facing: current.State.Facing.CounterClockwise()}, Score: current.Score + TurnCost},
+		}
+
+		for _, n := range next {
+			if !m.IsOpen(n.State.Position) {
+				continue
+			}
+
+			if s, ok := best[n.State]; !ok || n.Score < s {
+				best[n.State] = n.Score
+				queue.Push(n)
+			}
+		}
+	}
+
+	return best
+}
+
+// BestPaths returns the lowest score a reindeer starting east can get, and the
+// number of tiles on at least one path with that score. A state is on a best path
+// when the cost of reaching it plus the cost of finishing from it is the best score.
+func (m *Maze) BestPaths() (int, int, error) {
+	forward := m.scores([]State{{Position: m.Start, Facing: East}}, false)
+
+	ends := make([]State, 0, 4)
+	for d := East; d <= North; d++ {
+		ends = append(ends, State{Position: m.End, Facing: d})
+	}
+
+	bestScore := -1
+	for _, e := range ends {
+		if s, ok := forward[e]; ok && (bestScore < 0 || s < bestScore) {
+			bestScore = s
+		}
+	}
+
+	if bestScore < 0 {
+		return 0, 0, fmt.Errorf("the end can't be reached")
+	}
+
+	backward := m.scores(ends, true)
+
+	tiles := utilities.NewSetPoint2D()
+
+	for s, f := range forward {
+		if b, ok := backward[s]; ok && f+b == bestScore {
+			tiles.Add(s.Position)
+		}
+	}
+
+	return bestScore, tiles.Size(), nil
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Analyze your map carefully. What is the lowest score a Reindeer could possibly
+	// get?
+
+	maze, err := ParseMaze(fileContents)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("parsed maze", "size", maze.Bounds, "start", maze.Start, "end", maze.End)
+
+	score, tiles, err := maze.BestPaths()
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(1, "Lowest score", score)
+
+	// Part 2: Analyze your map further. How many tiles are part of at least one of the best
+	// paths through the maze?
+
+	utilities.Answer(2, "Tiles on a best path", tiles)
+
+	return nil
+}
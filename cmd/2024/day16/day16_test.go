@@ -0,0 +1,99 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day16
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const firstExample = `###############
+#.......#....E#
+#.#.###.#.###.#
+#.....#.#...#.#
+#.###.#####.#.#
+#.#.#.......#.#
+#.#.#####.###.#
+#...........#.#
+###.#.#####.#.#
+#...#.....#.#.#
+#.#.#.###.#.#.#
+#.....#...#.#.#
+#.###.#.#.#.#.#
+#S..#.....#...#
+###############`
+
+const secondExample = `#################
+#...#...#...#..E#
+#.#.#.#.#.#.#.#.#
+#.#.#.#...#...#.#
+#.#.#.#.###.#.#.#
+#...#.#.#.....#.#
+#.#.#.#.#.#####.#
+#.#...#.#.#.....#
+#.#.#####.#.###.#
+#.#.#.......#...#
+#.#.###.#####.###
+#.#.#...#.....#.#
+#.#.#.#####.###.#
+#.#.#.........#.#
+#.#.#.#########.#
+#S#.............#
+#################`
+
+func TestParseMaze(t *testing.T) {
+	maze, err := ParseMaze(firstExample)
+	assert.NoError(t, err)
+	assert.Equal(t, utilities.NewSize2D(15, 15), maze.Bounds)
+	assert.Equal(t, utilities.NewPoint2D(1, 13), maze.Start)
+	assert.Equal(t, utilities.NewPoint2D(13, 1), maze.End)
+	assert.False(t, maze.IsOpen(utilities.NewPoint2D(0, 0)))
+	assert.False(t, maze.IsOpen(utilities.NewPoint2D(-1, 1)))
+
+	_, err = ParseMaze("#####\n#S.S#\n#####")
+	assert.Error(t, err)
+
+	_, err = ParseMaze("#####\n#S.E#\n##x##")
+	assert.Error(t, err)
+}
+
+func TestBestPaths(t *testing.T) {
+	type testCase struct {
+		text          string
+		expectedScore int
+		expectedTiles int
+	}
+
+	testCases := []testCase{
+		{text: firstExample, expectedScore: 7036, expectedTiles: 45},
+		{text: secondExample, expectedScore: 11048, expectedTiles: 64},
+		{text: "#####\n#S.E#\n#####", expectedScore: 2, expectedTiles: 3},
+		// Turning around costs two turns.
+		{text: "#####\n#E.S#\n#####", expectedScore: 2002, expectedTiles: 3},
+	}
+
+	for _, test := range testCases {
+		maze, err := ParseMaze(test.text)
+		assert.NoError(t, err)
+
+		score, tiles, err := maze.BestPaths()
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedScore, score)
+		assert.Equal(t, test.expectedTiles, tiles)
+	}
+
+	maze, err := ParseMaze("#####\n#S#E#\n#####")
+	assert.NoError(t, err)
+
+	_, _, err = maze.BestPaths()
+	assert.Error(t, err)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
@@ -0,0 +1,255 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day17
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day17Cmd represents the day17 command
+var Day17Cmd = &cobra.Command{
+	Use:   "day17",
+	Short: `Chronospatial Computer`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type Opcode int
+
+const (
+	ADV Opcode = iota
+	BXL
+	BST
+	JNZ
+	BXC
+	OUT
+	BDV
+	CDV
+)
+
+// MaximumSteps stops programs that never halt.
+const MaximumSteps = 1 << 20
+
+type Computer struct {
+	A, B, C int
+	Program []int
+}
+
+func ParseComputer(fileContents string) (*Computer, error) {
+	var a, b, c int
+	var program string
+
+	if _, err := fmt.Sscanf(strings.TrimSpace(fileContents), "Register A: %d\nRegister B: %d\nRegister C: %d\n\nProgram: %s", &a, &b, &c, &program); err != nil {
+		return nil, fmt.Errorf("invalid computer: %w", err)
+	}
+
+	computer := &Computer{A: a, B: b, C: c}
+
+	for _, s := range strings.Split(program, ",") {
+		value, err := strconv.Atoi(s)
+		if err != nil || value < 0 || value > 7 {
+			return nil, fmt.Errorf("invalid 3-bit number '%s'", s)
+		}
+
+		computer.Program = append(computer.Program, value)
+	}
+
+	return computer, nil
+}
+
+func (c *Computer) combo(operand int) (int, error) {
+	switch operand {
+	case 0, 1, 2, 3:
+		return operand, nil
+	case 4:
+		return c.A, nil
+	case 5:
+		return c.B, nil
+	case 6:
+		return c.C, nil
+	}
+
+	return 0, fmt.Errorf("invalid combo operand %d", operand)
+}
+
+// Run executes the program until the instruction pointer runs off the end, and
+// returns everything it output. The registers are left as the program left them.
+func (c *Computer) Run() ([]int, error) {
+	output := make([]int, 0)
+
+	for ip, steps := 0, 0; ip+1 < len(c.Program); steps++ {
+		if steps >= MaximumSteps {
+			return output, fmt.Errorf("program did not halt after %d steps", MaximumSteps)
+		}
+
+		opcode, operand := Opcode(c.Program[ip]), c.Program[ip+1]
+		ip += 2
+
+		switch opcode {
+		case ADV, BDV, CDV, BST, OUT:
+			value, err := c.combo(operand)
+			if err != nil {
+				return output, err
+			}
+
+			switch opcode {
+			case ADV:
+				c.A = c.A >> value
+			case BDV:
+				c.B = c.A >> value
+			case CDV:
+				c.C = c.A >> value
+			case BST:
+				c.B = value & 7
+			case OUT:
+				output = append(output, value&7)
+			}
+
+		case BXL:
+			c.B ^= operand
+
+		case JNZ:
+			if c.A != 0 {
+				ip = operand
+			}
+
+		case BXC:
+			c.B ^= c.C
+		}
+	}
+
+	return output, nil
+}
+
+// RunWithA runs a copy of the computer with register A replaced.
+func (c *Computer) RunWithA(a int) ([]int, error) {
+	clone := &Computer{A: a, B: c.B, C: c.C, Program: c.Program}
+
+	return clone.Run()
+}
+
+func JoinOutput(output []int) string {
+	values := make([]string, 0, len(output))
+	for _, v := range output {
+		values = append(values, strconv.Itoa(v))
+	}
+
+	return strings.Join(values, ",")
+}
+
+// FindQuine finds the lowest value of register A that makes the program output a
+// copy of itself. These programs loop, outputting a value that depends on the low
+// bits of A and then shifting A right by 3 bits, so the last output only depends
+// on the highest octal digit of A. Building A one octal digit at a time, and
+// keeping only the digits that reproduce the end of the program, narrows the
+// search down to a few candidates per digit.
+func (c *Computer) FindQuine() (int, error) {
+	var search func(a int, matched int) (int, bool, error)
+
+	search = func(a int, matched int) (int, bool, error) {
+		if matched == len(c.Program) {
+			return a, true, nil
+		}
+
+		for digit := 0; digit < 8; digit++ {
+			candidate := a<<3 | digit
+			if candidate == 0 {
+				continue
+			}
+
+			output, err := c.RunWithA(candidate)
+			if err != nil {
+				return 0, false, err
+			}
+
+			suffix := c.Program[len(c.Program)-matched-1:]
+			if JoinOutput(output) != JoinOutput(suffix) {
+				continue
+			}
+
+			if found, ok, err := search(candidate, matched+1); err != nil || ok {
+				return found, ok, err
+			}
+		}
+
+		return 0, false, nil
+	}
+
+	a, ok, err := search(0, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	if !ok {
+		return 0, fmt.Errorf("no value of register A makes the program output itself")
+	}
+
+	return a, nil
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Using the information provided by the debugger, initialize the registers to the
+	// given values, then run the program. Once it halts, what do you get if you use commas to
+	// join the values it output into a single string?
+
+	computer, err := ParseComputer(fileContents)
+	if err != nil {
+		return err
+	}
+
+	output, err := computer.RunWithA(computer.A)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(1, "Program output", JoinOutput(output))
+
+	// Part 2: What is the lowest positive initial value for register A that causes the program
+	// to output a copy of itself?
+
+	a, err := computer.FindQuine()
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("quine", "octal", strconv.FormatInt(int64(a), 8))
+
+	utilities.Answer(2, "Lowest value of register A for a quine", a)
+
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day17
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseComputer(t *testing.T) {
+	computer, err := ParseComputer(`Register A: 729
+Register B: 0
+Register C: 0
+
+Program: 0,1,5,4,3,0`)
+	assert.NoError(t, err)
+	assert.Equal(t, &Computer{A: 729, Program: []int{0, 1, 5, 4, 3, 0}}, computer)
+
+	_, err = ParseComputer("Register A: 729\n\nProgram: 0,1")
+	assert.Error(t, err)
+
+	_, err = ParseComputer("Register A: 729\nRegister B: 0\nRegister C: 0\n\nProgram: 0,8")
+	assert.Error(t, err)
+}
+
+func TestRun(t *testing.T) {
+	type testCase struct {
+		computer       Computer
+		expectedOutput string
+		expected       Computer
+	}
+
+	testCases := []testCase{
+		{computer: Computer{C: 9, Program: []int{2, 6}}, expected: Computer{B: 1, C: 9}},
+		{computer: Computer{A: 10, Program: []int{5, 0, 5, 1, 5, 4}}, expectedOutput: "0,1,2", expected: Computer{A: 10}},
+		{computer: Computer{A: 2024, Program: []int{0, 1, 5, 4, 3, 0}}, expectedOutput: "4,2,5,6,7,7,7,7,3,1,0", expected: Computer{A: 0}},
+		{computer: Computer{B: 29, Program: []int{1, 7}}, expected: Computer{B: 26}},
+		{computer: Computer{B: 2024, C: 43690, Program: []int{4, 0}}, expected: Computer{B: 44354, C: 43690}},
+		{computer: Computer{A: 729, Program: []int{0, 1, 5, 4, 3, 0}}, expectedOutput: "4,6,3,5,6,3,5,2,1,0", expected: Computer{A: 0}},
+	}
+
+	for _, test := range testCases {
+		output, err := test.computer.Run()
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedOutput, JoinOutput(output))
+		assert.Equal(t, test.expected.A, test.computer.A)
+		assert.Equal(t, test.expected.B, test.computer.B)
+		assert.Equal(t, test.expected.C, test.computer.C)
+	}
+
+	// Combo operand 7 is reserved.
+	_, err := (&Computer{Program: []int{5, 7}}).Run()
+	assert.Error(t, err)
+
+	// Jumping back to the start with A never changing loops forever.
+	_, err = (&Computer{A: 1, Program: []int{3, 0}}).Run()
+	assert.Error(t, err)
+}
+
+func TestFindQuine(t *testing.T) {
+	computer := &Computer{A: 2024, Program: []int{0, 3, 5, 4, 3, 0}}
+
+	a, err := computer.FindQuine()
+	assert.NoError(t, err)
+	assert.Equal(t, 117440, a)
+
+	output, err := computer.RunWithA(a)
+	assert.NoError(t, err)
+	assert.Equal(t, computer.Program, output)
+
+	// This program only ever outputs 0.
+	_, err = (&Computer{Program: []int{0, 3, 5, 0, 3, 0}}).FindQuine()
+	assert.Error(t, err)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
@@ -0,0 +1,172 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day18
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day18Cmd represents the day18 command
+var Day18Cmd = &cobra.Command{
+	Use:   "day18",
+	Short: `RAM Run`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	MemorySize  = 71
+	FallenBytes = 1024
+)
+
+func ParseBytes(fileContents string) ([]utilities.Point2D, error) {
+	bytes := make([]utilities.Point2D, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		coordinates := utilities.ParseIntList(line)
+		if len(coordinates) != 2 || coordinates[0] < 0 || coordinates[1] < 0 {
+			return nil, fmt.Errorf("invalid byte position '%s'", line)
+		}
+
+		bytes = append(bytes, utilities.NewPoint2D(coordinates[0], coordinates[1]))
+	}
+
+	return bytes, nil
+}
+
+// ShortestPath finds the fewest steps from the top left to the bottom right corner
+// of the memory space, avoiding the corrupted positions, with a breadth first
+// search.
+func ShortestPath(bounds utilities.Size2D, corrupted []utilities.Point2D) (int, bool) {
+	blocked := utilities.NewSetPoint2D()
+	for _, p := range corrupted {
+		blocked.Add(p)
+	}
+
+	start := utilities.NewPoint2D(0, 0)
+	end := utilities.NewPoint2D(bounds.Width-1, bounds.Height-1)
+
+	if blocked.Exists(start) {
+		return 0, false
+	}
+
+	steps := map[utilities.Point2D]int{start: 0}
+
+	queue := utilities.NewFIFO[utilities.Point2D]()
+	queue.Push(start)
+
+	for !queue.IsEmpty() {
+		current := queue.Pop()
+		if current == end {
+			return steps[current], true
+		}
+
+		for _, next := range []utilities.Point2D{current.Up(), current.Right(), current.Down(), current.Left()} {
+			if next.X < 0 || next.X >= bounds.Width || next.Y < 0 || next.Y >= bounds.Height || blocked.Exists(next) {
+				continue
+			}
+
+			if _, seen := steps[next]; !seen {
+				steps[next] = steps[current] + 1
+				queue.Push(next)
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// FirstBlockingByte finds the first byte that cuts off the exit. Once the exit is
+// cut off it stays cut off, so a binary search over how many bytes have fallen
+// finds it with a handful of searches.
+func FirstBlockingByte(bounds utilities.Size2D, bytes []utilities.Point2D) (utilities.Point2D, error) {
+	fallen := sort.Search(len(bytes)+1, func(n int) bool {
+		_, ok := ShortestPath(bounds, bytes[:n])
+		return !ok
+	})
+
+	if fallen == 0 {
+		return utilities.Point2D{}, fmt.Errorf("the exit is never reachable")
+	}
+
+	if fallen > len(bytes) {
+		return utilities.Point2D{}, fmt.Errorf("the exit is always reachable")
+	}
+
+	return bytes[fallen-1], nil
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	return solve(logger, fileContents, utilities.NewSize2D(MemorySize, MemorySize), FallenBytes)
+}
+
+// solve answers both parts for a memory space of the given size, with fallen bytes
+// down by part 1. The puzzle's example is a 7x7 space after 12 bytes.
+func solve(logger *slog.Logger, fileContents string, bounds utilities.Size2D, fallen int) error {
+	// Part 1: Simulate the first kilobyte (1024 bytes) falling onto your memory space.
+	// Afterward, what is the minimum number of steps needed to reach the exit?
+
+	bytes, err := ParseBytes(fileContents)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("parsed bytes", "count", len(bytes), "size", bounds)
+
+	steps, ok := ShortestPath(bounds, bytes[:min(fallen, len(bytes))])
+	if !ok {
+		return fmt.Errorf("the exit can't be reached after %d bytes", fallen)
+	}
+
+	utilities.Answer(1, "Minimum steps to the exit", steps)
+
+	// Part 2: Simulate more of the bytes that are about to corrupt your memory space. What are
+	// the coordinates of the first byte that will prevent the exit from being reachable from
+	// your starting position?
+
+	blocking, err := FirstBlockingByte(bounds, bytes)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("exit cut off", "byte", blocking)
+
+	utilities.Answer(2, "First byte blocking the exit", fmt.Sprintf("%d,%d", blocking.X, blocking.Y))
+
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day18
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleBytes = `5,4
+4,2
+4,5
+3,0
+2,1
+6,3
+2,4
+1,5
+0,6
+3,3
+2,6
+5,1
+1,2
+5,5
+2,5
+6,5
+1,4
+0,4
+6,4
+1,1
+6,1
+1,0
+0,5
+1,6
+2,0`
+
+var exampleBounds = utilities.NewSize2D(7, 7)
+
+func TestParseBytes(t *testing.T) {
+	bytes, err := ParseBytes(exampleBytes)
+	assert.NoError(t, err)
+	assert.Len(t, bytes, 25)
+	assert.Equal(t, utilities.NewPoint2D(5, 4), bytes[0])
+
+	_, err = ParseBytes("5,4\n4")
+	assert.Error(t, err)
+}
+
+func TestShortestPath(t *testing.T) {
+	bytes, err := ParseBytes(exampleBytes)
+	assert.NoError(t, err)
+
+	steps, ok := ShortestPath(exampleBounds, nil)
+	assert.True(t, ok)
+	assert.Equal(t, 12, steps)
+
+	steps, ok = ShortestPath(exampleBounds, bytes[:12])
+	assert.True(t, ok)
+	assert.Equal(t, 22, steps)
+
+	_, ok = ShortestPath(exampleBounds, bytes)
+	assert.False(t, ok)
+}
+
+func TestFirstBlockingByte(t *testing.T) {
+	bytes, err := ParseBytes(exampleBytes)
+	assert.NoError(t, err)
+
+	blocking, err := FirstBlockingByte(exampleBounds, bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, utilities.NewPoint2D(6, 1), blocking)
+
+	_, err = FirstBlockingByte(exampleBounds, bytes[:12])
+	assert.Error(t, err)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error {
+		return solve(utilities.DiscardLogger, input, utilities.NewSize2D(7, 7), 12)
+	})
+}
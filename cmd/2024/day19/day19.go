@@ -0,0 +1,154 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day19
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day19Cmd represents the day19 command
+var Day19Cmd = &cobra.Command{
+	Use:   "day19",
+	Short: `Linen Layout`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type Onsen struct {
+	Patterns []string
+	Designs  []string
+}
+
+func ParseOnsen(fileContents string) (*Onsen, error) {
+	sections := strings.Split(strings.TrimSpace(fileContents), "\n\n")
+	if len(sections) != 2 {
+		return nil, fmt.Errorf("expected towel patterns and designs separated by a blank line")
+	}
+
+	onsen := &Onsen{}
+
+	for _, pattern := range strings.Split(sections[0], ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			return nil, fmt.Errorf("empty towel pattern")
+		}
+
+		onsen.Patterns = append(onsen.Patterns, pattern)
+	}
+
+	for _, design := range strings.Split(sections[1], "\n") {
+		onsen.Designs = append(onsen.Designs, strings.TrimSpace(design))
+	}
+
+	return onsen, nil
+}
+
+// Arrangements counts the ways the design can be made by lining up towels. The
+// count for each remaining suffix of the design is memoized, since many different
+// prefixes lead to the same suffix.
+func (o *Onsen) Arrangements(design string) int {
+	cache := make(map[int]int)
+
+	var count func(start int) int
+
+	count = func(start int) int {
+		if start == len(design) {
+			return 1
+		}
+
+		if c, ok := cache[start]; ok {
+			return c
+		}
+
+		ways := 0
+
+		for _, p := range o.Patterns {
+			if strings.HasPrefix(design[start:], p) {
+				ways += count(start + len(p))
+			}
+		}
+
+		cache[start] = ways
+
+		return ways
+	}
+
+	return count(0)
+}
+
+func (o *Onsen) Counts() (int, int) {
+	possible, total := 0, 0
+
+	for _, d := range o.Designs {
+		ways := o.Arrangements(d)
+		if ways > 0 {
+			possible++
+		}
+
+		total += ways
+	}
+
+	return possible, total
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: To start, collect together all of the available towel patterns and the list of
+	// desired designs (your puzzle input). How many designs are possible?
+
+	onsen, err := ParseOnsen(fileContents)
+	if err != nil {
+		return err
+	}
+
+	possible, total := onsen.Counts()
+
+	utilities.Answer(1, "Possible designs", possible)
+
+	// Part 2: They'll let you into the onsen as soon as you have the list. What do you get if
+	// you add up the number of different ways you could make each design?
+
+	if logger.Enabled(context.Background(), utilities.LevelVerbose) {
+		for _, d := range onsen.Designs {
+			logger.Debug("arrangements", "design", d, "count", onsen.Arrangements(d))
+		}
+	}
+
+	utilities.Answer(2, "Total arrangements", total)
+
+	return nil
+}
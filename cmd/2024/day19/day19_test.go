@@ -0,0 +1,62 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day19
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleOnsen = `r, wr, b, g, bwu, rb, gb, br
+
+brwrr
+bggr
+gbbr
+rrbgbr
+ubwu
+bwurrg
+brgr
+bbrgwb`
+
+func TestParseOnsen(t *testing.T) {
+	onsen, err := ParseOnsen(exampleOnsen)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"r", "wr", "b", "g", "bwu", "rb", "gb", "br"}, onsen.Patterns)
+	assert.Len(t, onsen.Designs, 8)
+
+	_, err = ParseOnsen("r, , b\n\nrb")
+	assert.Error(t, err)
+
+	_, err = ParseOnsen("r, b")
+	assert.Error(t, err)
+}
+
+func TestArrangements(t *testing.T) {
+	onsen, err := ParseOnsen(exampleOnsen)
+	assert.NoError(t, err)
+
+	expected := []int{2, 1, 4, 6, 0, 1, 2, 0}
+	for i, d := range onsen.Designs {
+		assert.Equal(t, expected[i], onsen.Arrangements(d), d)
+	}
+
+	possible, total := onsen.Counts()
+	assert.Equal(t, 6, possible)
+	assert.Equal(t, 16, total)
+}
+
+func TestArrangementsLongDesign(t *testing.T) {
+	onsen := &Onsen{Patterns: []string{"w", "ww"}}
+
+	// Tilings of a strip with squares and dominoes are Fibonacci numbers.
+	assert.Equal(t, 165580141, onsen.Arrangements("wwwwwwwwwwwwwwwwwwwwwwwwwwwwwwwwwwwwwwww"))
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
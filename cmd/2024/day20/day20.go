@@ -0,0 +1,212 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day20
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day20Cmd represents the day20 command
+var Day20Cmd = &cobra.Command{
+	Use:   "day20",
+	Short: `Race Condition`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	ShortCheat       = 2
+	LongCheat        = 20
+	MinimumTimeSaved = 100
+)
+
+type Racetrack struct {
+	Bounds utilities.Size2D
+	Start  utilities.Point2D
+	End    utilities.Point2D
+	Walls  *utilities.SetPoint2D
+}
+
+func ParseRacetrack(fileContents string) (*Racetrack, error) {
+	track := &Racetrack{Walls: utilities.NewSetPoint2D()}
+	starts, ends := 0, 0
+
+	lines := strings.Split(strings.TrimSpace(fileContents), "\n")
+
+	for y, line := range lines {
+		line = strings.TrimSpace(line)
+		if len(line) != len(strings.TrimSpace(lines[0])) {
+			return nil, fmt.Errorf("racetrack row %d has length %d, expected %d", y, len(line), len(lines[0]))
+		}
+
+		for x, c := range line {
+			p := utilities.NewPoint2D(x, y)
+
+			switch c {
+			case '#':
+				track.Walls.Add(p)
+			case 'S':
+				track.Start = p
+				starts++
+			case 'E':
+				track.End = p
+				ends++
+			case '.':
+			default:
+				return nil, fmt.Errorf("invalid racetrack tile '%c'", c)
+			}
+		}
+	}
+
+	if starts != 1 || ends != 1 {
+		return nil, fmt.Errorf("expected 1 start and 1 end, found %d and %d", starts, ends)
+	}
+
+	track.Bounds = utilities.NewSize2D(len(strings.TrimSpace(lines[0])), len(lines))
+
+	return track, nil
+}
+
+// Path follows the single track from the start to the end. A position's index in
+// the path is how many picoseconds it takes to get there.
+func (r *Racetrack) Path() ([]utilities.Point2D, error) {
+	path := []utilities.Point2D{r.Start}
+	previous := r.Start
+	current := r.Start
+
+	for current != r.End {
+		next := make([]utilities.Point2D, 0, 1)
+
+		for _, n := range []utilities.Point2D{current.Up(), current.Right(), current.Down(), current.Left()} {
+			if n != previous && !r.Walls.Exists(n) && n.X >= 0 && n.X < r.Bounds.Width && n.Y >= 0 && n.Y < r.Bounds.Height {
+				next = append(next, n)
+			}
+		}
+
+		if len(next) != 1 {
+			return nil, fmt.Errorf("track at %d,%d has %d ways forward", current.X, current.Y, len(next))
+		}
+
+		previous, current = current, next[0]
+		path = append(path, current)
+	}
+
+	return path, nil
+}
+
+// Cheats counts the cheats of at most the given duration, keyed by how many
+// picoseconds they save. A cheat jumps between two positions on the track no more
+// than the duration apart, and saves the difference between the time along the
+// track and the time taken by the jump.
+func (r *Racetrack) Cheats(duration int) (map[int]int, error) {
+	path, err := r.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	savings := make(map[int]int)
+
+	for i := range path {
+		for j := i + 1; j < len(path); j++ {
+			distance := utilities.ManhattanDistance(path[i], path[j])
+			if distance > duration {
+				continue
+			}
+
+			if saved := j - i - distance; saved > 0 {
+				savings[saved]++
+			}
+		}
+	}
+
+	return savings, nil
+}
+
+func (r *Racetrack) CountCheats(duration int, minimumSaved int) (int, error) {
+	savings, err := r.Cheats(duration)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+
+	for saved, cheats := range savings {
+		if saved >= minimumSaved {
+			count += cheats
+		}
+	}
+
+	return count, nil
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	return solve(logger, fileContents, MinimumTimeSaved)
+}
+
+// solve answers both parts counting the cheats that save at least minimumSaved
+// picoseconds. The puzzle's example has no cheats saving 100.
+func solve(logger *slog.Logger, fileContents string, minimumSaved int) error {
+	// Part 1: You aren't sure what the conditions of the racetrack will be like, so to give
+	// yourself as many options as possible, you'll need a list of the best cheats. How many
+	// cheats would save you at least 100 picoseconds?
+
+	track, err := ParseRacetrack(fileContents)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("parsed racetrack", "size", track.Bounds, "walls", track.Walls.Size())
+
+	count, err := track.CountCheats(ShortCheat, minimumSaved)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(1, fmt.Sprintf("Cheats saving at least %d picoseconds", minimumSaved), count)
+
+	// Part 2: Find the best cheats using the updated cheating rules. How many cheats would
+	// save you at least 100 picoseconds?
+
+	count, err = track.CountCheats(LongCheat, minimumSaved)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(2, fmt.Sprintf("Long cheats saving at least %d picoseconds", minimumSaved), count)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day20
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleRacetrack = `###############
+#...#...#.....#
+#.#.#.#.#.###.#
+#S#...#.#.#...#
+#######.#.#.###
+#######.#.#...#
+#######.#.###.#
+###..E#...#...#
+###.#######.###
+#...###...#...#
+#.#####.#.###.#
+#.#...#.#.#...#
+#.#.#.#.#.#.###
+#...#...#...###
+###############`
+
+func TestParseRacetrack(t *testing.T) {
+	track, err := ParseRacetrack(exampleRacetrack)
+	assert.NoError(t, err)
+	assert.Equal(t, utilities.NewSize2D(15, 15), track.Bounds)
+	assert.Equal(t, utilities.NewPoint2D(1, 3), track.Start)
+	assert.Equal(t, utilities.NewPoint2D(5, 7), track.End)
+
+	_, err = ParseRacetrack("#####\n#S.S#\n#####")
+	assert.Error(t, err)
+}
+
+func TestPath(t *testing.T) {
+	track, err := ParseRacetrack(exampleRacetrack)
+	assert.NoError(t, err)
+
+	path, err := track.Path()
+	assert.NoError(t, err)
+	assert.Len(t, path, 85)
+
+	track, err = ParseRacetrack("#####\n#S..#\n#..E#\n#####")
+	assert.NoError(t, err)
+
+	_, err = track.Path()
+	assert.Error(t, err)
+}
+
+func TestCheats(t *testing.T) {
+	track, err := ParseRacetrack(exampleRacetrack)
+	assert.NoError(t, err)
+
+	savings, err := track.Cheats(ShortCheat)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]int{2: 14, 4: 14, 6: 2, 8: 4, 10: 2, 12: 3, 20: 1, 36: 1, 38: 1, 40: 1, 64: 1}, savings)
+
+	savings, err = track.Cheats(LongCheat)
+	assert.NoError(t, err)
+
+	expected := map[int]int{50: 32, 52: 31, 54: 29, 56: 39, 58: 25, 60: 23, 62: 20, 64: 19, 66: 12, 68: 14, 70: 12, 72: 22, 74: 4, 76: 3}
+	for saved, count := range expected {
+		assert.Equal(t, count, savings[saved], saved)
+	}
+}
+
+func TestCountCheats(t *testing.T) {
+	track, err := ParseRacetrack(exampleRacetrack)
+	assert.NoError(t, err)
+
+	count, err := track.CountCheats(ShortCheat, 38)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	count, err = track.CountCheats(LongCheat, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, 285, count)
+}
+
+func TestExamples(t *testing.T) {
+	// The example's answers count the cheats saving at least 50 picoseconds.
+	examples.Test(t, func(input string) error { return solve(utilities.DiscardLogger, input, 50) })
+}
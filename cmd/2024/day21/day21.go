@@ -0,0 +1,231 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day21
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day21Cmd represents the day21 command
+var Day21Cmd = &cobra.Command{
+	Use:   "day21",
+	Short: `Keypad Conundrum`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	FewRobots  = 2
+	ManyRobots = 25
+)
+
+type Keypad struct {
+	Keys map[rune]utilities.Point2D
+	Gap  utilities.Point2D
+}
+
+func newKeypad(rows []string) Keypad {
+	keypad := Keypad{Keys: make(map[rune]utilities.Point2D)}
+
+	for y, row := range rows {
+		for x, c := range row {
+			if c == ' ' {
+				keypad.Gap = utilities.NewPoint2D(x, y)
+			} else {
+				keypad.Keys[c] = utilities.NewPoint2D(x, y)
+			}
+		}
+	}
+
+	return keypad
+}
+
+var (
+	NumericKeypad     = newKeypad([]string{"789", "456", "123", " 0A"})
+	DirectionalKeypad = newKeypad([]string{" ^A", "<v>"})
+)
+
+// Paths returns the ways worth trying to move from one key to another: all the
+// horizontal moves then all the vertical ones, or the other way around. Zigzagging
+// is never cheaper, since every change of direction costs the robot above another
+// trip across its keypad. A path that would pass over the gap is left out.
+func (k Keypad) Paths(from, to rune) []string {
+	a, b := k.Keys[from], k.Keys[to]
+
+	horizontal := strings.Repeat(">", max(0, b.X-a.X)) + strings.Repeat("<", max(0, a.X-b.X))
+	vertical := strings.Repeat("v", max(0, b.Y-a.Y)) + strings.Repeat("^", max(0, a.Y-b.Y))
+
+	paths := make([]string, 0, 2)
+
+	if utilities.NewPoint2D(b.X, a.Y) != k.Gap {
+		paths = append(paths, horizontal+vertical+"A")
+	}
+
+	if utilities.NewPoint2D(a.X, b.Y) != k.Gap && horizontal != "" && vertical != "" {
+		paths = append(paths, vertical+horizontal+"A")
+	}
+
+	return paths
+}
+
+// Chain is the numeric keypad followed by Robots directional keypads operated by
+// robots, and finally the directional keypad you press yourself.
+type Chain struct {
+	Robots int
+	cache  map[chainMove]int
+}
+
+type chainMove struct {
+	From, To rune
+	Level    int
+}
+
+func NewChain(robots int) *Chain {
+	return &Chain{Robots: robots, cache: make(map[chainMove]int)}
+}
+
+// Presses counts the buttons you press to type the keys on the keypad at the given
+// level, where level 0 is the numeric keypad. Every robot's arm starts and ends
+// each key on A, so moving between two keys is independent of everything else and
+// can be memoized per level.
+func (c *Chain) Presses(keys string, level int) int {
+	if level > c.Robots {
+		return len(keys)
+	}
+
+	keypad := DirectionalKeypad
+	if level == 0 {
+		keypad = NumericKeypad
+	}
+
+	total := 0
+	previous := 'A'
+
+	for _, key := range keys {
+		move := chainMove{From: previous, To: key, Level: level}
+
+		cost, ok := c.cache[move]
+		if !ok {
+			cost = -1
+
+			for _, path := range keypad.Paths(previous, key) {
+				if p := c.Presses(path, level+1); cost < 0 || p < cost {
+					cost = p
+				}
+			}
+
+			c.cache[move] = cost
+		}
+
+		total += cost
+		previous = key
+	}
+
+	return total
+}
+
+func ParseCodes(fileContents string) ([]string, error) {
+	codes := make([]string, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		code := strings.TrimSpace(line)
+
+		for _, c := range code {
+			if _, ok := NumericKeypad.Keys[c]; !ok {
+				return nil, fmt.Errorf("invalid key '%c' in code '%s'", c, code)
+			}
+		}
+
+		if !strings.HasSuffix(code, "A") {
+			return nil, fmt.Errorf("code '%s' doesn't end in A", code)
+		}
+
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+func (c *Chain) Complexity(code string) int {
+	numeric, err := strconv.Atoi(strings.TrimLeft(strings.TrimSuffix(code, "A"), "0"))
+	if err != nil {
+		numeric = 0
+	}
+
+	return c.Presses(code, 0) * numeric
+}
+
+func ComplexitySum(codes []string, robots int) int {
+	chain := NewChain(robots)
+	sum := 0
+
+	for _, code := range codes {
+		sum += chain.Complexity(code)
+	}
+
+	return sum
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Find the fewest number of button presses you'll need to perform in order to
+	// cause the robot in front of the door to type each code. What is the sum of the
+	// complexities of the five codes on your list?
+
+	codes, err := ParseCodes(fileContents)
+	if err != nil {
+		return err
+	}
+
+	if logger.Enabled(context.Background(), utilities.LevelVerbose) {
+		chain := NewChain(FewRobots)
+		for _, code := range codes {
+			logger.Debug("presses", "code", code, "presses", chain.Presses(code, 0))
+		}
+	}
+
+	utilities.Answer(1, fmt.Sprintf("Sum of complexities with %d robots", FewRobots), ComplexitySum(codes, FewRobots))
+
+	// Part 2: Find the fewest number of button presses you'll need to perform in order to
+	// cause the robot in front of the door to type each code. What is the sum of the
+	// complexities of the five codes on your list?
+
+	utilities.Answer(2, fmt.Sprintf("Sum of complexities with %d robots", ManyRobots), ComplexitySum(codes, ManyRobots))
+
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day21
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleCodes = `029A
+980A
+179A
+456A
+379A`
+
+func TestParseCodes(t *testing.T) {
+	codes, err := ParseCodes(exampleCodes)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"029A", "980A", "179A", "456A", "379A"}, codes)
+
+	_, err = ParseCodes("02BA")
+	assert.Error(t, err)
+
+	_, err = ParseCodes("029")
+	assert.Error(t, err)
+}
+
+func TestPaths(t *testing.T) {
+	// Going straight left from A would pass over the gap.
+	assert.Equal(t, []string{"^<<A"}, NumericKeypad.Paths('A', '1'))
+	assert.Equal(t, []string{">>vvvA"}, NumericKeypad.Paths('7', 'A'))
+	assert.ElementsMatch(t, []string{">^A", "^>A"}, NumericKeypad.Paths('0', '3'))
+	assert.Equal(t, []string{"A"}, NumericKeypad.Paths('5', '5'))
+
+	assert.Equal(t, []string{"v<<A"}, DirectionalKeypad.Paths('A', '<'))
+	assert.Equal(t, []string{">>^A"}, DirectionalKeypad.Paths('<', 'A'))
+}
+
+func TestPresses(t *testing.T) {
+	chain := NewChain(0)
+	assert.Equal(t, len("<A^A>^^AvvvA"), chain.Presses("029A", 0))
+
+	chain = NewChain(1)
+	assert.Equal(t, len("v<<A>>^A<A>AvA<^AA>A<vAAA>^A"), chain.Presses("029A", 0))
+
+	type testCase struct {
+		code     string
+		expected int
+	}
+
+	testCases := []testCase{
+		{code: "029A", expected: 68},
+		{code: "980A", expected: 60},
+		{code: "179A", expected: 68},
+		{code: "456A", expected: 64},
+		{code: "379A", expected: 64},
+	}
+
+	chain = NewChain(FewRobots)
+	for _, test := range testCases {
+		assert.Equal(t, test.expected, chain.Presses(test.code, 0), test.code)
+	}
+}
+
+func TestComplexitySum(t *testing.T) {
+	codes, err := ParseCodes(exampleCodes)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 126384, ComplexitySum(codes, FewRobots))
+	assert.Equal(t, 154115708116294, ComplexitySum(codes, ManyRobots))
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
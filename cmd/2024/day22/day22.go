@@ -0,0 +1,191 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day22
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day22Cmd represents the day22 command
+var Day22Cmd = &cobra.Command{
+	Use:   "day22",
+	Short: `Monkey Market`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	Prune            = 16777216
+	SecretsPerDay    = 2000
+	SequenceLength   = 4
+	priceChangeRange = 19
+)
+
+// NextSecret evolves a secret number once: mix in the secret times 64, then the
+// secret divided by 32, then the secret times 2048, pruning after each step.
+func NextSecret(secret int) int {
+	secret = (secret ^ secret*64) % Prune
+	secret = (secret ^ secret/32) % Prune
+	secret = (secret ^ secret*2048) % Prune
+
+	return secret
+}
+
+func ParseSecrets(fileContents string) ([]int, error) {
+	secrets := make([]int, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		s, err := strconv.Atoi(strings.TrimSpace(line))
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret number '%s'", line)
+		}
+
+		secrets = append(secrets, s)
+	}
+
+	return secrets, nil
+}
+
+func SecretAfter(secret int, count int) int {
+	for i := 0; i < count; i++ {
+		secret = NextSecret(secret)
+	}
+
+	return secret
+}
+
+// Sequence is four consecutive price changes.
+type Sequence [SequenceLength]int
+
+func sequenceIndex(s Sequence) int {
+	index := 0
+	for _, change := range s {
+		index = index*priceChangeRange + change + 9
+	}
+
+	return index
+}
+
+func sequenceFromIndex(index int) Sequence {
+	var s Sequence
+	for i := SequenceLength - 1; i >= 0; i-- {
+		s[i] = index%priceChangeRange - 9
+		index /= priceChangeRange
+	}
+
+	return s
+}
+
+// BestSequence finds the four price changes that earn the most bananas. The
+// monkey sells to each buyer the first time the sequence shows up in their
+// prices, so every buyer's first price after each sequence is added to that
+// sequence's total.
+func BestSequence(secrets []int, count int) (Sequence, int) {
+	totalSequences := 1
+	for i := 0; i < SequenceLength; i++ {
+		totalSequences *= priceChangeRange
+	}
+
+	bananas := make([]int, totalSequences)
+	lastBuyer := make([]int, totalSequences)
+
+	for buyer, secret := range secrets {
+		var changes Sequence
+		price := secret % 10
+
+		for i := 0; i < count; i++ {
+			secret = NextSecret(secret)
+			next := secret % 10
+
+			copy(changes[:], changes[1:])
+			changes[SequenceLength-1] = next - price
+			price = next
+
+			if i < SequenceLength-1 {
+				continue
+			}
+
+			index := sequenceIndex(changes)
+			if lastBuyer[index] == buyer+1 {
+				continue
+			}
+
+			lastBuyer[index] = buyer + 1
+			bananas[index] += price
+		}
+	}
+
+	best := 0
+	for i, b := range bananas {
+		if b > bananas[best] {
+			best = i
+		}
+	}
+
+	return sequenceFromIndex(best), bananas[best]
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Each buyer's secret number evolves into the next secret number in the sequence.
+	// For each buyer, simulate the creation of 2000 new secret numbers. What is the sum of the
+	// 2000th secret number generated by each buyer?
+
+	secrets, err := ParseSecrets(fileContents)
+	if err != nil {
+		return err
+	}
+
+	sum := 0
+	for _, s := range secrets {
+		sum += SecretAfter(s, SecretsPerDay)
+	}
+
+	utilities.Answer(1, fmt.Sprintf("Sum of the %dth secret numbers", SecretsPerDay), sum)
+
+	// Part 2: Figure out the best sequence to tell the monkey so that by looking for that same
+	// sequence of changes in every buyer's future prices, you get the most bananas in total.
+	// What is the most bananas you can get?
+
+	sequence, bananas := BestSequence(secrets, SecretsPerDay)
+
+	logger.Debug("best sequence", "changes", sequence)
+
+	utilities.Answer(2, "Most bananas", bananas)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day22
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextSecret(t *testing.T) {
+	expected := []int{15887950, 16495136, 527345, 704524, 1553684, 12683156, 11100544, 12249484, 7753432, 5908254}
+
+	secret := 123
+	for _, e := range expected {
+		secret = NextSecret(secret)
+		assert.Equal(t, e, secret)
+	}
+}
+
+func TestSecretAfter(t *testing.T) {
+	secrets, err := ParseSecrets("1\n10\n100\n2024")
+	assert.NoError(t, err)
+
+	expected := []int{8685429, 4700978, 15273692, 8667524}
+	sum := 0
+
+	for i, s := range secrets {
+		after := SecretAfter(s, SecretsPerDay)
+		assert.Equal(t, expected[i], after)
+		sum += after
+	}
+
+	assert.Equal(t, 37327623, sum)
+
+	_, err = ParseSecrets("1\nten")
+	assert.Error(t, err)
+}
+
+func TestSequenceIndex(t *testing.T) {
+	for _, s := range []Sequence{{-9, -9, -9, -9}, {9, 9, 9, 9}, {-2, 1, -1, 3}, {0, 0, 0, 0}} {
+		assert.Equal(t, s, sequenceFromIndex(sequenceIndex(s)))
+	}
+}
+
+func TestBestSequence(t *testing.T) {
+	// 123 has prices 3, 0, 6, 5, 4, 4, 6, 4, 4, 2 over its first ten secrets.
+	sequence, bananas := BestSequence([]int{123}, 9)
+	assert.Equal(t, Sequence{-1, -1, 0, 2}, sequence)
+	assert.Equal(t, 6, bananas)
+
+	secrets, err := ParseSecrets("1\n2\n3\n2024")
+	assert.NoError(t, err)
+
+	sequence, bananas = BestSequence(secrets, SecretsPerDay)
+	assert.Equal(t, Sequence{-2, 1, -1, 3}, sequence)
+	assert.Equal(t, 23, bananas)
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
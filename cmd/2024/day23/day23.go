@@ -0,0 +1,207 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day23
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day23Cmd represents the day23 command
+var Day23Cmd = &cobra.Command{
+	Use:   "day23",
+	Short: `LAN Party`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const ChiefPrefix = "t"
+
+type Network struct {
+	Computers []string
+	Links     map[string]map[string]bool
+}
+
+func ParseNetwork(fileContents string) (*Network, error) {
+	network := &Network{Links: make(map[string]map[string]bool)}
+
+	add := func(name string) {
+		if _, ok := network.Links[name]; !ok {
+			network.Links[name] = make(map[string]bool)
+			network.Computers = append(network.Computers, name)
+		}
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(fileContents), "\n") {
+		computers := strings.Split(strings.TrimSpace(line), "-")
+		if len(computers) != 2 || computers[0] == "" || computers[1] == "" || computers[0] == computers[1] {
+			return nil, fmt.Errorf("invalid connection '%s'", line)
+		}
+
+		add(computers[0])
+		add(computers[1])
+
+		network.Links[computers[0]][computers[1]] = true
+		network.Links[computers[1]][computers[0]] = true
+	}
+
+	sort.Strings(network.Computers)
+
+	return network, nil
+}
+
+// Triangles lists every set of three computers that are all connected to each
+// other. Each set is sorted, and only found once by requiring its computers to be
+// in sorted order.
+func (n *Network) Triangles() [][3]string {
+	triangles := make([][3]string, 0)
+
+	for _, a := range n.Computers {
+		for b := range n.Links[a] {
+			if b <= a {
+				continue
+			}
+
+			for c := range n.Links[b] {
+				if c > b && n.Links[a][c] {
+					triangles = append(triangles, [3]string{a, b, c})
+				}
+			}
+		}
+	}
+
+	return triangles
+}
+
+func (n *Network) CountTriangles(prefix string) int {
+	count := 0
+
+	for _, t := range n.Triangles() {
+		if strings.HasPrefix(t[0], prefix) || strings.HasPrefix(t[1], prefix) || strings.HasPrefix(t[2], prefix) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// LargestClique finds the largest set of computers that are all connected to each
+// other with the Bron-Kerbosch algorithm. Choosing a pivot with many neighbours
+// skips branches that could only find a subset of a clique found elsewhere.
+func (n *Network) LargestClique() []string {
+	var best []string
+
+	var search func(clique []string, candidates, excluded map[string]bool)
+
+	search = func(clique []string, candidates, excluded map[string]bool) {
+		if len(candidates) == 0 {
+			if len(excluded) == 0 && len(clique) > len(best) {
+				best = append([]string{}, clique...)
+			}
+			return
+		}
+
+		pivot := ""
+		for _, set := range []map[string]bool{candidates, excluded} {
+			for v := range set {
+				if pivot == "" || len(n.Links[v]) > len(n.Links[pivot]) {
+					pivot = v
+				}
+			}
+		}
+
+		for _, v := range n.Computers {
+			if !candidates[v] || n.Links[pivot][v] {
+				continue
+			}
+
+			nextCandidates := make(map[string]bool)
+			nextExcluded := make(map[string]bool)
+
+			for u := range n.Links[v] {
+				if candidates[u] {
+					nextCandidates[u] = true
+				}
+				if excluded[u] {
+					nextExcluded[u] = true
+				}
+			}
+
+			search(append(clique, v), nextCandidates, nextExcluded)
+
+			delete(candidates, v)
+			excluded[v] = true
+		}
+	}
+
+	all := make(map[string]bool, len(n.Computers))
+	for _, c := range n.Computers {
+		all[c] = true
+	}
+
+	search(nil, all, make(map[string]bool))
+
+	sort.Strings(best)
+
+	return best
+}
+
+func (n *Network) Password() string {
+	return strings.Join(n.LargestClique(), ",")
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Find all the sets of three inter-connected computers. How many contain at least
+	// one computer with a name that starts with t?
+
+	network, err := ParseNetwork(fileContents)
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(1, fmt.Sprintf("Sets of three containing a computer starting with %s", ChiefPrefix), network.CountTriangles(ChiefPrefix))
+
+	// Part 2: The password to get into the LAN party is the name of every computer at the LAN
+	// party, sorted alphabetically, then joined together with commas. What is the password to
+	// get into the LAN party?
+
+	logger.Debug("network", "computers", len(network.Computers), "triangles", utilities.Lazy(func() any { return len(network.Triangles()) }))
+
+	utilities.Answer(2, "LAN party password", network.Password())
+
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day23
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleNetwork = `kh-tc
+qp-kh
+de-cg
+ka-co
+yn-aq
+qp-ub
+cg-tb
+vc-aq
+tb-ka
+wh-tc
+yn-cg
+kh-ub
+ta-co
+de-co
+tc-td
+tb-wq
+wh-td
+ta-ka
+td-qp
+aq-cg
+wq-ub
+ub-vc
+de-ta
+wq-aq
+wq-vc
+wh-yn
+ka-de
+kh-ta
+co-tc
+wh-qp
+tb-vc
+td-yn`
+
+func TestParseNetwork(t *testing.T) {
+	network, err := ParseNetwork(exampleNetwork)
+	assert.NoError(t, err)
+	assert.Len(t, network.Computers, 16)
+	assert.Equal(t, "aq", network.Computers[0])
+	assert.True(t, network.Links["kh"]["tc"])
+	assert.True(t, network.Links["tc"]["kh"])
+
+	_, err = ParseNetwork("kh-tc\nkh")
+	assert.Error(t, err)
+
+	_, err = ParseNetwork("kh-kh")
+	assert.Error(t, err)
+}
+
+func TestTriangles(t *testing.T) {
+	network, err := ParseNetwork(exampleNetwork)
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, [][3]string{
+		{"aq", "cg", "yn"},
+		{"aq", "vc", "wq"},
+		{"co", "de", "ka"},
+		{"co", "de", "ta"},
+		{"co", "ka", "ta"},
+		{"de", "ka", "ta"},
+		{"kh", "qp", "ub"},
+		{"qp", "td", "wh"},
+		{"tb", "vc", "wq"},
+		{"tc", "td", "wh"},
+		{"td", "wh", "yn"},
+		{"ub", "vc", "wq"},
+	}, network.Triangles())
+
+	assert.Equal(t, 7, network.CountTriangles(ChiefPrefix))
+}
+
+func TestLargestClique(t *testing.T) {
+	network, err := ParseNetwork(exampleNetwork)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"co", "de", "ka", "ta"}, network.LargestClique())
+	assert.Equal(t, "co,de,ka,ta", network.Password())
+
+	network, err = ParseNetwork("a-b\nc-d\nd-e\nc-e\ne-f")
+	assert.NoError(t, err)
+	assert.Equal(t, "c,d,e", network.Password())
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
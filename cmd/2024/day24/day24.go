@@ -0,0 +1,283 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day24
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day24Cmd represents the day24 command
+var Day24Cmd = &cobra.Command{
+	Use:   "day24",
+	Short: `Crossed Wires`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+type Gate struct {
+	A   string
+	Op  string
+	B   string
+	Out string
+}
+
+func (g Gate) HasInput(wire string) bool {
+	return g.A == wire || g.B == wire
+}
+
+type Device struct {
+	Wires map[string]int
+	Gates []Gate
+}
+
+func ParseDevice(fileContents string) (*Device, error) {
+	sections := strings.Split(strings.ReplaceAll(strings.TrimSpace(fileContents), "\r\n", "\n"), "\n\n")
+	if len(sections) != 2 {
+		return nil, fmt.Errorf("expected wires and gates separated by a blank line")
+	}
+
+	device := &Device{Wires: make(map[string]int)}
+
+	wireRE := regexp.MustCompile(`^([a-z0-9]+): ([01])$`)
+	gateRE := regexp.MustCompile(`^([a-z0-9]+) (AND|OR|XOR) ([a-z0-9]+) -> ([a-z0-9]+)$`)
+
+	for _, line := range strings.Split(sections[0], "\n") {
+		matches := wireRE.FindStringSubmatch(strings.TrimSpace(line))
+		if matches == nil {
+			return nil, fmt.Errorf("invalid wire '%s'", line)
+		}
+
+		device.Wires[matches[1]] = int(matches[2][0] - '0')
+	}
+
+	for _, line := range strings.Split(sections[1], "\n") {
+		matches := gateRE.FindStringSubmatch(strings.TrimSpace(line))
+		if matches == nil {
+			return nil, fmt.Errorf("invalid gate '%s'", line)
+		}
+
+		device.Gates = append(device.Gates, Gate{A: matches[1], Op: matches[2], B: matches[3], Out: matches[4]})
+	}
+
+	return device, nil
+}
+
+// Swap exchanges the output wires of the gates that drive a and b.
+func (d *Device) Swap(a, b string) {
+	for i := range d.Gates {
+		switch d.Gates[i].Out {
+		case a:
+			d.Gates[i].Out = b
+		case b:
+			d.Gates[i].Out = a
+		}
+	}
+}
+
+// Simulate settles every gate, starting from the initial wire values. Gates wait
+// until both of their inputs have a value, so the gates can be listed in any
+// order; a pass that makes no progress means the remaining gates can never fire.
+func (d *Device) Simulate() (map[string]int, error) {
+	values := make(map[string]int, len(d.Wires)+len(d.Gates))
+	for wire, value := range d.Wires {
+		values[wire] = value
+	}
+
+	pending := append([]Gate{}, d.Gates...)
+
+	for len(pending) > 0 {
+		waiting := pending[:0]
+
+		for _, g := range pending {
+			a, okA := values[g.A]
+			b, okB := values[g.B]
+
+			if !okA || !okB {
+				waiting = append(waiting, g)
+				continue
+			}
+
+			switch g.Op {
+			case "AND":
+				values[g.Out] = a & b
+			case "OR":
+				values[g.Out] = a | b
+			case "XOR":
+				values[g.Out] = a ^ b
+			}
+		}
+
+		if len(waiting) == len(pending) {
+			return nil, fmt.Errorf("%d gates never receive both inputs", len(waiting))
+		}
+
+		pending = waiting
+	}
+
+	return values, nil
+}
+
+// Number combines the wires starting with prefix into a binary number, where the
+// wire ending in 00 is the least significant bit.
+func Number(values map[string]int, prefix string) int {
+	number := 0
+
+	for wire, value := range values {
+		if !strings.HasPrefix(wire, prefix) || value == 0 {
+			continue
+		}
+
+		bit, err := strconv.Atoi(wire[len(prefix):])
+		if err != nil {
+			continue
+		}
+
+		number |= 1 << bit
+	}
+
+	return number
+}
+
+func (d *Device) Output() (int, error) {
+	values, err := d.Simulate()
+	if err != nil {
+		return 0, err
+	}
+
+	return Number(values, "z"), nil
+}
+
+func isInput(wire string) bool {
+	return strings.HasPrefix(wire, "x") || strings.HasPrefix(wire, "y")
+}
+
+// SwappedWires finds the gate outputs that break the structure of a ripple carry
+// adder, where each bit is built as
+//
+//	s = x XOR y, z = s XOR carry, carry' = (x AND y) OR (s AND carry)
+//
+// and the final carry becomes the highest z. Any output that doesn't fit is one
+// half of a swapped pair.
+func (d *Device) SwappedWires() []string {
+	highest := ""
+	for _, g := range d.Gates {
+		if strings.HasPrefix(g.Out, "z") && g.Out > highest {
+			highest = g.Out
+		}
+	}
+
+	feeds := func(wire string, op string) bool {
+		for _, g := range d.Gates {
+			if g.HasInput(wire) && g.Op == op {
+				return true
+			}
+		}
+		return false
+	}
+
+	swapped := make(map[string]bool)
+
+	for _, g := range d.Gates {
+		firstBit := g.HasInput("x00")
+
+		switch {
+		case strings.HasPrefix(g.Out, "z") && g.Out != highest && g.Op != "XOR":
+			// Every sum bit but the final carry comes from an XOR.
+			swapped[g.Out] = true
+		case g.Out == highest && g.Op != "OR":
+			// The final carry comes from an OR.
+			swapped[g.Out] = true
+		case g.Op == "XOR" && !isInput(g.A) && !isInput(g.B) && !strings.HasPrefix(g.Out, "z"):
+			// An XOR of intermediate wires is the sum, so it must be a z.
+			swapped[g.Out] = true
+		case g.Op == "XOR" && isInput(g.A) && !firstBit && !feeds(g.Out, "XOR"):
+			// x XOR y is half of a sum, so it must feed the XOR with the carry.
+			swapped[g.Out] = true
+		case g.Op == "AND" && !firstBit && !feeds(g.Out, "OR"):
+			// Both ANDs of a bit are combined into its carry.
+			swapped[g.Out] = true
+		}
+	}
+
+	wires := make([]string, 0, len(swapped))
+	for wire := range swapped {
+		wires = append(wires, wire)
+	}
+
+	sort.Strings(wires)
+
+	return wires
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Ultimately, the system is trying to produce a number by combining the bits on
+	// all wires starting with z. Simulate the system of gates and wires. What decimal number
+	// does it output on the wires starting with z?
+
+	device, err := ParseDevice(fileContents)
+	if err != nil {
+		return err
+	}
+
+	output, err := device.Output()
+	if err != nil {
+		return err
+	}
+
+	utilities.Answer(1, "Output on z wires", output)
+
+	// Part 2: Your system of gates and wires has four pairs of gates which need their output
+	// wires swapped - eight wires in total. Determine which four pairs of gates need their
+	// outputs swapped so that your system correctly performs addition; what do you get if you
+	// sort the names of the eight wires involved in a swap and then join those names with
+	// commas?
+
+	swapped := device.SwappedWires()
+
+	logger.Debug("device", "wires", len(device.Wires), "gates", len(device.Gates))
+
+	if len(swapped) != 8 {
+		return fmt.Errorf("expected 8 swapped wires, found %d", len(swapped))
+	}
+
+	utilities.Answer(2, "Swapped wires", strings.Join(swapped, ","))
+
+	return nil
+}
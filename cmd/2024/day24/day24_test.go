@@ -0,0 +1,134 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day24
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleDevice = `x00: 1
+x01: 1
+x02: 1
+y00: 0
+y01: 1
+y02: 0
+
+x00 AND y00 -> z00
+x01 XOR y01 -> z01
+x02 OR y02 -> z02`
+
+// rippleCarryAdder generates a device that adds the bits-wide numbers x and y.
+func rippleCarryAdder(bits int, x, y int) string {
+	var sb strings.Builder
+
+	for _, prefix := range []struct {
+		Name  string
+		Value int
+	}{{"x", x}, {"y", y}} {
+		for i := 0; i < bits; i++ {
+			fmt.Fprintf(&sb, "%s%02d: %d\n", prefix.Name, i, (prefix.Value>>i)&1)
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString("x00 XOR y00 -> z00\n")
+	sb.WriteString("x00 AND y00 -> c00\n")
+
+	for i := 1; i < bits; i++ {
+		carry := fmt.Sprintf("c%02d", i)
+		if i == bits-1 {
+			carry = fmt.Sprintf("z%02d", bits)
+		}
+
+		fmt.Fprintf(&sb, "x%02d XOR y%02d -> s%02d\n", i, i, i)
+		fmt.Fprintf(&sb, "y%02d AND x%02d -> a%02d\n", i, i, i)
+		fmt.Fprintf(&sb, "c%02d XOR s%02d -> z%02d\n", i-1, i, i)
+		fmt.Fprintf(&sb, "s%02d AND c%02d -> b%02d\n", i, i-1, i)
+		fmt.Fprintf(&sb, "a%02d OR b%02d -> %s\n", i, i, carry)
+	}
+
+	return sb.String()
+}
+
+func TestParseDevice(t *testing.T) {
+	device, err := ParseDevice(exampleDevice)
+	assert.NoError(t, err)
+	assert.Len(t, device.Wires, 6)
+	assert.Equal(t, 1, device.Wires["x00"])
+	assert.Equal(t, 0, device.Wires["y02"])
+	assert.Equal(t, []Gate{
+		{A: "x00", Op: "AND", B: "y00", Out: "z00"},
+		{A: "x01", Op: "XOR", B: "y01", Out: "z01"},
+		{A: "x02", Op: "OR", B: "y02", Out: "z02"},
+	}, device.Gates)
+
+	_, err = ParseDevice("x00: 1\n\nx00 NAND y00 -> z00")
+	assert.Error(t, err)
+
+	_, err = ParseDevice("x00 AND y00 -> z00")
+	assert.Error(t, err)
+}
+
+func TestOutput(t *testing.T) {
+	device, err := ParseDevice(exampleDevice)
+	assert.NoError(t, err)
+
+	output, err := device.Output()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, output)
+
+	// Gates listed before their inputs are available still settle.
+	device, err = ParseDevice("x00: 1\ny00: 1\n\nab AND y00 -> z00\nx00 OR y00 -> ab")
+	assert.NoError(t, err)
+
+	output, err = device.Output()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, output)
+
+	device, err = ParseDevice("x00: 1\n\nx00 AND ab -> z00")
+	assert.NoError(t, err)
+
+	_, err = device.Output()
+	assert.Error(t, err)
+
+	for _, tc := range []struct{ X, Y int }{{0, 0}, {1, 1}, {12345, 54321}, {65535, 65535}} {
+		device, err = ParseDevice(rippleCarryAdder(16, tc.X, tc.Y))
+		assert.NoError(t, err)
+
+		output, err = device.Output()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.X+tc.Y, output)
+	}
+}
+
+func TestSwappedWires(t *testing.T) {
+	device, err := ParseDevice(rippleCarryAdder(16, 1234, 4321))
+	assert.NoError(t, err)
+	assert.Empty(t, device.SwappedWires())
+
+	// An adder with nothing to fix has no part 2 answer.
+	assert.ErrorContains(t, day(utilities.DiscardLogger, rippleCarryAdder(16, 1234, 4321)), "expected 8 swapped wires, found 0")
+
+	device.Swap("z03", "c03")
+	device.Swap("s07", "a07")
+	device.Swap("z10", "b10")
+	device.Swap("z15", "a15")
+
+	output, err := device.Output()
+	assert.NoError(t, err)
+	assert.NotEqual(t, 1234+4321, output)
+
+	assert.Equal(t, []string{"a07", "a15", "b10", "c03", "s07", "z03", "z10", "z15"}, device.SwappedWires())
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
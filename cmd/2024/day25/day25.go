@@ -0,0 +1,153 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day25
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+)
+
+// Day25Cmd represents the day25 command
+var Day25Cmd = &cobra.Command{
+	Use:   "day25",
+	Short: `Code Chronicle`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := utilities.GetInputPath(cmd)
+		var fileContents = ""
+
+		if inputPath != "" {
+			var err error
+			df, err := os.Open(inputPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			defer df.Close()
+
+			fileBytes, err := io.ReadAll(df)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fileContents = string(fileBytes)
+		}
+
+		err := day(utilities.GetLogger(cmd), string(fileContents))
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+const (
+	SchematicWidth  = 5
+	SchematicHeight = 7
+)
+
+// Heights counts the filled cells in each column, not including the full row at
+// the top of a lock or the bottom of a key.
+type Heights [SchematicWidth]int
+
+type Schematics struct {
+	Locks []Heights
+	Keys  []Heights
+}
+
+func ParseSchematics(fileContents string) (*Schematics, error) {
+	schematics := &Schematics{}
+
+	for i, block := range strings.Split(strings.ReplaceAll(strings.TrimSpace(fileContents), "\r\n", "\n"), "\n\n") {
+		rows := strings.Split(block, "\n")
+		if len(rows) != SchematicHeight {
+			return nil, fmt.Errorf("schematic %d has %d rows, expected %d", i, len(rows), SchematicHeight)
+		}
+
+		var heights Heights
+
+		for y, row := range rows {
+			row = strings.TrimSpace(row)
+			if len(row) != SchematicWidth {
+				return nil, fmt.Errorf("schematic %d row %d has width %d, expected %d", i, y, len(row), SchematicWidth)
+			}
+
+			for x, c := range row {
+				switch c {
+				case '#':
+					heights[x]++
+				case '.':
+				default:
+					return nil, fmt.Errorf("invalid character '%c' in schematic %d", c, i)
+				}
+			}
+		}
+
+		for x := range heights {
+			heights[x]--
+		}
+
+		switch {
+		case strings.TrimSpace(rows[0]) == strings.Repeat("#", SchematicWidth):
+			schematics.Locks = append(schematics.Locks, heights)
+		case strings.TrimSpace(rows[SchematicHeight-1]) == strings.Repeat("#", SchematicWidth):
+			schematics.Keys = append(schematics.Keys, heights)
+		default:
+			return nil, fmt.Errorf("schematic %d is neither a lock nor a key", i)
+		}
+	}
+
+	return schematics, nil
+}
+
+// Fits reports whether a key fits a lock without any column overlapping. Keys
+// don't need to fill the lock exactly.
+func Fits(lock, key Heights) bool {
+	for x := range lock {
+		if lock[x]+key[x] > SchematicHeight-2 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Schematics) FittingPairs() int {
+	count := 0
+
+	for _, lock := range s.Locks {
+		for _, key := range s.Keys {
+			if Fits(lock, key) {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+func day(logger *slog.Logger, fileContents string) error {
+	// Part 1: Analyze your lock and key schematics. How many unique lock/key pairs fit
+	// together without overlapping in any column?
+
+	schematics, err := ParseSchematics(fileContents)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("schematics", "locks", len(schematics.Locks), "keys", len(schematics.Keys))
+
+	utilities.Answer(1, "Lock/key pairs that fit", schematics.FittingPairs())
+
+	// Part 2: You deliver the chronicle to the Chief Historian, which means every
+	// star has been collected. Merry Christmas!
+
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package TwentyTwentyFour_day25
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleSchematics = `#####
+.####
+.####
+.####
+.#.#.
+.#...
+.....
+
+#####
+##.##
+.#.##
+...##
+...#.
+...#.
+.....
+
+.....
+#....
+#....
+#...#
+#.#.#
+#.###
+#####
+
+.....
+.....
+#.#..
+###..
+###.#
+###.#
+#####
+
+.....
+.....
+.....
+#....
+#.#..
+#.#.#
+#####`
+
+func TestParseSchematics(t *testing.T) {
+	schematics, err := ParseSchematics(exampleSchematics)
+	assert.NoError(t, err)
+	assert.Equal(t, []Heights{{0, 5, 3, 4, 3}, {1, 2, 0, 5, 3}}, schematics.Locks)
+	assert.Equal(t, []Heights{{5, 0, 2, 1, 3}, {4, 3, 4, 0, 2}, {3, 0, 2, 0, 1}}, schematics.Keys)
+
+	_, err = ParseSchematics("#####\n.....")
+	assert.Error(t, err)
+
+	_, err = ParseSchematics(".....\n#....\n.....\n.....\n.....\n.....\n.....")
+	assert.Error(t, err)
+}
+
+func TestFits(t *testing.T) {
+	schematics, err := ParseSchematics(exampleSchematics)
+	assert.NoError(t, err)
+
+	assert.False(t, Fits(schematics.Locks[0], schematics.Keys[0]))
+	assert.False(t, Fits(schematics.Locks[0], schematics.Keys[1]))
+	assert.True(t, Fits(schematics.Locks[0], schematics.Keys[2]))
+	assert.True(t, Fits(schematics.Locks[1], schematics.Keys[1]))
+
+	assert.Equal(t, 3, schematics.FittingPairs())
+}
+
+func TestExamples(t *testing.T) {
+	examples.Test(t, func(input string) error { return day(utilities.DiscardLogger, input) })
+}
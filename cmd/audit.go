@@ -0,0 +1,112 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/d1r7y/adventofcode/utilities/audit"
+	"github.com/d1r7y/adventofcode/utilities/metadata"
+	"github.com/spf13/cobra"
+)
+
+var auditRoot string
+
+// auditTitles finds days whose description isn't the title in their metadata, or
+// that have no metadata at all.
+func auditTitles(root *cobra.Command) []string {
+	problems := make([]string, 0)
+
+	for _, yearCmd := range root.Commands() {
+		if _, err := strconv.Atoi(yearCmd.Name()); err != nil {
+			continue
+		}
+
+		for _, dayCmd := range yearCmd.Commands() {
+			year, day, ok := dayOf(dayCmd)
+			if !ok {
+				continue
+			}
+
+			d, ok := metadata.Lookup(year, day)
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%d day %d: no %s", year, day, metadata.FileName))
+				continue
+			}
+
+			if d.Title != dayCmd.Short {
+				problems = append(problems, fmt.Sprintf("%d day %d: Short is '%s' but %s says '%s'", year, day, dayCmd.Short, metadata.FileName, d.Title))
+			}
+		}
+	}
+
+	return problems
+}
+
+// AuditCmd looks for copied code and mislabelled days
+var AuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Look for code copied between days and mislabelled days",
+	Long: `Look for packages and functions copied between days, which usually means a day
+solves some other day's puzzle, and for days whose description doesn't match the
+title in their puzzle.yaml. Functions match when they're the same up to the names
+of their identifiers.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+		problems := 0
+
+		functions, err := audit.Scan(auditRoot)
+		if err != nil {
+			return err
+		}
+
+		if pairs := audit.DuplicatePackages(functions); len(pairs) > 0 {
+			fmt.Fprintf(out, "Duplicated packages:\n")
+			for _, pair := range pairs {
+				fmt.Fprintf(out, "  %s\n", pair)
+			}
+			problems += len(pairs)
+		}
+
+		if groups := audit.Duplicates(functions); len(groups) > 0 {
+			fmt.Fprintf(out, "Duplicated functions:\n")
+			for _, group := range groups {
+				for i, f := range group {
+					separator := "  "
+					if i > 0 {
+						separator = "    = "
+					}
+					fmt.Fprintf(out, "%s%s\n", separator, f)
+				}
+			}
+			problems += len(groups)
+		}
+
+		if titles := auditTitles(cmd.Root()); len(titles) > 0 {
+			fmt.Fprintf(out, "Titles:\n")
+			for _, title := range titles {
+				fmt.Fprintf(out, "  %s\n", title)
+			}
+			problems += len(titles)
+		}
+
+		if problems > 0 {
+			return fmt.Errorf("audit found %d problems", problems)
+		}
+
+		fmt.Fprintf(out, "No problems found in %d functions\n", len(functions))
+
+		return nil
+	},
+}
+
+func init() {
+	AuditCmd.Flags().StringVar(&auditRoot, "root", "cmd", "directory holding the days' packages")
+
+	RootCmd.AddCommand(AuditCmd)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/audit"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditTitles(t *testing.T) {
+	assert.Empty(t, auditTitles(RootCmd))
+
+	root := &cobra.Command{Use: "advent"}
+	year := &cobra.Command{Use: "2024"}
+	year.AddCommand(&cobra.Command{Use: "day06", Short: "Guard Gallivant - NOT COMPLETED"})
+	year.AddCommand(&cobra.Command{Use: "day26", Short: "Nothing"})
+	root.AddCommand(year)
+
+	assert.Equal(t, []string{
+		"2024 day 6: Short is 'Guard Gallivant - NOT COMPLETED' but puzzle.yaml says 'Guard Gallivant'",
+		"2024 day 26: no puzzle.yaml",
+	}, auditTitles(root))
+}
+
+// TestAuditDuplicates keeps copied days from creeping back in.
+func TestAuditDuplicates(t *testing.T) {
+	functions, err := audit.Scan(".")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, functions)
+
+	assert.Empty(t, audit.DuplicatePackages(functions))
+	assert.Empty(t, audit.Duplicates(functions))
+}
@@ -0,0 +1,81 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/debugger"
+	"github.com/spf13/cobra"
+)
+
+// DebugCmd steps through a day's simulation interactively
+var DebugCmd = &cobra.Command{
+	Use:   "debug <year> <day>",
+	Short: "Step through a day's simulation interactively",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		year, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid year '%s'", args[0])
+		}
+
+		day, err := strconv.Atoi(strings.TrimPrefix(args[1], "day"))
+		if err != nil {
+			return fmt.Errorf("invalid day '%s'", args[1])
+		}
+
+		factory, ok := debugger.Lookup(year, day)
+		if !ok {
+			return fmt.Errorf("%d day %d has no simulation to debug", year, day)
+		}
+
+		if err := resolveInput(cmd, year, day); err != nil {
+			return err
+		}
+
+		df, err := os.Open(utilities.GetInputPath(cmd))
+		if err != nil {
+			return err
+		}
+
+		defer df.Close()
+
+		fileContents, err := io.ReadAll(df)
+		if err != nil {
+			return err
+		}
+
+		simulation, err := factory(string(fileContents))
+		if err != nil {
+			return err
+		}
+
+		return debugger.NewSession(simulation).Run(cmd.InOrStdin(), cmd.OutOrStdout())
+	},
+}
+
+func debuggableDays() string {
+	days := make([]string, 0)
+
+	for _, d := range debugger.Registered() {
+		days = append(days, fmt.Sprintf("%d %d", d.Year, d.Day))
+	}
+
+	return strings.Join(days, ", ")
+}
+
+func init() {
+	// Days register their simulations as they're initialized, which happens
+	// before this package's init.
+	DebugCmd.Long = "Step through a day's simulation interactively. Days with a simulation: " + debuggableDays()
+
+	RootCmd.AddCommand(DebugCmd)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities/examples"
+	"github.com/spf13/cobra"
+)
+
+var examplesYear int
+var examplesDay int
+var examplesPage string
+
+// ExamplesCmd extracts the examples from a puzzle page into a day's testdata
+var ExamplesCmd = &cobra.Command{
+	Use:   "examples",
+	Short: "Extract the examples from a puzzle page into a day's testdata",
+	Long: `Extract the example inputs from a puzzle page, with the answers the puzzle gives
+for them, into testdata next to the day's package. The page is read from --page,
+a puzzle page saved from a browser, or fetched from the site.
+
+A day's tests run the fixtures with examples.Test.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if examplesYear == 0 || examplesDay == 0 {
+			return fmt.Errorf("--year and --day are required")
+		}
+
+		dayDir := filepath.Join("cmd", fmt.Sprintf("%d", examplesYear), fmt.Sprintf("day%02d", examplesDay))
+		if _, err := os.Stat(dayDir); err != nil {
+			return fmt.Errorf("no package for %d day %d: %w", examplesYear, examplesDay, err)
+		}
+
+		var page string
+
+		if examplesPage != "" {
+			contents, err := os.ReadFile(examplesPage)
+			if err != nil {
+				return err
+			}
+
+			page = string(contents)
+		} else {
+			client, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			page, err = client.Get(fmt.Sprintf("/%d/day/%d", examplesYear, examplesDay))
+			if err != nil {
+				return err
+			}
+		}
+
+		extracted := examples.Extract(page)
+		if len(extracted) == 0 {
+			return fmt.Errorf("no examples with answers found")
+		}
+
+		testdata := filepath.Join(dayDir, "testdata")
+		if err := examples.Write(testdata, extracted); err != nil {
+			return err
+		}
+
+		for _, example := range extracted {
+			answers := make([]string, 0, len(example.Answers))
+			for part, answer := range example.Answers {
+				answers = append(answers, fmt.Sprintf("part %d: %s", part, answer))
+			}
+			sort.Strings(answers)
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Wrote %s (%s)\n", filepath.Join(testdata, fmt.Sprintf("example%d.txt", example.Number)), strings.Join(answers, ", "))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	ExamplesCmd.Flags().IntVar(&examplesYear, "year", 0, "year of the puzzle")
+	ExamplesCmd.Flags().IntVar(&examplesDay, "day", 0, "day of the puzzle")
+	ExamplesCmd.Flags().StringVar(&examplesPage, "page", "", "saved puzzle page to read instead of fetching it")
+
+	RootCmd.AddCommand(ExamplesCmd)
+}
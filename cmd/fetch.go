@@ -0,0 +1,169 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d1r7y/adventofcode/utilities/aoc"
+	"github.com/spf13/cobra"
+)
+
+var fetchYear int
+var fetchDay int
+var baseURL string
+
+// FetchCmd downloads puzzle inputs into input_files
+var FetchCmd = &cobra.Command{
+	Use:   "fetch",
+	Short: "Download puzzle inputs into input_files",
+	Long: `Download puzzle inputs into input_files/<year>/dayNN_input.txt. Inputs that are
+already there are never fetched again. Without --day, every puzzle of the year
+that has been released is fetched.
+
+The session cookie of a logged in browser is read from ` + aoc.SessionEnv + `, or from
+the session file in the advent config directory.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if fetchYear == 0 {
+			return fmt.Errorf("--year is required")
+		}
+
+		days := []int{fetchDay}
+		if fetchDay == 0 {
+			days = days[:0]
+			for day := 1; day <= 25 && aoc.Released(fetchYear, day, time.Now()); day++ {
+				days = append(days, day)
+			}
+		} else if fetchDay < 1 || fetchDay > 25 {
+			return fmt.Errorf("invalid day %d", fetchDay)
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		for _, day := range days {
+			path := aoc.InputPath(".", fetchYear, day)
+
+			fetched, err := client.Fetch(fetchYear, day, path)
+			if err != nil {
+				return err
+			}
+
+			if fetched {
+				fmt.Fprintf(cmd.OutOrStdout(), "Fetched %s\n", path)
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "Already have %s\n", path)
+			}
+		}
+
+		return nil
+	},
+}
+
+func newClient() (*aoc.Client, error) {
+	session, err := aoc.Session()
+	if err != nil {
+		return nil, err
+	}
+
+	client := aoc.NewClient(session)
+	if baseURL != "" {
+		client.BaseURL = baseURL
+	}
+
+	return client, nil
+}
+
+// dayOf works out which puzzle a day command like "advent 2024 day13" solves.
+func dayOf(cmd *cobra.Command) (int, int, bool) {
+	if cmd.Parent() == nil {
+		return 0, 0, false
+	}
+
+	year, err := strconv.Atoi(cmd.Parent().Name())
+	if err != nil {
+		return 0, 0, false
+	}
+
+	day, err := strconv.Atoi(strings.TrimPrefix(cmd.Name(), "day"))
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return year, day, true
+}
+
+func isTerminal(in io.Reader) bool {
+	f, ok := in.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := f.Stat()
+
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// offerFetch asks whether to download a missing input, and does if the answer is
+// yes.
+func offerFetch(in io.Reader, out io.Writer, year, day int, path string, client func() (*aoc.Client, error)) error {
+	fmt.Fprintf(out, "%s is missing. Fetch %d day %d? [y/N] ", path, year, day)
+
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if answer != "y" && answer != "yes" {
+		return nil
+	}
+
+	c, err := client()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Fetch(year, day, path)
+
+	return err
+}
+
+// resolveInput points a day at its file in input_files when --input isn't given,
+// and offers to fetch the input when that file is missing.
+func resolveInput(cmd *cobra.Command, year, day int) error {
+	path, err := cmd.Flags().GetString("input")
+	if err != nil {
+		return err
+	}
+
+	if path == "" {
+		path = aoc.InputPath(".", year, day)
+
+		if err := cmd.Flags().Set("input", path); err != nil {
+			return err
+		}
+	}
+
+	if _, err := os.Stat(path); err == nil || !isTerminal(cmd.InOrStdin()) {
+		return nil
+	}
+
+	return offerFetch(cmd.InOrStdin(), cmd.ErrOrStderr(), year, day, path, newClient)
+}
+
+func init() {
+	FetchCmd.Flags().IntVar(&fetchYear, "year", 0, "year of the puzzles")
+	FetchCmd.Flags().IntVar(&fetchDay, "day", 0, "day of the puzzle, or every released day if not given")
+	RootCmd.PersistentFlags().StringVar(&baseURL, "base-url", "", "Advent of Code site to talk to (default "+aoc.DefaultBaseURL+", or "+aoc.BaseURLEnv+")")
+
+	RootCmd.AddCommand(FetchCmd)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/aoc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDayOf(t *testing.T) {
+	dayCmd, _, err := RootCmd.Find([]string{"2024", "day13"})
+	assert.NoError(t, err)
+
+	year, day, ok := dayOf(dayCmd)
+	assert.True(t, ok)
+	assert.Equal(t, 2024, year)
+	assert.Equal(t, 13, day)
+
+	_, _, ok = dayOf(FetchCmd)
+	assert.False(t, ok)
+
+	_, _, ok = dayOf(RootCmd)
+	assert.False(t, ok)
+}
+
+func TestOfferFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("3   4\n4   3\n"))
+	}))
+	defer server.Close()
+
+	client := func() (*aoc.Client, error) {
+		return &aoc.Client{BaseURL: server.URL, Session: "secret", HTTPClient: server.Client()}, nil
+	}
+
+	path := aoc.InputPath(t.TempDir(), 2024, 1)
+
+	var out bytes.Buffer
+	assert.NoError(t, offerFetch(strings.NewReader("n\n"), &out, 2024, 1, path, client))
+	assert.Equal(t, path+" is missing. Fetch 2024 day 1? [y/N] ", out.String())
+	assert.NoFileExists(t, path)
+
+	assert.NoError(t, offerFetch(strings.NewReader("y\n"), &out, 2024, 1, path, client))
+
+	contents, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "3   4\n4   3\n", string(contents))
+}
+
+func TestResolveInput(t *testing.T) {
+	dayCmd, _, err := RootCmd.Find([]string{"2024", "day13"})
+	assert.NoError(t, err)
+
+	// Parsing merges the root's persistent flags into the day's, as running it does.
+	assert.NoError(t, dayCmd.ParseFlags(nil))
+	defer func() { inputPath = "" }()
+
+	// Not a terminal, so nothing is offered and the default path is filled in.
+	dayCmd.SetIn(strings.NewReader(""))
+	defer dayCmd.SetIn(nil)
+
+	assert.NoError(t, resolveInput(dayCmd, 2024, 13))
+
+	path, err := dayCmd.Flags().GetString("input")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join("input_files", "2024", "day13_input.txt"), path)
+
+	assert.NoError(t, dayCmd.Flags().Set("input", "elsewhere.txt"))
+	assert.NoError(t, resolveInput(dayCmd, 2024, 13))
+
+	path, err = dayCmd.Flags().GetString("input")
+	assert.NoError(t, err)
+	assert.Equal(t, "elsewhere.txt", path)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/d1r7y/adventofcode/utilities/metadata"
+	"github.com/spf13/cobra"
+)
+
+var listTag string
+
+// ListCmd lists every day's puzzle and how far its solution has got
+var ListCmd = &cobra.Command{
+	Use:          "list [year]",
+	Short:        "List every day's puzzle and how far its solution has got",
+	Args:         cobra.MaximumNArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		years := metadata.Years()
+
+		if len(args) == 1 {
+			year, err := strconv.Atoi(args[0])
+			if err != nil || !slices.Contains(years, year) {
+				return fmt.Errorf("no puzzles for year '%s'", args[0])
+			}
+
+			years = []int{year}
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+
+		printed := 0
+
+		for _, year := range years {
+			counts := make(map[metadata.Status]int)
+			lines := make([]string, 0)
+
+			for _, d := range metadata.Year(year) {
+				if listTag != "" && !slices.Contains(d.Tags, listTag) {
+					continue
+				}
+
+				counts[d.Status]++
+				lines = append(lines, fmt.Sprintf("  %d\t%s\t%s\t%s", d.Day, d.Title, d.Status, strings.Join(d.Tags, ", ")))
+			}
+
+			if len(lines) == 0 {
+				continue
+			}
+
+			if printed > 0 {
+				fmt.Fprintln(w)
+			}
+			printed++
+
+			fmt.Fprintf(w, "%d: %d complete, %d partial, %d stub\n", year, counts[metadata.Complete], counts[metadata.Partial], counts[metadata.Stub])
+			for _, line := range lines {
+				fmt.Fprintln(w, line)
+			}
+		}
+
+		return w.Flush()
+	},
+}
+
+// ShowCmd prints a day's puzzle statement
+var ShowCmd = &cobra.Command{
+	Use:          "show <year> <day>",
+	Short:        "Print a day's puzzle statement",
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		year, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid year '%s'", args[0])
+		}
+
+		day, err := strconv.Atoi(strings.TrimPrefix(args[1], "day"))
+		if err != nil {
+			return fmt.Errorf("invalid day '%s'", args[1])
+		}
+
+		d, ok := metadata.Lookup(year, day)
+		if !ok {
+			return fmt.Errorf("no puzzle for %d day %d", year, day)
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), d.Statement())
+
+		fmt.Fprintf(cmd.OutOrStdout(), "\nStatus: %s\n", d.Status)
+		if len(d.Tags) > 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "Tags: %s\n", strings.Join(d.Tags, ", "))
+		}
+
+		return nil
+	},
+}
+
+// describe is a day's description in help output, with its status from the
+// metadata when the day isn't complete.
+func describe(cmd *cobra.Command) string {
+	year, day, ok := dayOf(cmd)
+	if !ok {
+		return cmd.Short
+	}
+
+	if d, ok := metadata.Lookup(year, day); ok && d.Status != metadata.Complete {
+		return fmt.Sprintf("%s (%s)", cmd.Short, d.Status)
+	}
+
+	return cmd.Short
+}
+
+func init() {
+	cobra.AddTemplateFunc("describe", describe)
+	RootCmd.SetUsageTemplate(strings.ReplaceAll(RootCmd.UsageTemplate(), "{{.Short}}", "{{describe .}}"))
+
+	ListCmd.Flags().StringVar(&listTag, "tag", "", "only list puzzles with this tag")
+
+	RootCmd.AddCommand(ListCmd)
+	RootCmd.AddCommand(ShowCmd)
+}
@@ -5,17 +5,94 @@ Copyright © 2021-2024 Cameron Esfahani
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"log"
 	"os"
+	"time"
 
 	TwentyTwentyOne "github.com/d1r7y/adventofcode/cmd/2021"
 	TwentyTwentyTwo "github.com/d1r7y/adventofcode/cmd/2022"
 	TwentyTwentyThree "github.com/d1r7y/adventofcode/cmd/2023"
 	TwentyTwentyFour "github.com/d1r7y/adventofcode/cmd/2024"
+	"github.com/d1r7y/adventofcode/utilities"
 	"github.com/spf13/cobra"
 )
 
 var verbosity int = 0
 var inputPath string
+var timeout time.Duration
+var maxMem string
+var outputFormat string
+var logFilter []string
+var jobs int
+
+var cancelLimits context.CancelFunc = func() {}
+
+// applyProgress picks how a day command shows its progress: as a line redrawn on
+// the terminal, as JSON events with --format ndjson, or not at all when stderr is
+// going somewhere else.
+func applyProgress(w *os.File) error {
+	switch outputFormat {
+	case "text":
+		if isTerminal(w) {
+			utilities.SetProgressReporter(utilities.NewTerminalProgress(w))
+		} else {
+			utilities.SetProgressReporter(utilities.SilentProgress)
+		}
+	case "ndjson":
+		utilities.SetProgressReporter(utilities.NewNDJSONProgress(w))
+	default:
+		return fmt.Errorf("unknown format '%s', use text or ndjson", outputFormat)
+	}
+
+	return nil
+}
+
+// applyLogging hands a day command a logger showing as much as -v asks for, from
+// the days --log-filter picks, as JSON with --format ndjson. Rendered grids keep
+// their colours only when w is a terminal.
+func applyLogging(cmd *cobra.Command, w *os.File) {
+	logger := utilities.NewLogger(w, utilities.LogOptions{
+		Verbosity: verbosity,
+		JSON:      outputFormat == "ndjson",
+		Color:     isTerminal(w),
+		Filter:    logFilter,
+	})
+
+	cmd.SetContext(utilities.WithLogger(utilities.GetContext(cmd), logger))
+}
+
+// applyLimits runs a day command under the limits from --timeout and --max-mem,
+// and with as many workers as -j asks for.
+// Solvers that watch their context stop with a "timed out after" or "ran out of
+// memory" error, and whatever they've answered so far. A watchdog exits with that
+// error for the ones that don't.
+func applyLimits(cmd *cobra.Command) error {
+	var limit uint64
+
+	if maxMem != "" {
+		var err error
+		if limit, err = utilities.ParseByteSize(maxMem); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := utilities.WithLimits(utilities.GetContext(cmd), timeout, limit)
+	cmd.SetContext(utilities.WithWorkers(ctx, jobs))
+
+	disarm := utilities.Watchdog(ctx, utilities.WatchdogGrace, func(cause error) {
+		log.Fatalf("%s: %v", cmd.CommandPath(), cause)
+	})
+
+	cancelLimits()
+	cancelLimits = func() {
+		disarm()
+		cancel()
+	}
+
+	return nil
+}
 
 // RootCmd represents the base command when called without any subcommands
 var RootCmd = &cobra.Command{
@@ -25,6 +102,26 @@ var RootCmd = &cobra.Command{
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
 	// Run: func(cmd *cobra.Command, args []string) { },
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if year, day, ok := dayOf(cmd); ok {
+			if err := applyLimits(cmd); err != nil {
+				return err
+			}
+
+			if err := applyProgress(os.Stderr); err != nil {
+				return err
+			}
+
+			applyLogging(cmd, os.Stderr)
+
+			return resolveInput(cmd, year, day)
+		}
+
+		return nil
+	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		cancelLimits()
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -37,8 +134,13 @@ func Execute() {
 }
 
 func init() {
-	RootCmd.PersistentFlags().CountVarP(&verbosity, "verbose", "v", "verbose output")
+	RootCmd.PersistentFlags().CountVarP(&verbosity, "verbose", "v", "show debug output from solvers; repeat for more")
 	RootCmd.PersistentFlags().StringVarP(&inputPath, "input", "i", "", "input file")
+	RootCmd.PersistentFlags().StringVar(&outputFormat, "format", "text", "how solvers report progress: text, or ndjson for JSON events on stderr")
+	RootCmd.PersistentFlags().StringSliceVar(&logFilter, "log-filter", nil, "only show debug output from these days or years, e.g. 2024/day06")
+	RootCmd.PersistentFlags().IntVarP(&jobs, "jobs", "j", 0, "how many goroutines solvers spread their work over (default one per CPU)")
+	RootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "stop a solver after this long, e.g. 30s (default no limit)")
+	RootCmd.PersistentFlags().StringVar(&maxMem, "max-mem", "", "stop a solver once its heap grows past this size, e.g. 4GiB (default no limit)")
 
 	RootCmd.AddCommand(TwentyTwentyOne.TwentyTwentyOneCmd)
 	RootCmd.AddCommand(TwentyTwentyTwo.TwentyTwentyTwoCmd)
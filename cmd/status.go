@@ -0,0 +1,246 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities/aoc"
+	"github.com/d1r7y/adventofcode/utilities/metadata"
+	"github.com/spf13/cobra"
+)
+
+var statusOutput string
+
+// PartStatus is how far the solution to one part of a puzzle has got.
+type PartStatus string
+
+const (
+	Solved  PartStatus = "solved"
+	Stubbed PartStatus = "stubbed"
+	Missing PartStatus = "missing"
+)
+
+var partSymbols = map[PartStatus]string{Solved: "*", Stubbed: "~", Missing: "."}
+
+// DayProgress is the state of one day: the status of each of its parts, and
+// whether its input is in input_files.
+type DayProgress struct {
+	Day   int          `json:"day"`
+	Parts []PartStatus `json:"parts"`
+	Input bool         `json:"input"`
+}
+
+type YearProgress struct {
+	Year int           `json:"year"`
+	Days []DayProgress `json:"days"`
+}
+
+// Stars counts the solved parts.
+func (y YearProgress) Stars() int {
+	stars := 0
+
+	for _, d := range y.Days {
+		for _, part := range d.Parts {
+			if part == Solved {
+				stars++
+			}
+		}
+	}
+
+	return stars
+}
+
+func (y YearProgress) Inputs() int {
+	inputs := 0
+
+	for _, d := range y.Days {
+		if d.Input {
+			inputs++
+		}
+	}
+
+	return inputs
+}
+
+// partsOf works out the status of a registered day's parts from its metadata. A
+// day without metadata counts as solved. Day 25 has only one part.
+func partsOf(year, day int) []PartStatus {
+	status := metadata.Complete
+
+	if d, ok := metadata.Lookup(year, day); ok {
+		status = d.Status
+	}
+
+	parts := []PartStatus{Solved, Solved}
+
+	switch status {
+	case metadata.Partial:
+		parts = []PartStatus{Solved, Stubbed}
+	case metadata.Stub:
+		parts = []PartStatus{Stubbed, Stubbed}
+	}
+
+	if day == 25 {
+		parts = parts[:1]
+	}
+
+	return parts
+}
+
+// collectProgress builds the matrix from the day commands registered under each
+// year command of root, and the inputs under inputRoot.
+func collectProgress(root *cobra.Command, inputRoot string) []YearProgress {
+	years := make([]YearProgress, 0)
+
+	for _, yearCmd := range root.Commands() {
+		year, err := strconv.Atoi(yearCmd.Name())
+		if err != nil {
+			continue
+		}
+
+		registered := make(map[int]bool)
+		for _, dayCmd := range yearCmd.Commands() {
+			if _, day, ok := dayOf(dayCmd); ok {
+				registered[day] = true
+			}
+		}
+
+		progress := YearProgress{Year: year}
+
+		for day := 1; day <= 25; day++ {
+			d := DayProgress{Day: day}
+
+			if registered[day] {
+				d.Parts = partsOf(year, day)
+			} else if day == 25 {
+				d.Parts = []PartStatus{Missing}
+			} else {
+				d.Parts = []PartStatus{Missing, Missing}
+			}
+
+			if _, err := os.Stat(aoc.InputPath(inputRoot, year, day)); err == nil {
+				d.Input = true
+			}
+
+			progress.Days = append(progress.Days, d)
+		}
+
+		years = append(years, progress)
+	}
+
+	sort.Slice(years, func(i, j int) bool { return years[i].Year < years[j].Year })
+
+	return years
+}
+
+// cell draws a day as a symbol per part, followed by ? when its input is
+// missing.
+func (d DayProgress) cell() string {
+	var b strings.Builder
+
+	for _, part := range d.Parts {
+		b.WriteString(partSymbols[part])
+	}
+
+	if !d.Input {
+		b.WriteString("?")
+	}
+
+	return b.String()
+}
+
+const statusLegend = "* solved, ~ stubbed, . missing, ? no input"
+
+func writeStatusText(w io.Writer, years []YearProgress) {
+	fmt.Fprintf(w, "%-6s", "")
+	for day := 1; day <= 25; day++ {
+		fmt.Fprintf(w, "%-4d", day)
+	}
+	fmt.Fprintf(w, "stars  inputs\n")
+
+	for _, y := range years {
+		fmt.Fprintf(w, "%-6d", y.Year)
+		for _, d := range y.Days {
+			fmt.Fprintf(w, "%-4s", d.cell())
+		}
+		fmt.Fprintf(w, "%-7d%d\n", y.Stars(), y.Inputs())
+	}
+
+	fmt.Fprintf(w, "\n%s\n", statusLegend)
+}
+
+func writeStatusMarkdown(w io.Writer, years []YearProgress) {
+	header := []string{"Year"}
+	rule := []string{"---"}
+
+	for day := 1; day <= 25; day++ {
+		header = append(header, strconv.Itoa(day))
+		rule = append(rule, ":-:")
+	}
+
+	header = append(header, "Stars", "Inputs")
+	rule = append(rule, "--:", "--:")
+
+	fmt.Fprintf(w, "| %s |\n", strings.Join(header, " | "))
+	fmt.Fprintf(w, "| %s |\n", strings.Join(rule, " | "))
+
+	for _, y := range years {
+		row := []string{strconv.Itoa(y.Year)}
+
+		for _, d := range y.Days {
+			// Markdown would read a run of * as emphasis.
+			row = append(row, "`"+d.cell()+"`")
+		}
+
+		row = append(row, strconv.Itoa(y.Stars()), strconv.Itoa(y.Inputs()))
+
+		fmt.Fprintf(w, "| %s |\n", strings.Join(row, " | "))
+	}
+
+	fmt.Fprintf(w, "\n%s\n", statusLegend)
+}
+
+// StatusCmd shows which parts of which days are solved
+var StatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show which parts of which days are solved",
+	Long: `Show a year by day matrix of which parts are solved, stubbed or missing, and which
+inputs are in input_files. --output markdown makes a table for a README, and
+--output json gives the same data for scripts.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		years := collectProgress(cmd.Root(), ".")
+
+		switch statusOutput {
+		case "text":
+			writeStatusText(cmd.OutOrStdout(), years)
+		case "markdown":
+			writeStatusMarkdown(cmd.OutOrStdout(), years)
+		case "json":
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			encoder.SetIndent("", "  ")
+
+			return encoder.Encode(years)
+		default:
+			return fmt.Errorf("unknown output '%s', use text, markdown or json", statusOutput)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	StatusCmd.Flags().StringVar(&statusOutput, "output", "text", "output format: text, markdown or json")
+
+	RootCmd.AddCommand(StatusCmd)
+}
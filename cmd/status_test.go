@@ -0,0 +1,121 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities/aoc"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTree(t *testing.T) (*cobra.Command, string) {
+	root := &cobra.Command{Use: "advent"}
+
+	// 1998 has no metadata, so its days count as solved.
+	year := &cobra.Command{Use: "1998"}
+	year.AddCommand(&cobra.Command{Use: "day01", Short: "One", Run: func(*cobra.Command, []string) {}})
+	year.AddCommand(&cobra.Command{Use: "day02", Short: "Two", Run: func(*cobra.Command, []string) {}})
+	year.AddCommand(&cobra.Command{Use: "day25", Short: "Last", Run: func(*cobra.Command, []string) {}})
+	root.AddCommand(year)
+	root.AddCommand(&cobra.Command{Use: "status"})
+
+	inputRoot := t.TempDir()
+	input := aoc.InputPath(inputRoot, 1998, 1)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(input), 0755))
+	assert.NoError(t, os.WriteFile(input, []byte("1\n"), 0644))
+
+	return root, inputRoot
+}
+
+func TestCollectProgress(t *testing.T) {
+	root, inputRoot := testTree(t)
+
+	years := collectProgress(root, inputRoot)
+	assert.Len(t, years, 1)
+	assert.Equal(t, 1998, years[0].Year)
+	assert.Len(t, years[0].Days, 25)
+
+	assert.Equal(t, DayProgress{Day: 1, Parts: []PartStatus{Solved, Solved}, Input: true}, years[0].Days[0])
+	assert.Equal(t, DayProgress{Day: 2, Parts: []PartStatus{Solved, Solved}}, years[0].Days[1])
+	assert.Equal(t, DayProgress{Day: 3, Parts: []PartStatus{Missing, Missing}}, years[0].Days[2])
+	assert.Equal(t, DayProgress{Day: 25, Parts: []PartStatus{Solved}}, years[0].Days[24])
+
+	assert.Equal(t, 5, years[0].Stars())
+	assert.Equal(t, 1, years[0].Inputs())
+
+	// The real tree reads status from the metadata.
+	for _, y := range collectProgress(RootCmd, inputRoot) {
+		if y.Year == 2022 {
+			assert.Equal(t, []PartStatus{Stubbed, Stubbed}, y.Days[15].Parts)
+			assert.Equal(t, []PartStatus{Missing, Missing}, y.Days[18].Parts)
+		}
+	}
+}
+
+func TestWriteStatus(t *testing.T) {
+	root, inputRoot := testTree(t)
+	years := collectProgress(root, inputRoot)
+
+	var text bytes.Buffer
+	writeStatusText(&text, years)
+
+	lines := strings.Split(text.String(), "\n")
+	assert.True(t, strings.HasPrefix(lines[1], "1998  **  **? ..? ..? "), lines[1])
+	assert.True(t, strings.HasSuffix(lines[1], "*?  5      1"), lines[1])
+
+	var markdown bytes.Buffer
+	writeStatusMarkdown(&markdown, years)
+
+	lines = strings.Split(markdown.String(), "\n")
+	assert.True(t, strings.HasPrefix(lines[0], "| Year | 1 | 2 | 3 |"))
+	assert.True(t, strings.HasPrefix(lines[1], "| --- | :-: |"))
+	assert.True(t, strings.HasPrefix(lines[2], "| 1998 | `**` | `**?` | `..?` |"), lines[2])
+	assert.True(t, strings.HasSuffix(lines[2], "| `*?` | 5 | 1 |"), lines[2])
+
+	text.Reset()
+	writeStatusText(&text, []YearProgress{{Year: 1999, Days: []DayProgress{{Day: 1, Parts: []PartStatus{Solved, Stubbed}}}}})
+	assert.Contains(t, text.String(), "1999  *~?")
+}
+
+func TestStatusJSON(t *testing.T) {
+	var out bytes.Buffer
+
+	StatusCmd.SetOut(&out)
+	defer StatusCmd.SetOut(nil)
+
+	statusOutput = "json"
+	defer func() { statusOutput = "text" }()
+
+	assert.NoError(t, StatusCmd.RunE(StatusCmd, nil))
+	assert.Contains(t, out.String(), `"year": 2024`)
+	assert.Contains(t, out.String(), `"parts": [`)
+
+	statusOutput = "yaml"
+	assert.ErrorContains(t, StatusCmd.RunE(StatusCmd, nil), "unknown output 'yaml'")
+}
+
+func TestStatusFlags(t *testing.T) {
+	// --format is the root's, for how solvers report progress.
+	assert.Nil(t, StatusCmd.LocalNonPersistentFlags().Lookup("format"))
+	assert.NotNil(t, StatusCmd.LocalNonPersistentFlags().Lookup("output"))
+}
+
+func TestDescribe(t *testing.T) {
+	day17, _, err := RootCmd.Find([]string{"2023", "day17"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Clumsy Crucible (stub)", describe(day17))
+
+	day01, _, err := RootCmd.Find([]string{"2024", "day01"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Historian Hysteria", describe(day01))
+
+	assert.Equal(t, RootCmd.Short, describe(RootCmd))
+}
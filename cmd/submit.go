@@ -0,0 +1,133 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/d1r7y/adventofcode/utilities/aoc"
+	"github.com/spf13/cobra"
+)
+
+var submitYear int
+var submitDay int
+var submitPart int
+var submitAnswer string
+
+// SubmitCmd runs a day and submits the answer for one part
+var SubmitCmd = &cobra.Command{
+	Use:   "submit",
+	Short: "Run a day and submit the answer for one part",
+	Long: `Run a day and submit the answer for one part. The answer is the one the day
+reports for that part. Use --answer to submit something else, or for a day that
+doesn't report an answer for the part.
+
+Every attempt is recorded in ` + aoc.AnswerLogPath(".") + `. An answer that was
+already judged, or that an earlier too high or too low verdict rules out, is not
+sent.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if submitYear == 0 || submitDay == 0 {
+			return fmt.Errorf("--year and --day are required")
+		}
+
+		if submitPart != 1 && submitPart != 2 {
+			return fmt.Errorf("invalid part %d", submitPart)
+		}
+
+		answer := submitAnswer
+		if answer == "" {
+			var err error
+			if answer, err = runForAnswer(cmd, submitYear, submitDay, submitPart); err != nil {
+				return err
+			}
+		}
+
+		answerLog, err := aoc.LoadAnswerLog(aoc.AnswerLogPath("."))
+		if err != nil {
+			return err
+		}
+
+		if err := answerLog.Check(submitYear, submitDay, submitPart, answer); err != nil {
+			return err
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Submitting %s for %d day %d part %d\n", answer, submitYear, submitDay, submitPart)
+
+		response, err := client.Submit(submitYear, submitDay, submitPart, answer)
+		if err != nil {
+			return err
+		}
+
+		err = answerLog.Record(aoc.Attempt{Year: submitYear, Day: submitDay, Part: submitPart, Answer: answer, Outcome: response.Outcome, Time: time.Now()})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "%s\n", response.Message)
+
+		if response.Outcome != aoc.Correct {
+			if response.Wait > 0 {
+				return fmt.Errorf("%s, wait %s before trying again", response.Outcome, response.Wait)
+			}
+
+			return fmt.Errorf("%s", response.Outcome)
+		}
+
+		return nil
+	},
+}
+
+// runForAnswer runs a day with its output captured, and returns the answer it
+// reports for a part.
+func runForAnswer(cmd *cobra.Command, year, day, part int) (string, error) {
+	dayCmd, _, err := cmd.Root().Find([]string{fmt.Sprintf("%d", year), fmt.Sprintf("day%02d", day)})
+	if err != nil || dayCmd.Run == nil {
+		return "", fmt.Errorf("no solution for %d day %d", year, day)
+	}
+
+	if err := dayCmd.ParseFlags(nil); err != nil {
+		return "", err
+	}
+
+	if err := applyLimits(dayCmd); err != nil {
+		return "", err
+	}
+
+	defer cancelLimits()
+
+	if err := resolveInput(dayCmd, year, day); err != nil {
+		return "", err
+	}
+
+	answers, _, err := utilities.CollectAnswers(func() { dayCmd.Run(dayCmd, nil) })
+	if err != nil {
+		return "", err
+	}
+
+	answer, ok := answers[part]
+	if !ok {
+		return "", fmt.Errorf("%d day %d reported no answer for part %d, use --answer", year, day, part)
+	}
+
+	return answer, nil
+}
+
+func init() {
+	SubmitCmd.Flags().IntVar(&submitYear, "year", 0, "year of the puzzle")
+	SubmitCmd.Flags().IntVar(&submitDay, "day", 0, "day of the puzzle")
+	SubmitCmd.Flags().IntVar(&submitPart, "part", 1, "part of the puzzle, 1 or 2")
+	SubmitCmd.Flags().StringVar(&submitAnswer, "answer", "", "answer to submit instead of running the day")
+
+	RootCmd.AddCommand(SubmitCmd)
+}
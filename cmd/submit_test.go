@@ -0,0 +1,42 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunForAnswer(t *testing.T) {
+	root := &cobra.Command{Use: "advent"}
+	root.PersistentFlags().String("input", "", "")
+
+	year := &cobra.Command{Use: "1998"}
+	year.AddCommand(&cobra.Command{Use: "day10", Run: func(*cobra.Command, []string) {
+		fmt.Printf("Cycle count: %d\n", 241)
+		utilities.Answer(1, "Total signal strength", 17180)
+	}})
+	root.AddCommand(year)
+
+	input := filepath.Join(t.TempDir(), "day10_input.txt")
+	assert.NoError(t, os.WriteFile(input, []byte("noop\n"), 0644))
+	assert.NoError(t, root.PersistentFlags().Set("input", input))
+
+	answer, err := runForAnswer(root, 1998, 10, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "17180", answer)
+
+	_, err = runForAnswer(root, 1998, 10, 2)
+	assert.ErrorContains(t, err, "reported no answer for part 2")
+
+	_, err = runForAnswer(root, 1998, 11, 1)
+	assert.ErrorContains(t, err, "no solution")
+}
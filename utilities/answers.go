@@ -0,0 +1,88 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+)
+
+var answersMu sync.Mutex
+var collected map[int]string
+
+// Answer prints the answer to a part as "label: answer", and hands it to
+// CollectAnswers when something, like submit, is waiting for it.
+func Answer(part int, label string, answer any) {
+	fmt.Printf("%s: %v\n", label, answer)
+
+	answersMu.Lock()
+	defer answersMu.Unlock()
+
+	if collected != nil {
+		collected[part] = fmt.Sprint(answer)
+	}
+}
+
+// CollectAnswers runs run with its output captured, and returns the answers it
+// gave with Answer keyed by part, along with everything it printed.
+func CollectAnswers(run func()) (map[int]string, string, error) {
+	answers := make(map[int]string)
+
+	answersMu.Lock()
+	collected = answers
+	answersMu.Unlock()
+
+	defer func() {
+		answersMu.Lock()
+		collected = nil
+		answersMu.Unlock()
+	}()
+
+	output, err := CaptureOutput(run)
+	if err != nil {
+		return nil, "", err
+	}
+
+	answersMu.Lock()
+	defer answersMu.Unlock()
+
+	return answers, output, nil
+}
+
+// CaptureOutput collects what run prints, whether with fmt or log.
+func CaptureOutput(run func()) (string, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return "", err
+	}
+
+	stdout := os.Stdout
+	flags := log.Flags()
+
+	os.Stdout = w
+	log.SetOutput(w)
+	log.SetFlags(0)
+
+	captured := make(chan string)
+	go func() {
+		output, _ := io.ReadAll(r)
+		captured <- string(output)
+	}()
+
+	defer func() {
+		os.Stdout = stdout
+		log.SetOutput(os.Stderr)
+		log.SetFlags(flags)
+	}()
+
+	run()
+
+	w.Close()
+
+	return <-captured, nil
+}
@@ -0,0 +1,39 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectAnswers(t *testing.T) {
+	answers, output, err := CollectAnswers(func() {
+		fmt.Printf("Cycle count: %d\n", 241)
+		Answer(1, "Total signal strength", 17180)
+		log.Printf("Prime factors for %d: %v\n", 12, []int{2, 3})
+		Answer(2, "Letters", "EZFPRAKL")
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]string{1: "17180", 2: "EZFPRAKL"}, answers)
+	assert.Equal(t, "Cycle count: 241\nTotal signal strength: 17180\nPrime factors for 12: [2 3]\nLetters: EZFPRAKL\n", output)
+
+	// Nothing is collected once CollectAnswers is done.
+	_, err = CaptureOutput(func() { Answer(1, "Total signal strength", 0) })
+	assert.NoError(t, err)
+	assert.Equal(t, "17180", answers[1])
+}
+
+func TestCaptureOutput(t *testing.T) {
+	output, err := CaptureOutput(func() {
+		fmt.Printf("Part 1: %d\n", 41)
+		log.Printf("Part 2: %d\n", 6)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Part 1: 41\nPart 2: 6\n", output)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package aoc
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// Attempt is one answer submitted to the site, and what it said.
+type Attempt struct {
+	Year    int       `json:"year"`
+	Day     int       `json:"day"`
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Outcome Outcome   `json:"outcome"`
+	Time    time.Time `json:"time"`
+}
+
+// AnswerLog remembers every answer submitted, one JSON object per line, so a wrong
+// answer is never sent twice.
+type AnswerLog struct {
+	Path     string
+	Attempts []Attempt
+}
+
+// AnswerLogPath is where the answer log lives under root.
+func AnswerLogPath(root string) string {
+	return filepath.Join(root, "input_files", "answers.jsonl")
+}
+
+// LoadAnswerLog reads the log at path. A missing log is empty.
+func LoadAnswerLog(path string) (*AnswerLog, error) {
+	l := &AnswerLog{Path: path}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var a Attempt
+		if err := json.Unmarshal(scanner.Bytes(), &a); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+
+		l.Attempts = append(l.Attempts, a)
+	}
+
+	return l, scanner.Err()
+}
+
+// Record adds an attempt to the log, on disk as well as in memory.
+func (l *AnswerLog) Record(a Attempt) error {
+	if err := os.MkdirAll(filepath.Dir(l.Path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(l.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	line, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	l.Attempts = append(l.Attempts, a)
+
+	return nil
+}
+
+// Check returns an error if an answer shouldn't be sent: the part is already
+// solved, the same answer was already judged, or an earlier too high or too low
+// verdict rules it out.
+func (l *AnswerLog) Check(year, day, part int, answer string) error {
+	value, parseErr := strconv.ParseInt(answer, 10, 64)
+
+	for _, a := range l.Attempts {
+		if a.Year != year || a.Day != day || a.Part != part || !a.Outcome.Judged() {
+			continue
+		}
+
+		if a.Outcome == Correct {
+			return fmt.Errorf("already solved with %s", a.Answer)
+		}
+
+		if a.Answer == answer {
+			return fmt.Errorf("%s was already submitted and was %s", answer, a.Outcome)
+		}
+
+		bound, err := strconv.ParseInt(a.Answer, 10, 64)
+		if parseErr != nil || err != nil {
+			continue
+		}
+
+		if a.Outcome == TooHigh && value >= bound {
+			return fmt.Errorf("%s can't be right, %s was too high", answer, a.Answer)
+		}
+
+		if a.Outcome == TooLow && value <= bound {
+			return fmt.Errorf("%s can't be right, %s was too low", answer, a.Answer)
+		}
+	}
+
+	return nil
+}
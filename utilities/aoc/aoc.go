@@ -0,0 +1,242 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+// Package aoc talks to the Advent of Code website: it downloads puzzle inputs into
+// input_files so they only ever need to be fetched once, and submits answers.
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+
+	// UserAgent identifies this tool to the site, as its maintainers ask automated
+	// requests to do.
+	UserAgent = "github.com/d1r7y/adventofcode"
+
+	// DefaultThrottle is the least time between two requests to the site.
+	DefaultThrottle = 3 * time.Second
+
+	// SessionEnv and BaseURLEnv name the environment variables holding the session
+	// cookie and an alternate site to talk to.
+	SessionEnv = "ADVENT_SESSION"
+	BaseURLEnv = "ADVENT_BASE_URL"
+)
+
+// ErrNoSession is returned when no session token is set in the environment or the
+// config file.
+var ErrNoSession = errors.New("no session token")
+
+// Client makes requests to the site with the session cookie of a logged in user.
+// LastRequestPath keeps the time of its last request, so the throttle holds from
+// one run to the next; without it the throttle only covers a single run.
+type Client struct {
+	BaseURL         string
+	Session         string
+	Throttle        time.Duration
+	HTTPClient      *http.Client
+	LastRequestPath string
+
+	last time.Time
+}
+
+// NewClient returns a client for the site named by ADVENT_BASE_URL, or the real
+// site if it isn't set.
+func NewClient(session string) *Client {
+	baseURL := os.Getenv(BaseURLEnv)
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	client := &Client{BaseURL: baseURL, Session: session, Throttle: DefaultThrottle, HTTPClient: http.DefaultClient}
+
+	if lastRequestPath, err := LastRequestPath(); err == nil {
+		client.LastRequestPath = lastRequestPath
+	}
+
+	return client
+}
+
+// LastRequestPath is the cache file recording when the site was last sent a
+// request.
+func LastRequestPath() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cacheDir, "advent", "last-request"), nil
+}
+
+// SessionPath is the config file the session token is read from when it isn't in
+// the environment.
+func SessionPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "advent", "session"), nil
+}
+
+// Session returns the session token from ADVENT_SESSION, or from the config file.
+func Session() (string, error) {
+	if session := strings.TrimSpace(os.Getenv(SessionEnv)); session != "" {
+		return session, nil
+	}
+
+	sessionPath, err := SessionPath()
+	if err != nil {
+		return "", err
+	}
+
+	contents, err := os.ReadFile(sessionPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("%w: set %s or write it to %s", ErrNoSession, SessionEnv, sessionPath)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	session := strings.TrimSpace(string(contents))
+	if session == "" {
+		return "", fmt.Errorf("%w: %s is empty", ErrNoSession, sessionPath)
+	}
+
+	return session, nil
+}
+
+// InputPath is where a day's input lives under root.
+func InputPath(root string, year, day int) string {
+	return filepath.Join(root, "input_files", fmt.Sprintf("%d", year), fmt.Sprintf("day%02d_input.txt", day))
+}
+
+// Released reports whether a day's puzzle has unlocked by now. Puzzles unlock at
+// midnight US Eastern time, which is UTC-5 in December.
+func Released(year, day int, now time.Time) bool {
+	unlock := time.Date(year, time.December, day, 5, 0, 0, 0, time.UTC)
+
+	return !now.Before(unlock)
+}
+
+// wait sleeps until the throttle has passed since the last request, whether this
+// client or an earlier run made it.
+func (c *Client) wait() error {
+	if c.LastRequestPath != "" {
+		if contents, err := os.ReadFile(c.LastRequestPath); err == nil {
+			if last, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(string(contents))); err == nil && last.After(c.last) {
+				c.last = last
+			}
+		}
+	}
+
+	if !c.last.IsZero() {
+		// A time in the future, from a clock that was changed, waits no longer than
+		// the throttle.
+		if remaining := min(c.Throttle-time.Since(c.last), c.Throttle); remaining > 0 {
+			time.Sleep(remaining)
+		}
+	}
+
+	c.last = time.Now()
+
+	if c.LastRequestPath == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.LastRequestPath), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.LastRequestPath, []byte(c.last.Format(time.RFC3339Nano)+"\n"), 0644)
+}
+
+// Do sends a request to the site, waiting first if the last one was too recent,
+// and returns the body of a successful response.
+func (c *Client) Do(request *http.Request) (string, error) {
+	if c.Session == "" {
+		return "", ErrNoSession
+	}
+
+	request.Header.Set("User-Agent", UserAgent)
+	request.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+
+	if err := c.wait(); err != nil {
+		return "", err
+	}
+
+	response, err := c.HTTPClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s %s: %s: %s", request.Method, request.URL.Path, response.Status, strings.TrimSpace(string(body)))
+	}
+
+	return string(body), nil
+}
+
+// Get fetches a page of the site, such as "/2024/day/13".
+func (c *Client) Get(path string) (string, error) {
+	request, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(c.BaseURL, "/")+path, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return c.Do(request)
+}
+
+// Input downloads a day's puzzle input.
+func (c *Client) Input(year, day int) (string, error) {
+	return c.Get(fmt.Sprintf("/%d/day/%d/input", year, day))
+}
+
+// Fetch downloads a day's input to path, unless it's already there. It reports
+// whether it downloaded anything.
+func (c *Client) Fetch(year, day int, path string) (bool, error) {
+	if _, err := os.Stat(path); err == nil {
+		return false, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	input, err := c.Input(year, day)
+	if err != nil {
+		return false, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return false, err
+	}
+
+	// Write to a temporary file first so an interrupted download never leaves a
+	// partial input behind to be mistaken for the real one.
+	temporary := path + ".partial"
+	if err := os.WriteFile(temporary, []byte(input), 0644); err != nil {
+		return false, err
+	}
+
+	if err := os.Rename(temporary, path); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,277 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package aoc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+
+		assert.Equal(t, UserAgent, r.UserAgent())
+
+		switch r.URL.Path {
+		case "/2024/day/13/input":
+			w.Write([]byte("Button A: X+94, Y+34\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newClient(server *httptest.Server, session string) *Client {
+	return &Client{BaseURL: server.URL, Session: session, HTTPClient: server.Client()}
+}
+
+func TestInput(t *testing.T) {
+	var requests atomic.Int32
+	server := newServer(t, &requests)
+
+	input, err := newClient(server, "secret").Input(2024, 13)
+	assert.NoError(t, err)
+	assert.Equal(t, "Button A: X+94, Y+34\n", input)
+
+	_, err = newClient(server, "secret").Input(2024, 26)
+	assert.ErrorContains(t, err, "404 Not Found")
+
+	_, err = newClient(server, "stale").Input(2024, 13)
+	assert.ErrorContains(t, err, "Please log in")
+
+	_, err = newClient(server, "").Input(2024, 13)
+	assert.ErrorIs(t, err, ErrNoSession)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestFetch(t *testing.T) {
+	var requests atomic.Int32
+	server := newServer(t, &requests)
+	client := newClient(server, "secret")
+
+	path := InputPath(t.TempDir(), 2024, 13)
+	assert.Equal(t, filepath.Join("2024", "day13_input.txt"), filepath.Join(filepath.Base(filepath.Dir(path)), filepath.Base(path)))
+
+	fetched, err := client.Fetch(2024, 13, path)
+	assert.NoError(t, err)
+	assert.True(t, fetched)
+
+	contents, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "Button A: X+94, Y+34\n", string(contents))
+
+	// The input is never fetched twice.
+	fetched, err = client.Fetch(2024, 13, path)
+	assert.NoError(t, err)
+	assert.False(t, fetched)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// A failed download leaves nothing behind.
+	missing := InputPath(t.TempDir(), 2024, 26)
+	_, err = client.Fetch(2024, 26, missing)
+	assert.Error(t, err)
+	assert.NoFileExists(t, missing)
+}
+
+func TestThrottle(t *testing.T) {
+	var requests atomic.Int32
+	server := newServer(t, &requests)
+	client := newClient(server, "secret")
+	client.Throttle = 50 * time.Millisecond
+
+	start := time.Now()
+
+	for i := 0; i < 3; i++ {
+		_, err := client.Input(2024, 13)
+		assert.NoError(t, err)
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 2*client.Throttle)
+}
+
+func TestThrottleBetweenRuns(t *testing.T) {
+	var requests atomic.Int32
+	server := newServer(t, &requests)
+	lastRequestPath := filepath.Join(t.TempDir(), "advent", "last-request")
+
+	run := func() {
+		client := newClient(server, "secret")
+		client.Throttle = 50 * time.Millisecond
+		client.LastRequestPath = lastRequestPath
+
+		_, err := client.Input(2024, 13)
+		assert.NoError(t, err)
+	}
+
+	start := time.Now()
+
+	run()
+	assert.FileExists(t, lastRequestPath)
+	run()
+
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// A last request from the future only holds things up for the throttle.
+	assert.NoError(t, os.WriteFile(lastRequestPath, []byte(time.Now().Add(time.Hour).Format(time.RFC3339Nano)), 0644))
+
+	start = time.Now()
+	run()
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestSession(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(SessionEnv, "")
+
+	_, err := Session()
+	assert.ErrorIs(t, err, ErrNoSession)
+
+	sessionPath, err := SessionPath()
+	assert.NoError(t, err)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(sessionPath), 0755))
+	assert.NoError(t, os.WriteFile(sessionPath, []byte("from-config\n"), 0600))
+
+	session, err := Session()
+	assert.NoError(t, err)
+	assert.Equal(t, "from-config", session)
+
+	t.Setenv(SessionEnv, "from-env")
+
+	session, err = Session()
+	assert.NoError(t, err)
+	assert.Equal(t, "from-env", session)
+}
+
+func TestNewClient(t *testing.T) {
+	t.Setenv(BaseURLEnv, "")
+	assert.Equal(t, DefaultBaseURL, NewClient("secret").BaseURL)
+
+	t.Setenv(BaseURLEnv, "http://localhost:8080")
+	assert.Equal(t, "http://localhost:8080", NewClient("secret").BaseURL)
+
+	cacheDir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", cacheDir)
+	assert.Equal(t, filepath.Join(cacheDir, "advent", "last-request"), NewClient("secret").LastRequestPath)
+}
+
+func TestReleased(t *testing.T) {
+	eastern := time.FixedZone("EST", -5*60*60)
+
+	assert.False(t, Released(2024, 13, time.Date(2024, time.December, 12, 23, 59, 59, 0, eastern)))
+	assert.True(t, Released(2024, 13, time.Date(2024, time.December, 13, 0, 0, 0, 0, eastern)))
+	assert.True(t, Released(2023, 25, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestParseResponse(t *testing.T) {
+	type testCase struct {
+		fixture string
+		outcome Outcome
+		wait    time.Duration
+		message string
+	}
+
+	testCases := []testCase{
+		{"correct.html", Correct, 0, "That's the right answer! You are one gold star closer to finding the Chief Historian. [Continue to Part Two]"},
+		{"too_high.html", TooHigh, time.Minute, ""},
+		{"too_low.html", TooLow, time.Minute, ""},
+		{"wrong.html", Wrong, 5 * time.Minute, ""},
+		{"too_soon.html", TooSoon, 4*time.Minute + 32*time.Second, ""},
+		{"already_solved.html", AlreadySolved, 0, "You don't seem to be solving the right level. Did you already complete it? [Return to Day 13]"},
+	}
+
+	for _, test := range testCases {
+		page, err := os.ReadFile(filepath.Join("testdata", test.fixture))
+		assert.NoError(t, err)
+
+		response := ParseResponse(string(page))
+		assert.Equal(t, test.outcome, response.Outcome, test.fixture)
+		assert.Equal(t, test.wait, response.Wait, test.fixture)
+		if test.message != "" {
+			assert.Equal(t, test.message, response.Message, test.fixture)
+		}
+	}
+
+	assert.Equal(t, Unknown, ParseResponse("<html><body>Something else</body></html>").Outcome)
+}
+
+func TestSubmit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/2024/day/13/answer", r.URL.Path)
+		assert.Equal(t, "2", r.FormValue("level"))
+
+		fixture := "too_low.html"
+		if r.FormValue("answer") == "875318608908" {
+			fixture = "correct.html"
+		}
+
+		http.ServeFile(w, r, filepath.Join("testdata", fixture))
+	}))
+	defer server.Close()
+
+	client := newClient(server, "secret")
+
+	response, err := client.Submit(2024, 13, 2, "875318608908")
+	assert.NoError(t, err)
+	assert.Equal(t, Correct, response.Outcome)
+
+	response, err = client.Submit(2024, 13, 2, "12")
+	assert.NoError(t, err)
+	assert.Equal(t, TooLow, response.Outcome)
+}
+
+func TestAnswerLog(t *testing.T) {
+	path := AnswerLogPath(t.TempDir())
+
+	l, err := LoadAnswerLog(path)
+	assert.NoError(t, err)
+	assert.Empty(t, l.Attempts)
+	assert.NoError(t, l.Check(2024, 13, 1, "480"))
+
+	now := time.Date(2024, time.December, 13, 6, 0, 0, 0, time.UTC)
+	assert.NoError(t, l.Record(Attempt{Year: 2024, Day: 13, Part: 1, Answer: "500", Outcome: TooHigh, Time: now}))
+	assert.NoError(t, l.Record(Attempt{Year: 2024, Day: 13, Part: 1, Answer: "400", Outcome: TooLow, Time: now}))
+	assert.NoError(t, l.Record(Attempt{Year: 2024, Day: 13, Part: 1, Answer: "450", Outcome: Wrong, Time: now}))
+	assert.NoError(t, l.Record(Attempt{Year: 2024, Day: 13, Part: 1, Answer: "470", Outcome: TooSoon, Time: now}))
+
+	l, err = LoadAnswerLog(path)
+	assert.NoError(t, err)
+	assert.Len(t, l.Attempts, 4)
+	assert.Equal(t, TooLow, l.Attempts[1].Outcome)
+
+	assert.ErrorContains(t, l.Check(2024, 13, 1, "500"), "already submitted")
+	assert.ErrorContains(t, l.Check(2024, 13, 1, "450"), "already submitted")
+	assert.ErrorContains(t, l.Check(2024, 13, 1, "600"), "500 was too high")
+	assert.ErrorContains(t, l.Check(2024, 13, 1, "12"), "400 was too low")
+	assert.NoError(t, l.Check(2024, 13, 1, "470"))
+	assert.NoError(t, l.Check(2024, 13, 1, "ABC"))
+	assert.NoError(t, l.Check(2024, 13, 2, "600"))
+
+	assert.NoError(t, l.Record(Attempt{Year: 2024, Day: 13, Part: 1, Answer: "480", Outcome: Correct, Time: now}))
+	assert.ErrorContains(t, l.Check(2024, 13, 1, "470"), "already solved with 480")
+
+	assert.NoError(t, os.WriteFile(path, []byte("{not json\n"), 0644))
+	_, err = LoadAnswerLog(path)
+	assert.ErrorContains(t, err, ":1:")
+}
@@ -0,0 +1,140 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package aoc
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome is the site's verdict on a submitted answer.
+type Outcome int
+
+const (
+	Unknown Outcome = iota
+	Correct
+	TooHigh
+	TooLow
+	Wrong
+	TooSoon
+	AlreadySolved
+)
+
+var outcomeNames = map[Outcome]string{
+	Unknown:       "unknown",
+	Correct:       "correct",
+	TooHigh:       "too high",
+	TooLow:        "too low",
+	Wrong:         "wrong",
+	TooSoon:       "too soon",
+	AlreadySolved: "already solved",
+}
+
+func (o Outcome) String() string {
+	return outcomeNames[o]
+}
+
+func (o Outcome) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+func (o *Outcome) UnmarshalText(text []byte) error {
+	for outcome, name := range outcomeNames {
+		if name == string(text) {
+			*o = outcome
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown outcome '%s'", text)
+}
+
+// Judged reports whether the site actually checked the answer, rather than turning
+// the submission away.
+func (o Outcome) Judged() bool {
+	return o == Correct || o == TooHigh || o == TooLow || o == Wrong
+}
+
+// Response is what the site said about a submitted answer. Wait is how long to
+// hold off before submitting again, if the site said.
+type Response struct {
+	Outcome Outcome
+	Wait    time.Duration
+	Message string
+}
+
+var articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+var spacePattern = regexp.MustCompile(`\s+`)
+var leftPattern = regexp.MustCompile(`(?i)you have (?:(\d+)m )?(\d+)s left to wait`)
+var waitPattern = regexp.MustCompile(`wait (one|\d+) minutes? before trying again`)
+
+// ParseResponse reads the verdict out of the page the site sends back for an
+// answer.
+func ParseResponse(page string) Response {
+	message := page
+	if match := articlePattern.FindStringSubmatch(page); match != nil {
+		message = match[1]
+	}
+
+	message = tagPattern.ReplaceAllString(message, "")
+	message = strings.TrimSpace(spacePattern.ReplaceAllString(html.UnescapeString(message), " "))
+
+	response := Response{Message: message}
+
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		response.Outcome = Correct
+	case strings.Contains(message, "your answer is too high"):
+		response.Outcome = TooHigh
+	case strings.Contains(message, "your answer is too low"):
+		response.Outcome = TooLow
+	case strings.Contains(message, "That's not the right answer"):
+		response.Outcome = Wrong
+	case strings.Contains(message, "You gave an answer too recently"):
+		response.Outcome = TooSoon
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		response.Outcome = AlreadySolved
+	}
+
+	if match := leftPattern.FindStringSubmatch(message); match != nil {
+		minutes, _ := strconv.Atoi(match[1])
+		seconds, _ := strconv.Atoi(match[2])
+		response.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if match := waitPattern.FindStringSubmatch(message); match != nil {
+		minutes, err := strconv.Atoi(match[1])
+		if err != nil {
+			minutes = 1
+		}
+		response.Wait = time.Duration(minutes) * time.Minute
+	}
+
+	return response
+}
+
+// Submit sends an answer for one part of a day's puzzle.
+func (c *Client) Submit(year, day, part int, answer string) (Response, error) {
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+
+	request, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%d/day/%d/answer", strings.TrimSuffix(c.BaseURL, "/"), year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Response{}, err
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	page, err := c.Do(request)
+	if err != nil {
+		return Response{}, err
+	}
+
+	return ParseResponse(page), nil
+}
@@ -0,0 +1,240 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+// Package audit finds code that was copied between days' packages, by hashing
+// each function's syntax tree with its identifiers renamed in order of first use.
+// A function copied from another day still matches after its variables are
+// renamed, but not after its logic or constants change.
+package audit
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// MinimumNodes is how big a function has to be before it's worth reporting. Below
+// this, accessors like Len and Less match all over the place.
+const MinimumNodes = 60
+
+// Function is a function or method in a package under the audited directory.
+type Function struct {
+	Package  string
+	Name     string
+	Position token.Position
+	Nodes    int
+	Hash     [sha256.Size]byte
+}
+
+func (f Function) String() string {
+	return fmt.Sprintf("%s:%d %s", f.Position.Filename, f.Position.Line, f.Name)
+}
+
+func name(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+
+	receiver := fn.Recv.List[0].Type
+	if star, ok := receiver.(*ast.StarExpr); ok {
+		receiver = star.X
+	}
+
+	if ident, ok := receiver.(*ast.Ident); ok {
+		return ident.Name + "." + fn.Name.Name
+	}
+
+	return fn.Name.Name
+}
+
+// Hash fingerprints a function's signature and body. Node types, operators and
+// literals are kept; identifiers are numbered in the order they first appear.
+func Hash(fn *ast.FuncDecl) ([sha256.Size]byte, int) {
+	h := sha256.New()
+	identifiers := make(map[string]int)
+	nodes := 0
+
+	visit := func(n ast.Node) bool {
+		if n == nil {
+			return true
+		}
+
+		nodes++
+		fmt.Fprintf(h, "%T;", n)
+
+		switch x := n.(type) {
+		case *ast.Ident:
+			i, ok := identifiers[x.Name]
+			if !ok {
+				i = len(identifiers)
+				identifiers[x.Name] = i
+			}
+			fmt.Fprintf(h, "%d;", i)
+		case *ast.BasicLit:
+			fmt.Fprintf(h, "%s;", x.Value)
+		case *ast.BinaryExpr:
+			fmt.Fprintf(h, "%s;", x.Op)
+		case *ast.UnaryExpr:
+			fmt.Fprintf(h, "%s;", x.Op)
+		case *ast.AssignStmt:
+			fmt.Fprintf(h, "%s;", x.Tok)
+		case *ast.IncDecStmt:
+			fmt.Fprintf(h, "%s;", x.Tok)
+		case *ast.BranchStmt:
+			fmt.Fprintf(h, "%s;", x.Tok)
+		}
+
+		return true
+	}
+
+	ast.Inspect(fn.Type, visit)
+	ast.Inspect(fn.Body, visit)
+
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+
+	return sum, nodes
+}
+
+// Scan hashes every function with a body in the non-test Go files under root.
+// Each function's package is named by its directory relative to root.
+func Scan(root string) ([]Function, error) {
+	functions := make([]Function, 0)
+	fset := token.NewFileSet()
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		pkg, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+
+			hash, nodes := Hash(fn)
+			functions = append(functions, Function{
+				Package:  filepath.ToSlash(pkg),
+				Name:     name(fn),
+				Position: fset.Position(fn.Pos()),
+				Nodes:    nodes,
+				Hash:     hash,
+			})
+		}
+
+		return nil
+	})
+
+	return functions, err
+}
+
+// Duplicates groups the functions big enough to matter that appear, identical up
+// to naming, in more than one package.
+func Duplicates(functions []Function) [][]Function {
+	byHash := make(map[[sha256.Size]byte][]Function)
+
+	for _, f := range functions {
+		if f.Nodes >= MinimumNodes {
+			byHash[f.Hash] = append(byHash[f.Hash], f)
+		}
+	}
+
+	groups := make([][]Function, 0)
+
+	for _, group := range byHash {
+		packages := make(map[string]bool)
+		for _, f := range group {
+			packages[f.Package] = true
+		}
+
+		if len(packages) > 1 {
+			sort.Slice(group, func(i, j int) bool { return group[i].String() < group[j].String() })
+			groups = append(groups, group)
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i][0].String() < groups[j][0].String() })
+
+	return groups
+}
+
+// PackagePair is two packages that share functions.
+type PackagePair struct {
+	A, B   string
+	Shared int
+	// Smaller is how many functions worth reporting the smaller of the two has.
+	Smaller int
+}
+
+func (p PackagePair) String() string {
+	return fmt.Sprintf("%s and %s share %d of %d functions", p.A, p.B, p.Shared, p.Smaller)
+}
+
+// DuplicatePackages finds packages where at least half of the functions of the
+// smaller one, and at least three, are duplicated in the other: one was most
+// likely copied from the other wholesale.
+func DuplicatePackages(functions []Function) []PackagePair {
+	hashes := make(map[string]map[[sha256.Size]byte]bool)
+
+	for _, f := range functions {
+		if f.Nodes < MinimumNodes {
+			continue
+		}
+
+		if hashes[f.Package] == nil {
+			hashes[f.Package] = make(map[[sha256.Size]byte]bool)
+		}
+
+		hashes[f.Package][f.Hash] = true
+	}
+
+	packages := make([]string, 0, len(hashes))
+	for pkg := range hashes {
+		packages = append(packages, pkg)
+	}
+
+	sort.Strings(packages)
+
+	pairs := make([]PackagePair, 0)
+
+	for i, a := range packages {
+		for _, b := range packages[i+1:] {
+			shared := 0
+			for hash := range hashes[a] {
+				if hashes[b][hash] {
+					shared++
+				}
+			}
+
+			smaller := min(len(hashes[a]), len(hashes[b]))
+
+			if shared >= 3 && 2*shared >= smaller {
+				pairs = append(pairs, PackagePair{A: a, B: b, Shared: shared, Smaller: smaller})
+			}
+		}
+	}
+
+	return pairs
+}
@@ -0,0 +1,107 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package audit
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func parseFunction(t *testing.T, source string) *ast.FuncDecl {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+source, 0)
+	assert.NoError(t, err)
+
+	return file.Decls[0].(*ast.FuncDecl)
+}
+
+func TestHash(t *testing.T) {
+	original, nodes := Hash(parseFunction(t, "func sum(values []int) int { total := 0; for _, v := range values { total += v }; return total }"))
+	renamed, _ := Hash(parseFunction(t, "func add(xs []int) int { acc := 0; for _, x := range xs { acc += x }; return acc }"))
+	changed, _ := Hash(parseFunction(t, "func sum(values []int) int { total := 1; for _, v := range values { total += v }; return total }"))
+	operator, _ := Hash(parseFunction(t, "func sum(values []int) int { total := 0; for _, v := range values { total *= v }; return total }"))
+
+	assert.Greater(t, nodes, 0)
+	assert.Equal(t, original, renamed)
+	assert.NotEqual(t, original, changed)
+	assert.NotEqual(t, original, operator)
+}
+
+func function(pkg, name string, hash byte) Function {
+	f := Function{Package: pkg, Name: name, Nodes: MinimumNodes}
+	f.Position.Filename = pkg + "/day.go"
+	f.Hash[0] = hash
+
+	return f
+}
+
+func TestDuplicates(t *testing.T) {
+	small := function("b", "Less", 1)
+	small.Nodes = MinimumNodes - 1
+
+	functions := []Function{
+		function("a", "parse", 1), function("a", "day", 2), function("a", "walk", 3),
+		function("b", "parse", 1), function("b", "day", 2), function("b", "walk", 3), function("b", "other", 4),
+		function("c", "parse", 1), function("c", "solve", 5), function("c", "count", 6), function("c", "print", 7),
+		function("c", "helper", 8), function("c", "extra", 9),
+		function("a", "copy", 8), small,
+	}
+
+	groups := Duplicates(functions)
+	assert.Len(t, groups, 4)
+	assert.Equal(t, []Function{function("a", "copy", 8), function("c", "helper", 8)}, groups[0])
+
+	// A function repeated only within its own package isn't a copy.
+	assert.Empty(t, Duplicates([]Function{function("a", "x", 1), function("a", "y", 1)}))
+
+	pairs := DuplicatePackages(functions)
+	assert.Equal(t, []PackagePair{{A: "a", B: "b", Shared: 3, Smaller: 4}}, pairs)
+	assert.Equal(t, "a and b share 3 of 4 functions", pairs[0].String())
+}
+
+func TestScan(t *testing.T) {
+	root := t.TempDir()
+
+	body := `package day
+
+import "strings"
+
+func solve(lines []string) int {
+	count := 0
+	for i, line := range lines {
+		if len(line) > i*2+1 && line[0] == '#' {
+			count += len(line) - i
+		} else if len(line) == 0 {
+			count--
+		} else {
+			count += strings.Count(line, ".") * 3
+		}
+	}
+	return count
+}
+`
+
+	for _, dir := range []string{"2022/day01", "2023/day01"} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(root, dir), 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(root, dir, "day.go"), []byte(body), 0644))
+		assert.NoError(t, os.WriteFile(filepath.Join(root, dir, "day_test.go"), []byte(body), 0644))
+	}
+
+	functions, err := Scan(root)
+	assert.NoError(t, err)
+	assert.Len(t, functions, 2)
+	assert.Equal(t, "2022/day01", functions[0].Package)
+	assert.Equal(t, "solve", functions[0].Name)
+	assert.Equal(t, 5, functions[0].Position.Line)
+	assert.GreaterOrEqual(t, functions[0].Nodes, MinimumNodes)
+
+	groups := Duplicates(functions)
+	assert.Len(t, groups, 1)
+}
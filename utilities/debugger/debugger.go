@@ -0,0 +1,394 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+// Package debugger steps through a day's simulation one step at a time, so a
+// misbehaving simulation can be watched instead of sprinkled with Printf calls.
+package debugger
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// HistoryLimit is how many steps can be stepped back through. Older steps are
+	// forgotten.
+	HistoryLimit = 10000
+	// MaximumContinueSteps stops a continue that never reaches a breakpoint, such
+	// as a simulation stuck in a loop.
+	MaximumContinueSteps = 1 << 20
+)
+
+// Simulation is all a day has to provide to be debugged.
+type Simulation interface {
+	// Step advances the simulation by one step, and reports whether it has
+	// finished.
+	Step() bool
+	// Render draws the current state, usually as a grid.
+	Render() string
+}
+
+// Inspector can be implemented by simulations with more state than Render shows,
+// such as counters or registers.
+type Inspector interface {
+	Inspect() string
+}
+
+// Breakpointer can be implemented by simulations with interesting moments to stop
+// at. Each condition is checked after every step once it has been enabled.
+type Breakpointer interface {
+	Breakpoints() map[string]func() bool
+}
+
+// Factory makes a simulation from a puzzle input.
+type Factory func(input string) (Simulation, error)
+
+type Day struct {
+	Year int
+	Day  int
+}
+
+var registry = make(map[Day]Factory)
+
+// Register makes a day's simulation available to the debugger. Days call it from
+// init.
+func Register(year, day int, factory Factory) {
+	registry[Day{Year: year, Day: day}] = factory
+}
+
+func Lookup(year, day int) (Factory, bool) {
+	factory, ok := registry[Day{Year: year, Day: day}]
+
+	return factory, ok
+}
+
+// Registered lists every day with a simulation, in order.
+func Registered() []Day {
+	days := make([]Day, 0, len(registry))
+	for d := range registry {
+		days = append(days, d)
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		if days[i].Year != days[j].Year {
+			return days[i].Year < days[j].Year
+		}
+		return days[i].Day < days[j].Day
+	})
+
+	return days
+}
+
+type frame struct {
+	Step    int
+	Render  string
+	Inspect string
+}
+
+// Session keeps the history of a simulation so it can be stepped backwards. The
+// simulation itself only ever moves forwards; stepping back shows an earlier
+// frame, and stepping forward again replays frames until it catches up.
+type Session struct {
+	Simulation Simulation
+	Finished   bool
+
+	history  []frame
+	position int
+	enabled  map[string]bool
+}
+
+func NewSession(simulation Simulation) *Session {
+	s := &Session{Simulation: simulation, enabled: make(map[string]bool)}
+	s.record(0)
+
+	return s
+}
+
+func (s *Session) record(step int) {
+	f := frame{Step: step, Render: s.Simulation.Render()}
+
+	if inspector, ok := s.Simulation.(Inspector); ok {
+		f.Inspect = inspector.Inspect()
+	}
+
+	s.history = append(s.history, f)
+
+	if len(s.history) > HistoryLimit {
+		s.history = s.history[len(s.history)-HistoryLimit:]
+	}
+
+	s.position = len(s.history) - 1
+}
+
+func (s *Session) current() frame {
+	return s.history[s.position]
+}
+
+// Step is the number of steps the session is showing.
+func (s *Session) Step() int {
+	return s.current().Step
+}
+
+func (s *Session) Render() string {
+	return s.current().Render
+}
+
+// Inspect describes the state at the current step. Simulations that aren't an
+// Inspector are printed with their fields.
+func (s *Session) Inspect() string {
+	if _, ok := s.Simulation.(Inspector); ok {
+		return s.current().Inspect
+	}
+
+	if s.position != len(s.history)-1 {
+		return "state is only available at the latest step"
+	}
+
+	return fmt.Sprintf("%+v", s.Simulation)
+}
+
+func (s *Session) atHead() bool {
+	return s.position == len(s.history)-1
+}
+
+// Forward moves up to n steps forwards, and reports how many it moved.
+func (s *Session) Forward(n int) int {
+	moved := 0
+
+	for ; moved < n; moved++ {
+		if !s.atHead() {
+			s.position++
+			continue
+		}
+
+		if s.Finished {
+			break
+		}
+
+		s.Finished = s.Simulation.Step()
+		s.record(s.current().Step + 1)
+	}
+
+	return moved
+}
+
+// Back moves up to n steps backwards, as far as the history goes, and reports how
+// many it moved.
+func (s *Session) Back(n int) int {
+	moved := min(n, s.position)
+	s.position -= moved
+
+	return moved
+}
+
+// Breakpoints lists the simulation's breakpoints and whether each is enabled.
+func (s *Session) Breakpoints() map[string]bool {
+	breakpoints := make(map[string]bool)
+
+	if b, ok := s.Simulation.(Breakpointer); ok {
+		for name := range b.Breakpoints() {
+			breakpoints[name] = s.enabled[name]
+		}
+	}
+
+	return breakpoints
+}
+
+func (s *Session) Toggle(name string) (bool, error) {
+	if _, ok := s.Breakpoints()[name]; !ok {
+		return false, fmt.Errorf("no breakpoint named '%s'", name)
+	}
+
+	s.enabled[name] = !s.enabled[name]
+
+	return s.enabled[name], nil
+}
+
+// Continue runs until an enabled breakpoint or the stop condition is hit, or the
+// simulation finishes. It returns the name of the breakpoint that stopped it, if
+// any.
+func (s *Session) Continue(stop func(s *Session) bool) string {
+	var conditions map[string]func() bool
+	if b, ok := s.Simulation.(Breakpointer); ok {
+		conditions = b.Breakpoints()
+	}
+
+	for i := 0; i < MaximumContinueSteps; i++ {
+		if s.Forward(1) == 0 {
+			return ""
+		}
+
+		if s.atHead() {
+			for name, condition := range conditions {
+				if s.enabled[name] && condition() {
+					return name
+				}
+			}
+		}
+
+		if stop != nil && stop(s) {
+			return "condition"
+		}
+	}
+
+	return ""
+}
+
+// Until runs until the rendered or inspected state matches pattern.
+func (s *Session) Until(pattern *regexp.Regexp) string {
+	return s.Continue(func(s *Session) bool {
+		return pattern.MatchString(s.Render()) || pattern.MatchString(s.Inspect())
+	})
+}
+
+const help = `Commands:
+  s, step [n]       step forward n steps (default 1)
+  b, back [n]       step back n steps (default 1)
+  c, continue       run to an enabled breakpoint or the end
+  u, until <regexp> run until the rendered or inspected state matches
+  break [name]      list breakpoints, or toggle one
+  r, render         draw the current state
+  i, inspect        show the current state
+  h, help           show this help
+  q, quit           leave the debugger
+An empty line repeats the last command.`
+
+// Run reads commands from in until it ends or the user quits, writing the
+// simulation to out as it goes.
+func (s *Session) Run(in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+
+	show := func() {
+		status := ""
+		if s.Finished && s.atHead() {
+			status = " (finished)"
+		}
+
+		fmt.Fprintf(out, "step %d%s\n%s\n", s.Step(), status, s.Render())
+	}
+
+	count := func(fields []string) (int, error) {
+		if len(fields) < 2 {
+			return 1, nil
+		}
+
+		n, err := strconv.Atoi(fields[1])
+		if err != nil || n < 1 {
+			return 0, fmt.Errorf("invalid step count '%s'", fields[1])
+		}
+
+		return n, nil
+	}
+
+	show()
+
+	last := ""
+
+	for {
+		fmt.Fprint(out, "(debug) ")
+
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			return scanner.Err()
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			line = last
+		}
+		last = line
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "s", "step":
+			n, err := count(fields)
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+
+			if s.Forward(n) < n {
+				fmt.Fprintln(out, "simulation finished")
+			}
+			show()
+		case "b", "back":
+			n, err := count(fields)
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+
+			if s.Back(n) < n {
+				fmt.Fprintln(out, "no more history")
+			}
+			show()
+		case "c", "continue":
+			if name := s.Continue(nil); name != "" {
+				fmt.Fprintf(out, "stopped at breakpoint %s\n", name)
+			}
+			show()
+		case "u", "until":
+			if len(fields) < 2 {
+				fmt.Fprintln(out, "until needs a pattern")
+				continue
+			}
+
+			pattern, err := regexp.Compile(strings.TrimSpace(strings.TrimPrefix(line, fields[0])))
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+
+			if s.Until(pattern) == "" {
+				fmt.Fprintln(out, "pattern never matched")
+			}
+			show()
+		case "break":
+			if len(fields) < 2 {
+				breakpoints := s.Breakpoints()
+				if len(breakpoints) == 0 {
+					fmt.Fprintln(out, "no breakpoints")
+				}
+
+				names := make([]string, 0, len(breakpoints))
+				for name := range breakpoints {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+
+				for _, name := range names {
+					fmt.Fprintf(out, "  %s: %t\n", name, breakpoints[name])
+				}
+				continue
+			}
+
+			enabled, err := s.Toggle(fields[1])
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+
+			fmt.Fprintf(out, "breakpoint %s enabled: %t\n", fields[1], enabled)
+		case "r", "render":
+			show()
+		case "i", "inspect":
+			fmt.Fprintln(out, s.Inspect())
+		case "h", "help":
+			fmt.Fprintln(out, help)
+		case "q", "quit":
+			return nil
+		default:
+			fmt.Fprintf(out, "unknown command '%s', try help\n", fields[0])
+		}
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package debugger
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// counter counts up to a limit, drawing itself as a row of #.
+type counter struct {
+	Value int
+	Limit int
+}
+
+func (c *counter) Step() bool {
+	c.Value++
+	return c.Value >= c.Limit
+}
+
+func (c *counter) Render() string {
+	return strings.Repeat("#", c.Value)
+}
+
+func (c *counter) Breakpoints() map[string]func() bool {
+	return map[string]func() bool{
+		"even": func() bool { return c.Value%2 == 0 },
+	}
+}
+
+type inspectedCounter struct {
+	counter
+}
+
+func (c *inspectedCounter) Inspect() string {
+	return fmt.Sprintf("value=%d", c.Value)
+}
+
+func TestRegister(t *testing.T) {
+	Register(1999, 2, func(string) (Simulation, error) { return &counter{}, nil })
+	Register(1999, 1, func(string) (Simulation, error) { return &counter{}, nil })
+
+	_, ok := Lookup(1999, 1)
+	assert.True(t, ok)
+
+	_, ok = Lookup(1999, 3)
+	assert.False(t, ok)
+
+	days := Registered()
+	assert.Equal(t, []Day{{1999, 1}, {1999, 2}}, days[:2])
+}
+
+func TestForwardBack(t *testing.T) {
+	c := &counter{Limit: 5}
+	s := NewSession(c)
+
+	assert.Equal(t, 0, s.Step())
+	assert.Equal(t, "", s.Render())
+
+	assert.Equal(t, 3, s.Forward(3))
+	assert.Equal(t, 3, s.Step())
+	assert.Equal(t, "###", s.Render())
+
+	assert.Equal(t, 2, s.Back(2))
+	assert.Equal(t, "#", s.Render())
+	assert.Equal(t, 1, s.Back(5))
+	assert.Equal(t, 0, s.Step())
+
+	// Stepping forward again replays history without stepping the simulation.
+	assert.Equal(t, 3, s.Forward(3))
+	assert.Equal(t, 3, c.Value)
+
+	assert.Equal(t, 2, s.Forward(10))
+	assert.True(t, s.Finished)
+	assert.Equal(t, 5, s.Step())
+	assert.Equal(t, 5, c.Value)
+}
+
+func TestHistoryLimit(t *testing.T) {
+	s := NewSession(&counter{Limit: HistoryLimit * 2})
+
+	s.Forward(HistoryLimit + 10)
+	assert.Equal(t, HistoryLimit-1, s.Back(HistoryLimit*2))
+	assert.Equal(t, 11, s.Step())
+}
+
+func TestContinue(t *testing.T) {
+	s := NewSession(&counter{Limit: 7})
+
+	assert.Equal(t, map[string]bool{"even": false}, s.Breakpoints())
+
+	enabled, err := s.Toggle("even")
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	_, err = s.Toggle("odd")
+	assert.Error(t, err)
+
+	assert.Equal(t, "even", s.Continue(nil))
+	assert.Equal(t, 2, s.Step())
+	assert.Equal(t, "even", s.Continue(nil))
+	assert.Equal(t, 4, s.Step())
+
+	assert.Equal(t, "condition", s.Until(regexp.MustCompile(`#{5}`)))
+	assert.Equal(t, 5, s.Step())
+
+	s.Toggle("even")
+	assert.Equal(t, "", s.Continue(nil))
+	assert.Equal(t, 7, s.Step())
+	assert.True(t, s.Finished)
+}
+
+func TestInspect(t *testing.T) {
+	s := NewSession(&counter{Limit: 3})
+	s.Forward(1)
+	assert.Equal(t, "&{Value:1 Limit:3}", s.Inspect())
+
+	s.Back(1)
+	assert.Equal(t, "state is only available at the latest step", s.Inspect())
+
+	s = NewSession(&inspectedCounter{counter{Limit: 3}})
+	s.Forward(2)
+	s.Back(1)
+	assert.Equal(t, "value=1", s.Inspect())
+}
+
+func TestRun(t *testing.T) {
+	s := NewSession(&inspectedCounter{counter{Limit: 4}})
+
+	var out strings.Builder
+	err := s.Run(strings.NewReader("step 2\n\nback\ninspect\nbreak\nbreak even\ncontinue\nuntil ####\nstep\nstep x\nbogus\nquit\nstep\n"), &out)
+	assert.NoError(t, err)
+
+	expected := `step 0
+
+(debug) step 2
+##
+(debug) step 4 (finished)
+####
+(debug) step 3
+###
+(debug) value=3
+(debug)   even: false
+(debug) breakpoint even enabled: true
+(debug) stopped at breakpoint even
+step 4 (finished)
+####
+(debug) pattern never matched
+step 4 (finished)
+####
+(debug) simulation finished
+step 4 (finished)
+####
+(debug) invalid step count 'x'
+(debug) unknown command 'bogus', try help
+(debug) `
+
+	assert.Equal(t, expected, out.String())
+}
@@ -0,0 +1,228 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+// Package examples pulls the worked examples out of puzzle pages into testdata
+// fixtures, and runs a day's solver over them in tests.
+package examples
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+)
+
+// Example is an example input from a puzzle, with the answers the puzzle gives for
+// it keyed by part.
+type Example struct {
+	Number  int
+	Input   string
+	Answers map[int]string
+}
+
+var articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+var codeBlockPattern = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+
+// The puzzles emphasize the answer to an example as <code><em>11</em></code>, or
+// in older years as <em><code>11</code></em>.
+var answerPattern = regexp.MustCompile(`(?s)<code><em>(.*?)</em></code>|<em><code>(.*?)</code></em>`)
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+func text(fragment string) string {
+	return html.UnescapeString(tagPattern.ReplaceAllString(fragment, ""))
+}
+
+// Extract finds the examples on a puzzle page. Each part's article gives its
+// example input in its first code block, and ends with the answer for it; later
+// blocks are diagrams of the steps in between. A part without a code block of its
+// own reuses the example before it. Code blocks no answer refers to are left out.
+func Extract(page string) []Example {
+	examples := make([]Example, 0)
+
+	for part, article := range articlePattern.FindAllStringSubmatch(page, -1) {
+		body := article[1]
+
+		blocks := codeBlockPattern.FindAllStringSubmatchIndex(body, -1)
+		for _, block := range blocks {
+			examples = append(examples, Example{Input: strings.TrimSuffix(text(body[block[2]:block[3]]), "\n"), Answers: make(map[int]string)})
+		}
+
+		answers := answerPattern.FindAllStringSubmatchIndex(body, -1)
+		if len(answers) == 0 || len(examples) == 0 {
+			continue
+		}
+
+		last := answers[len(answers)-1]
+
+		answer := ""
+		if last[2] >= 0 {
+			answer = text(body[last[2]:last[3]])
+		} else {
+			answer = text(body[last[4]:last[5]])
+		}
+
+		example := len(examples) - len(blocks)
+		if len(blocks) == 0 {
+			example--
+		}
+
+		if example >= 0 {
+			examples[example].Answers[part+1] = answer
+		}
+	}
+
+	answered := make([]Example, 0)
+	for _, example := range examples {
+		if len(example.Answers) > 0 {
+			example.Number = len(answered) + 1
+			answered = append(answered, example)
+		}
+	}
+
+	return answered
+}
+
+// Write saves examples to dir as exampleN.txt, holding the input, and
+// exampleN.answers, holding a "partN: answer" line for each part.
+func Write(dir string, examples []Example) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for _, example := range examples {
+		base := filepath.Join(dir, fmt.Sprintf("example%d", example.Number))
+
+		if err := os.WriteFile(base+".txt", []byte(example.Input+"\n"), 0644); err != nil {
+			return err
+		}
+
+		parts := make([]int, 0, len(example.Answers))
+		for part := range example.Answers {
+			parts = append(parts, part)
+		}
+		sort.Ints(parts)
+
+		var answers strings.Builder
+		for _, part := range parts {
+			fmt.Fprintf(&answers, "part%d: %s\n", part, example.Answers[part])
+		}
+
+		if err := os.WriteFile(base+".answers", []byte(answers.String()), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var fixturePattern = regexp.MustCompile(`^example(\d+)\.answers$`)
+
+// Load reads the examples saved in dir, in order. The trailing newline of each
+// input is dropped, as it is in the examples written into tests.
+func Load(dir string) ([]Example, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	examples := make([]Example, 0)
+
+	for _, entry := range entries {
+		match := fixturePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		number, _ := strconv.Atoi(match[1])
+		example := Example{Number: number, Answers: make(map[int]string)}
+
+		answers, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, line := range strings.Split(strings.TrimSpace(string(answers)), "\n") {
+			var part int
+			var answer string
+
+			if _, err := fmt.Sscanf(line, "part%d: %s", &part, &answer); err != nil {
+				return nil, fmt.Errorf("%s: invalid answer '%s'", entry.Name(), line)
+			}
+
+			example.Answers[part] = answer
+		}
+
+		input, err := os.ReadFile(filepath.Join(dir, fmt.Sprintf("example%d.txt", number)))
+		if err != nil {
+			return nil, err
+		}
+
+		example.Input = strings.TrimSuffix(string(input), "\n")
+
+		examples = append(examples, example)
+	}
+
+	sort.Slice(examples, func(i, j int) bool { return examples[i].Number < examples[j].Number })
+
+	return examples, nil
+}
+
+// Test runs every example in the package's testdata through solve, a day's
+// solver, and checks the answers it reports for each part. An example that only
+// gives answers for some parts may fail in the others, once it's reported those.
+func Test(t *testing.T, solve func(input string) error) {
+	t.Helper()
+
+	examples, err := Load("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(examples) == 0 {
+		t.Fatal("no examples in testdata")
+	}
+
+	for _, example := range examples {
+		t.Run(fmt.Sprintf("example%d", example.Number), func(t *testing.T) {
+			var solveErr error
+
+			answers, output, err := utilities.CollectAnswers(func() { solveErr = solve(example.Input) })
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if solveErr != nil {
+				for part := range example.Answers {
+					if _, reported := answers[part]; !reported {
+						t.Fatal(solveErr)
+					}
+				}
+
+				t.Logf("stopped after the parts it checks: %v", solveErr)
+			}
+
+			for part := 1; part <= 2; part++ {
+				answer, ok := example.Answers[part]
+				if !ok {
+					continue
+				}
+
+				got, reported := answers[part]
+				switch {
+				case !reported:
+					t.Errorf("part %d: no answer reported, output:\n%s", part, output)
+				case got != answer:
+					t.Errorf("part %d: got %s, want %s", part, got, answer)
+				}
+			}
+		})
+	}
+}
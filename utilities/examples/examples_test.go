@@ -0,0 +1,89 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package examples
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtract(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("testdata", "puzzle.html"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []Example{
+		{Number: 1, Input: "1\n2\n3\n4", Answers: map[int]string{1: "10"}},
+		{Number: 2, Input: "<\n5\n6", Answers: map[int]string{2: "30"}},
+	}, Extract(string(page)))
+
+	// A part that reuses the earlier example adds its answer to it.
+	assert.Equal(t, []Example{
+		{Number: 1, Input: "1\n2", Answers: map[int]string{1: "3", 2: "2"}},
+	}, Extract(`<article><pre><code>1
+2
+</code></pre><p>Total <em><code>3</code></em>.</p></article>
+<article><p>Product <code><em>2</em></code>.</p></article>`))
+
+	assert.Empty(t, Extract("<html><body>No puzzle here</body></html>"))
+}
+
+func TestWriteLoad(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "testdata")
+
+	examples := []Example{
+		{Number: 1, Input: "1\n2", Answers: map[int]string{1: "3", 2: "2"}},
+		{Number: 2, Input: "co,de", Answers: map[int]string{2: "ka,ta"}},
+	}
+
+	assert.NoError(t, Write(dir, examples))
+
+	answers, err := os.ReadFile(filepath.Join(dir, "example1.answers"))
+	assert.NoError(t, err)
+	assert.Equal(t, "part1: 3\npart2: 2\n", string(answers))
+
+	loaded, err := Load(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, examples, loaded)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "example3.answers"), []byte("three\n"), 0644))
+	_, err = Load(dir)
+	assert.ErrorContains(t, err, "invalid answer 'three'")
+}
+
+func TestTest(t *testing.T) {
+	Test(t, func(input string) error {
+		sum, product := 0, 1
+
+		for _, line := range strings.Split(input, "\n") {
+			n, err := strconv.Atoi(line)
+			if err != nil {
+				continue
+			}
+
+			sum += n
+			product *= n
+		}
+
+		fmt.Printf("Lines: %d\n", len(strings.Split(input, "\n")))
+		utilities.Answer(1, "Total", sum)
+
+		// Example 1 only gives part 1's answer, so it can fail in part 2.
+		if sum < 11 {
+			return errors.New("too small for a product")
+		}
+
+		utilities.Answer(2, "Product", product)
+
+		return nil
+	})
+}
@@ -4,7 +4,11 @@ Copyright © 2021-2024 Cameron Esfahani
 
 package utilities
 
-import "github.com/spf13/cobra"
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+)
 
 func GetInputPath(cmd *cobra.Command) string {
 	if cmd == nil {
@@ -20,16 +24,12 @@ func GetInputPath(cmd *cobra.Command) string {
 	return inputPath
 }
 
-func GetVerbosity(cmd *cobra.Command) int {
-	if cmd == nil {
-		return 0
-	}
-
-	verbosity, err := cmd.Flags().GetCount("verbose")
-
-	if err != nil {
-		return 0
+// GetContext returns the context a command runs under, which carries the limits
+// set by --timeout and --max-mem.
+func GetContext(cmd *cobra.Command) context.Context {
+	if cmd == nil || cmd.Context() == nil {
+		return context.Background()
 	}
 
-	return verbosity
+	return cmd.Context()
 }
@@ -0,0 +1,158 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"runtime/debug"
+	"runtime/metrics"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryPollInterval is how often WithLimits checks the heap against its limit.
+const MemoryPollInterval = 100 * time.Millisecond
+
+// WatchdogGrace is how long a solver gets to stop by itself once it's over its
+// limits, before Watchdog stops it.
+const WatchdogGrace = time.Second
+
+var byteUnits = []struct {
+	suffix string
+	size   uint64
+}{
+	{"KiB", 1 << 10}, {"MiB", 1 << 20}, {"GiB", 1 << 30}, {"TiB", 1 << 40},
+	{"KB", 1e3}, {"MB", 1e6}, {"GB", 1e9}, {"TB", 1e12},
+	{"K", 1 << 10}, {"M", 1 << 20}, {"G", 1 << 30}, {"T", 1 << 40},
+	{"B", 1},
+}
+
+// ParseByteSize reads a size like 512MiB, 4GB or 2G. Bare K, M, G and T suffixes
+// are binary, as they are for GOMEMLIMIT; a number with no suffix is in bytes.
+func ParseByteSize(s string) (uint64, error) {
+	number, multiplier := strings.TrimSpace(s), uint64(1)
+
+	for _, unit := range byteUnits {
+		if strings.HasSuffix(number, unit.suffix) {
+			number, multiplier = strings.TrimSpace(strings.TrimSuffix(number, unit.suffix)), unit.size
+			break
+		}
+	}
+
+	size, err := strconv.ParseFloat(number, 64)
+	if err != nil || size < 0 || size*float64(multiplier) >= math.MaxUint64 {
+		return 0, fmt.Errorf("invalid size '%s'", s)
+	}
+
+	return uint64(size * float64(multiplier)), nil
+}
+
+// FormatByteSize writes a size to a tenth of the largest binary unit it fills.
+func FormatByteSize(size uint64) string {
+	// The binary units come first in byteUnits, smallest to largest.
+	for i := 3; i >= 0; i-- {
+		if unit := byteUnits[i]; size >= unit.size {
+			return strings.TrimSuffix(strconv.FormatFloat(float64(size)/float64(unit.size), 'f', 1, 64), ".0") + unit.suffix
+		}
+	}
+
+	return fmt.Sprintf("%dB", size)
+}
+
+func heapSize() uint64 {
+	sample := []metrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+	metrics.Read(sample)
+
+	return sample[0].Value.Uint64()
+}
+
+// WithLimits returns a context that's cancelled once timeout has passed, or once
+// the heap grows past maxMem bytes. A zero timeout or maxMem leaves that limit
+// off. The garbage collector is asked to keep under maxMem too, so the limit is
+// only hit when the live data really doesn't fit. The cause of the cancellation
+// says which limit was hit; Interrupted returns it.
+func WithLimits(parent context.Context, timeout time.Duration, maxMem uint64) (context.Context, context.CancelFunc) {
+	ctx, cancelTimeout := parent, context.CancelFunc(func() {})
+
+	if timeout > 0 {
+		ctx, cancelTimeout = context.WithTimeoutCause(parent, timeout, fmt.Errorf("timed out after %s", timeout))
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	if maxMem > 0 {
+		previous := debug.SetMemoryLimit(int64(min(maxMem, math.MaxInt64)))
+
+		go func() {
+			defer debug.SetMemoryLimit(previous)
+
+			ticker := time.NewTicker(MemoryPollInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if heap := heapSize(); heap > maxMem {
+						cancel(fmt.Errorf("ran out of memory: heap of %s is over the limit of %s", FormatByteSize(heap), FormatByteSize(maxMem)))
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	return ctx, func() {
+		cancel(context.Canceled)
+		cancelTimeout()
+	}
+}
+
+// Interrupted returns why ctx was cancelled, or nil while it's still live. Long
+// loops call it every so often and stop with what they have so far.
+func Interrupted(ctx context.Context) error {
+	if ctx.Err() == nil {
+		return nil
+	}
+
+	return context.Cause(ctx)
+}
+
+// Watchdog calls stop with the cause once ctx is over its limits, unless it's
+// disarmed within grace. Solvers that watch their context get that long to stop
+// with what they have; the ones that don't are stopped anyway. A context
+// cancelled by its CancelFunc rather than a limit doesn't trip it.
+func Watchdog(ctx context.Context, grace time.Duration, stop func(cause error)) (disarm func()) {
+	disarmed := make(chan struct{})
+
+	go func() {
+		select {
+		case <-disarmed:
+			return
+		case <-ctx.Done():
+		}
+
+		cause := context.Cause(ctx)
+		if errors.Is(cause, context.Canceled) {
+			return
+		}
+
+		select {
+		case <-disarmed:
+		case <-time.After(grace):
+			stop(cause)
+		}
+	}()
+
+	var once sync.Once
+
+	return func() { once.Do(func() { close(disarmed) }) }
+}
@@ -0,0 +1,92 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseByteSize(t *testing.T) {
+	type testCase struct {
+		size         string
+		expectedSize uint64
+	}
+
+	testCases := []testCase{
+		{"1024", 1024},
+		{"2K", 2048},
+		{"512MiB", 512 << 20},
+		{"4GB", 4e9},
+		{"1.5G", 3 << 29},
+		{"10 B", 10},
+	}
+
+	for _, test := range testCases {
+		size, err := ParseByteSize(test.size)
+		assert.NoError(t, err, test.size)
+		assert.Equal(t, test.expectedSize, size, test.size)
+	}
+
+	for _, bad := range []string{"", "GiB", "-1M", "lots"} {
+		_, err := ParseByteSize(bad)
+		assert.Error(t, err, bad)
+	}
+
+	assert.Equal(t, "512MiB", FormatByteSize(512<<20))
+	assert.Equal(t, "1.5GiB", FormatByteSize(3<<29))
+	assert.Equal(t, "64.5MiB", FormatByteSize(67619328))
+	assert.Equal(t, "100B", FormatByteSize(100))
+}
+
+func TestWithLimits(t *testing.T) {
+	ctx, cancel := WithLimits(context.Background(), 0, 0)
+	assert.NoError(t, Interrupted(ctx))
+	cancel()
+	assert.ErrorIs(t, Interrupted(ctx), context.Canceled)
+
+	ctx, cancel = WithLimits(context.Background(), time.Millisecond, 0)
+	defer cancel()
+	<-ctx.Done()
+	assert.EqualError(t, Interrupted(ctx), "timed out after 1ms")
+
+	// Any heap at all is over a one byte limit.
+	ctx, cancel = WithLimits(context.Background(), time.Minute, 1)
+	defer cancel()
+	<-ctx.Done()
+	assert.ErrorContains(t, Interrupted(ctx), "ran out of memory")
+	assert.ErrorContains(t, Interrupted(ctx), "over the limit of 1B")
+}
+
+func TestWatchdog(t *testing.T) {
+	stopped := make(chan error, 1)
+	stop := func(cause error) { stopped <- cause }
+
+	ctx, cancel := WithLimits(context.Background(), time.Millisecond, 0)
+	defer cancel()
+
+	Watchdog(ctx, time.Millisecond, stop)
+	assert.EqualError(t, <-stopped, "timed out after 1ms")
+
+	// A solver that stops by itself in time disarms it.
+	ctx, cancel = WithLimits(context.Background(), time.Millisecond, 0)
+	defer cancel()
+
+	disarm := Watchdog(ctx, time.Minute, stop)
+	<-ctx.Done()
+	disarm()
+	disarm()
+
+	// So does finishing before the limit, and cancelling.
+	ctx, cancel = WithLimits(context.Background(), time.Minute, 0)
+	Watchdog(ctx, 0, stop)
+	cancel()
+
+	time.Sleep(10 * time.Millisecond)
+	assert.Empty(t, stopped)
+}
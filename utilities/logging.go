@@ -0,0 +1,272 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+// Each -v shows one more level of debug output.
+const (
+	LevelVerbose = slog.LevelDebug
+	LevelDetail  = slog.LevelDebug - 4
+	LevelTrace   = slog.LevelDebug - 8
+)
+
+// PackageKey is the attribute naming the day a solver's logger belongs to, like
+// 2024/day06.
+const PackageKey = "package"
+
+var levelNames = map[slog.Level]string{LevelVerbose: "DEBUG", LevelDetail: "DETAIL", LevelTrace: "TRACE"}
+
+func levelName(level slog.Level) string {
+	if name, ok := levelNames[level]; ok {
+		return name
+	}
+
+	return level.String()
+}
+
+// VerbosityLevel is the lowest level shown with verbosity -v flags.
+func VerbosityLevel(verbosity int) slog.Level {
+	return slog.LevelInfo - slog.Level(4*verbosity)
+}
+
+// LogOptions configures NewLogger. Filter limits the output to the days named,
+// or to whole years; with no filter every day's output is shown. Color keeps the
+// colours of rendered grids, for when w is a terminal; otherwise they're stripped,
+// and JSON output never has them.
+type LogOptions struct {
+	Verbosity int
+	JSON      bool
+	Color     bool
+	Filter    []string
+}
+
+var colorPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// stripColor removes the escape sequences that colour text on a terminal.
+func stripColor(s string) string {
+	return colorPattern.ReplaceAllString(s, "")
+}
+
+// NewLogger writes to w as text, or as a line of JSON per record.
+func NewLogger(w io.Writer, options LogOptions) *slog.Logger {
+	level := VerbosityLevel(options.Verbosity)
+
+	var handler slog.Handler
+
+	if options.JSON {
+		handler = slog.NewJSONHandler(w, &slog.HandlerOptions{
+			Level: level,
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if a.Key == slog.LevelKey && len(groups) == 0 {
+					a.Value = slog.StringValue(levelName(a.Value.Any().(slog.Level)))
+				}
+
+				if a.Value.Kind() == slog.KindString {
+					a.Value = slog.StringValue(stripColor(a.Value.String()))
+				}
+
+				return a
+			},
+		})
+	} else {
+		handler = &textHandler{w: w, mu: &sync.Mutex{}, level: level, color: options.Color}
+	}
+
+	if len(options.Filter) > 0 {
+		handler = &filterHandler{Handler: handler, filter: options.Filter}
+	}
+
+	return slog.New(handler)
+}
+
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (d discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return d }
+func (d discardHandler) WithGroup(string) slog.Handler           { return d }
+
+// DiscardLogger shows nothing. It's what solvers get when nothing else was set
+// up, as in tests.
+var DiscardLogger = slog.New(discardHandler{})
+
+// Lazy puts off working out an attribute's value, like a rendered map, until a
+// record that's going to be shown needs it.
+type Lazy func() any
+
+func (f Lazy) LogValue() slog.Value {
+	return slog.AnyValue(f())
+}
+
+type loggerKey struct{}
+
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// GetLogger returns the logger for a day command, tagged with the day it's for.
+func GetLogger(cmd *cobra.Command) *slog.Logger {
+	logger, ok := GetContext(cmd).Value(loggerKey{}).(*slog.Logger)
+	if !ok {
+		return DiscardLogger
+	}
+
+	if cmd.HasParent() {
+		logger = logger.With(PackageKey, cmd.Parent().Name()+"/"+cmd.Name())
+	}
+
+	return logger
+}
+
+// textHandler writes a line per record: the level, the day, the message and then
+// the attributes. Attribute values running over several lines, like rendered
+// maps, follow as they are.
+type textHandler struct {
+	w      io.Writer
+	mu     *sync.Mutex
+	level  slog.Level
+	color  bool
+	attrs  []slog.Attr
+	prefix string
+}
+
+func (h *textHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level
+}
+
+func (h *textHandler) Handle(_ context.Context, r slog.Record) error {
+	var line strings.Builder
+	blocks := make([]string, 0)
+
+	line.WriteString(levelName(r.Level))
+
+	add := func(a slog.Attr) {
+		a.Value = a.Value.Resolve()
+		value := a.Value.String()
+
+		if !h.color {
+			value = stripColor(value)
+		}
+
+		switch {
+		case a.Key == PackageKey:
+			return
+		case strings.Contains(value, "\n"):
+			blocks = append(blocks, strings.TrimSuffix(value, "\n"))
+			value = "↓"
+		case value == "" || strings.ContainsAny(value, " =\"\t"):
+			value = strconv.Quote(value)
+		}
+
+		fmt.Fprintf(&line, " %s=%s", a.Key, value)
+	}
+
+	for _, a := range h.attrs {
+		if a.Key == PackageKey {
+			fmt.Fprintf(&line, " %s:", a.Value)
+		}
+	}
+
+	fmt.Fprintf(&line, " %s", r.Message)
+
+	for _, a := range h.attrs {
+		add(a)
+	}
+
+	r.Attrs(func(a slog.Attr) bool {
+		a.Key = h.prefix + a.Key
+		add(a)
+
+		return true
+	})
+
+	line.WriteString("\n")
+
+	for _, block := range blocks {
+		line.WriteString(block + "\n")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	_, err := io.WriteString(h.w, line.String())
+
+	return err
+}
+
+func (h *textHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handler := *h
+	handler.attrs = make([]slog.Attr, 0, len(h.attrs)+len(attrs))
+	handler.attrs = append(handler.attrs, h.attrs...)
+
+	for _, a := range attrs {
+		if a.Key != PackageKey {
+			a.Key = h.prefix + a.Key
+		}
+		handler.attrs = append(handler.attrs, a)
+	}
+
+	return &handler
+}
+
+func (h *textHandler) WithGroup(name string) slog.Handler {
+	handler := *h
+	handler.prefix = h.prefix + name + "."
+
+	return &handler
+}
+
+// filterHandler drops the records of days that don't match its filter.
+type filterHandler struct {
+	slog.Handler
+	filter []string
+	pkg    string
+}
+
+func (h *filterHandler) matches() bool {
+	if h.pkg == "" {
+		return true
+	}
+
+	for _, f := range h.filter {
+		f = strings.Trim(f, "/")
+		if h.pkg == f || strings.HasPrefix(h.pkg, f+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *filterHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.matches() && h.Handler.Enabled(ctx, level)
+}
+
+func (h *filterHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	pkg := h.pkg
+	for _, a := range attrs {
+		if a.Key == PackageKey {
+			pkg = a.Value.String()
+		}
+	}
+
+	return &filterHandler{Handler: h.Handler.WithAttrs(attrs), filter: h.filter, pkg: pkg}
+}
+
+func (h *filterHandler) WithGroup(name string) slog.Handler {
+	return &filterHandler{Handler: h.Handler.WithGroup(name), filter: h.filter, pkg: h.pkg}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggerLevels(t *testing.T) {
+	type testCase struct {
+		verbosity      int
+		expectedOutput string
+	}
+
+	testCases := []testCase{
+		{0, "INFO start\n"},
+		{1, "INFO start\nDEBUG step n=1\n"},
+		{3, "INFO start\nDEBUG step n=1\nDETAIL tilted load=136 platform=↓\nO.#\n..O\nTRACE try equation=\"3 + 4\"\n"},
+	}
+
+	for _, test := range testCases {
+		var out bytes.Buffer
+		logger := NewLogger(&out, LogOptions{Verbosity: test.verbosity})
+
+		logger.Info("start")
+		logger.Debug("step", "n", 1)
+		logger.Log(context.Background(), LevelDetail, "tilted", "load", 136, "platform", "O.#\n..O\n")
+		logger.Log(context.Background(), LevelTrace, "try", "equation", "3 + 4")
+
+		assert.Equal(t, test.expectedOutput, out.String(), test.verbosity)
+	}
+}
+
+func TestLoggerFilter(t *testing.T) {
+	var out bytes.Buffer
+	logger := NewLogger(&out, LogOptions{Verbosity: 1, Filter: []string{"2024/day06", "2023"}})
+
+	logger.With(PackageKey, "2024/day06").Debug("loops", "x", 3)
+	logger.With(PackageKey, "2024/day07").Debug("hidden")
+	logger.With(PackageKey, "2023/day14").With("cycle", 2).Debug("spun")
+	logger.Debug("no day")
+
+	assert.Equal(t, "DEBUG 2024/day06: loops x=3\nDEBUG 2023/day14: spun cycle=2\nDEBUG no day\n", out.String())
+}
+
+func TestLoggerJSON(t *testing.T) {
+	var out bytes.Buffer
+	logger := NewLogger(&out, LogOptions{Verbosity: 2, JSON: true}).With(PackageKey, "2024/day07")
+
+	logger.Log(context.Background(), LevelDetail, "valid", "value", 190)
+
+	assert.Regexp(t, `^\{"time":"[^"]+","level":"DETAIL","msg":"valid","package":"2024/day07","value":190\}\n$`, out.String())
+}
+
+func TestLoggerColor(t *testing.T) {
+	grid := "\x1b[32mX\x1b[0m.\n\x1b[1;31m#\x1b[0m.\n"
+
+	var out bytes.Buffer
+	NewLogger(&out, LogOptions{Verbosity: 1, Color: true}).Debug("walked", "grid", grid)
+	assert.Equal(t, "DEBUG walked grid=↓\n"+grid, out.String())
+
+	out.Reset()
+	NewLogger(&out, LogOptions{Verbosity: 1}).Debug("walked", "grid", grid)
+	assert.Equal(t, "DEBUG walked grid=↓\nX.\n#.\n", out.String())
+
+	out.Reset()
+	NewLogger(&out, LogOptions{Verbosity: 1, JSON: true, Color: true}).Debug("walked", "grid", Lazy(func() any { return grid }))
+	assert.Contains(t, out.String(), `"grid":"X.\n#.\n"`)
+	assert.NotContains(t, out.String(), `\u001b`)
+}
+
+func TestLazy(t *testing.T) {
+	var out bytes.Buffer
+	logger := NewLogger(&out, LogOptions{Verbosity: 1})
+
+	rendered := 0
+	render := Lazy(func() any { rendered++; return "#.#" })
+
+	logger.Log(context.Background(), LevelDetail, "hidden", "grid", render)
+	logger.Debug("shown", "grid", render)
+
+	assert.Equal(t, 1, rendered)
+	assert.Equal(t, "DEBUG shown grid=#.#\n", out.String())
+}
+
+func TestGetLogger(t *testing.T) {
+	assert.Same(t, DiscardLogger, GetLogger(nil))
+	assert.False(t, DiscardLogger.Enabled(context.Background(), LevelTrace))
+
+	var out bytes.Buffer
+
+	year := &cobra.Command{Use: "2024"}
+	day := &cobra.Command{Use: "day06"}
+	year.AddCommand(day)
+	day.SetContext(WithLogger(context.Background(), NewLogger(&out, LogOptions{Verbosity: 1})))
+
+	GetLogger(day).Debug("walked", "steps", 41)
+	assert.Equal(t, "DEBUG 2024/day06: walked steps=41\n", out.String())
+}
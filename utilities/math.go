@@ -300,3 +300,31 @@ func Abs[T constraints.Integer](a T) T {
 	}
 	return a
 }
+
+func GCD[T constraints.Integer](a, b T) T {
+	a, b = Abs(a), Abs(b)
+
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+func LCM[T constraints.Integer](values ...T) T {
+	if len(values) == 0 {
+		return 0
+	}
+
+	result := Abs(values[0])
+
+	for _, v := range values[1:] {
+		if result == 0 || v == 0 {
+			return 0
+		}
+
+		result = result / GCD(result, v) * Abs(v)
+	}
+
+	return result
+}
@@ -193,3 +193,42 @@ func TestDigitCount(t *testing.T) {
 		assert.Equal(t, test.expectedDigitCount, DigitCount(test.number))
 	}
 }
+
+func TestGCD(t *testing.T) {
+	type testCase struct {
+		a, b        int
+		expectedGCD int
+	}
+
+	testCases := []testCase{
+		{12, 18, 6},
+		{18, 12, 6},
+		{7, 13, 1},
+		{0, 5, 5},
+		{-4, 6, 2},
+	}
+
+	for _, test := range testCases {
+		assert.Equal(t, test.expectedGCD, GCD(test.a, test.b))
+	}
+}
+
+func TestLCM(t *testing.T) {
+	type testCase struct {
+		values      []int64
+		expectedLCM int64
+	}
+
+	testCases := []testCase{
+		{[]int64{4, 6}, 12},
+		{[]int64{3, 5, 7}, 105},
+		{[]int64{3761, 3931, 3889, 4057}, 233264864469443},
+		{[]int64{5}, 5},
+		{[]int64{}, 0},
+		{[]int64{4, 0}, 0},
+	}
+
+	for _, test := range testCases {
+		assert.Equal(t, test.expectedLCM, LCM(test.values...))
+	}
+}
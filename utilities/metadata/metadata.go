@@ -0,0 +1,168 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+// Package metadata describes each day's puzzle: its title, how far the solution
+// has got, the puzzle statement and tags for the techniques it uses. Every day
+// keeps its description in a puzzle.yaml next to its code.
+package metadata
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the metadata file in each day's directory.
+const FileName = "puzzle.yaml"
+
+type Status string
+
+const (
+	Complete Status = "complete"
+	Partial  Status = "partial"
+	Stub     Status = "stub"
+)
+
+// Day is the metadata of one day's puzzle. Parts holds the statement of each
+// part, in order.
+type Day struct {
+	Year   int      `yaml:"-"`
+	Day    int      `yaml:"-"`
+	Title  string   `yaml:"title"`
+	Status Status   `yaml:"status"`
+	Tags   []string `yaml:"tags,omitempty"`
+	Input  string   `yaml:"input"`
+	Parts  []string `yaml:"parts"`
+}
+
+// Parse reads a day's metadata file.
+func Parse(year, day int, contents []byte) (*Day, error) {
+	d := &Day{}
+
+	if err := yaml.Unmarshal(contents, d); err != nil {
+		return nil, err
+	}
+
+	d.Year = year
+	d.Day = day
+
+	if d.Title == "" {
+		return nil, fmt.Errorf("%d day %d: missing title", year, day)
+	}
+
+	switch d.Status {
+	case Complete, Partial, Stub:
+	default:
+		return nil, fmt.Errorf("%d day %d: invalid status '%s'", year, day, d.Status)
+	}
+
+	if d.Input != fmt.Sprintf("day%02d_input.txt", day) {
+		return nil, fmt.Errorf("%d day %d: unexpected input file '%s'", year, day, d.Input)
+	}
+
+	return d, nil
+}
+
+var days = make(map[int]map[int]*Day)
+
+// RegisterYear reads the metadata of every day of a year from files, where each
+// day's is in dayNN/puzzle.yaml.
+func RegisterYear(year int, files fs.FS) error {
+	paths, err := fs.Glob(files, path.Join("day*", FileName))
+	if err != nil {
+		return err
+	}
+
+	for _, p := range paths {
+		day, err := strconv.Atoi(strings.TrimPrefix(path.Dir(p), "day"))
+		if err != nil {
+			return fmt.Errorf("%s: not in a day's directory", p)
+		}
+
+		contents, err := fs.ReadFile(files, p)
+		if err != nil {
+			return err
+		}
+
+		d, err := Parse(year, day, contents)
+		if err != nil {
+			return err
+		}
+
+		if days[year] == nil {
+			days[year] = make(map[int]*Day)
+		}
+
+		days[year][day] = d
+	}
+
+	return nil
+}
+
+// MustRegisterYear is RegisterYear for metadata embedded in the binary, where a
+// bad file can only be a mistake in the tree.
+func MustRegisterYear(year int, files fs.FS) {
+	if err := RegisterYear(year, files); err != nil {
+		panic(err)
+	}
+}
+
+func Lookup(year, day int) (*Day, bool) {
+	d, ok := days[year][day]
+
+	return d, ok
+}
+
+// Years returns the years with metadata, in order.
+func Years() []int {
+	years := make([]int, 0, len(days))
+	for year := range days {
+		years = append(years, year)
+	}
+
+	sort.Ints(years)
+
+	return years
+}
+
+// Year returns the metadata of a year's days, in order.
+func Year(year int) []*Day {
+	yearDays := make([]*Day, 0, len(days[year]))
+	for _, d := range days[year] {
+		yearDays = append(yearDays, d)
+	}
+
+	sort.Slice(yearDays, func(i, j int) bool { return yearDays[i].Day < yearDays[j].Day })
+
+	return yearDays
+}
+
+var partNames = []string{"One", "Two"}
+
+// Statement lays out the puzzle statement the way the site does.
+func (d *Day) Statement() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "--- Day %d: %s ---\n", d.Day, d.Title)
+
+	for i, part := range d.Parts {
+		if i > 0 {
+			name := strconv.Itoa(i + 1)
+			if i < len(partNames) {
+				name = partNames[i]
+			}
+
+			fmt.Fprintf(&b, "\n--- Part %s ---\n", name)
+		}
+
+		fmt.Fprintf(&b, "\n%s\n", strings.TrimSpace(part))
+	}
+
+	return b.String()
+}
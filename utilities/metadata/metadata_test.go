@@ -0,0 +1,94 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package metadata
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const guardGallivant = `title: "Guard Gallivant"
+status: complete
+tags: [grid, simulation]
+input: day06_input.txt
+parts:
+  - |-
+    The map shows the guard.
+
+    Predict the path of the guard.
+  - |-
+    How many different positions could you choose for this obstruction?
+`
+
+func TestParse(t *testing.T) {
+	d, err := Parse(2024, 6, []byte(guardGallivant))
+	assert.NoError(t, err)
+	assert.Equal(t, &Day{
+		Year:   2024,
+		Day:    6,
+		Title:  "Guard Gallivant",
+		Status: Complete,
+		Tags:   []string{"grid", "simulation"},
+		Input:  "day06_input.txt",
+		Parts:  []string{"The map shows the guard.\n\nPredict the path of the guard.", "How many different positions could you choose for this obstruction?"},
+	}, d)
+
+	_, err = Parse(2024, 6, []byte("status: complete\ninput: day06_input.txt\n"))
+	assert.ErrorContains(t, err, "missing title")
+
+	_, err = Parse(2024, 6, []byte("title: x\nstatus: done\ninput: day06_input.txt\n"))
+	assert.ErrorContains(t, err, "invalid status 'done'")
+
+	_, err = Parse(2024, 6, []byte("title: x\nstatus: stub\ninput: day6.txt\n"))
+	assert.ErrorContains(t, err, "unexpected input file")
+
+	_, err = Parse(2024, 6, []byte("title: [x"))
+	assert.Error(t, err)
+}
+
+func TestRegisterYear(t *testing.T) {
+	files := fstest.MapFS{
+		"day06/puzzle.yaml": {Data: []byte(guardGallivant)},
+		"day01/puzzle.yaml": {Data: []byte("title: Historian Hysteria\nstatus: partial\ninput: day01_input.txt\nparts: []\n")},
+		"day06/day06.go":    {Data: []byte("package day06")},
+	}
+
+	assert.NoError(t, RegisterYear(1999, files))
+	defer delete(days, 1999)
+
+	assert.Contains(t, Years(), 1999)
+
+	yearDays := Year(1999)
+	assert.Len(t, yearDays, 2)
+	assert.Equal(t, "Historian Hysteria", yearDays[0].Title)
+	assert.Equal(t, 6, yearDays[1].Day)
+
+	d, ok := Lookup(1999, 6)
+	assert.True(t, ok)
+	assert.Equal(t, Complete, d.Status)
+
+	_, ok = Lookup(1999, 2)
+	assert.False(t, ok)
+
+	assert.Error(t, RegisterYear(1998, fstest.MapFS{"day02/puzzle.yaml": {Data: []byte("title: x\n")}}))
+}
+
+func TestStatement(t *testing.T) {
+	d, err := Parse(2024, 6, []byte(guardGallivant))
+	assert.NoError(t, err)
+
+	assert.Equal(t, `--- Day 6: Guard Gallivant ---
+
+The map shows the guard.
+
+Predict the path of the guard.
+
+--- Part Two ---
+
+How many different positions could you choose for this obstruction?
+`, d.Statement())
+}
@@ -0,0 +1,115 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Advent of Code draws capital letters 4 pixels wide and 6 pixels tall, with one
+// blank column between letters.
+const (
+	LetterWidth   = 4
+	LetterHeight  = 6
+	LetterSpacing = 1
+)
+
+var letterFont = map[rune][LetterHeight]string{
+	'A': {".##.", "#..#", "#..#", "####", "#..#", "#..#"},
+	'B': {"###.", "#..#", "###.", "#..#", "#..#", "###."},
+	'C': {".##.", "#..#", "#...", "#...", "#..#", ".##."},
+	'E': {"####", "#...", "###.", "#...", "#...", "####"},
+	'F': {"####", "#...", "###.", "#...", "#...", "#..."},
+	'G': {".##.", "#..#", "#...", "#.##", "#..#", ".###"},
+	'H': {"#..#", "#..#", "####", "#..#", "#..#", "#..#"},
+	'I': {".###", "..#.", "..#.", "..#.", "..#.", ".###"},
+	'J': {"..##", "...#", "...#", "...#", "#..#", ".##."},
+	'K': {"#..#", "#.#.", "##..", "#.#.", "#.#.", "#..#"},
+	'L': {"#...", "#...", "#...", "#...", "#...", "####"},
+	'O': {".##.", "#..#", "#..#", "#..#", "#..#", ".##."},
+	'P': {"###.", "#..#", "#..#", "###.", "#...", "#..."},
+	'R': {"###.", "#..#", "#..#", "###.", "#.#.", "#..#"},
+	'S': {".###", "#...", "#...", ".##.", "...#", "###."},
+	'U': {"#..#", "#..#", "#..#", "#..#", "#..#", ".##."},
+	'Z': {"####", "...#", "..#.", ".#..", "#...", "####"},
+}
+
+var letterGlyphs = func() map[string]rune {
+	glyphs := make(map[string]rune, len(letterFont))
+
+	for letter, rows := range letterFont {
+		glyphs[strings.Join(rows[:], "\n")] = letter
+	}
+
+	return glyphs
+}()
+
+// RenderLetters draws text in the letter font, the inverse of RecognizeLetters.
+func RenderLetters(text string) ([]string, error) {
+	rows := make([]string, LetterHeight)
+
+	for i, letter := range text {
+		glyph, ok := letterFont[letter]
+		if !ok {
+			return nil, fmt.Errorf("no glyph for '%c'", letter)
+		}
+
+		for y := range rows {
+			if i > 0 {
+				rows[y] += strings.Repeat(".", LetterSpacing)
+			}
+			rows[y] += glyph[y]
+		}
+	}
+
+	return rows, nil
+}
+
+// RecognizeLetters reads the capital letters drawn on a screen, where '#' is a lit
+// pixel and anything else is dark. Rows may be ragged, as when the picture comes
+// from a set of points; missing pixels are dark.
+func RecognizeLetters(rows []string) (string, error) {
+	if len(rows) != LetterHeight {
+		return "", fmt.Errorf("screen has %d rows, expected %d", len(rows), LetterHeight)
+	}
+
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+
+	count := (width + LetterWidth) / (LetterWidth + LetterSpacing)
+
+	var sb strings.Builder
+
+	for i := 0; i < count; i++ {
+		glyph := make([]string, LetterHeight)
+
+		for y, row := range rows {
+			pixels := make([]byte, LetterWidth)
+
+			for x := range pixels {
+				column := i*(LetterWidth+LetterSpacing) + x
+				if column < len(row) && row[column] == '#' {
+					pixels[x] = '#'
+				} else {
+					pixels[x] = '.'
+				}
+			}
+
+			glyph[y] = string(pixels)
+		}
+
+		letter, ok := letterGlyphs[strings.Join(glyph, "\n")]
+		if !ok {
+			return "", fmt.Errorf("unrecognized letter %d:\n%s", i, strings.Join(glyph, "\n"))
+		}
+
+		sb.WriteRune(letter)
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecognizeLetters(t *testing.T) {
+	rows := strings.Split(`###..#..#.###..####..##..###..###..#..#.
+#..#.#..#.#..#.#....#..#.#..#.#..#.#.#..
+#..#.#..#.#..#.###..#....#..#.###..##...
+###..#..#.###..#....#....###..#..#.#.#..
+#.#..#..#.#.#..#....#..#.#....#..#.#.#..
+#..#..##..#..#.####..##..#....###..#..#.`, "\n")
+
+	text, err := RecognizeLetters(rows)
+	assert.NoError(t, err)
+	assert.Equal(t, "RURECPBK", text)
+
+	// A picture drawn from points has no trailing dark columns.
+	for y := range rows {
+		rows[y] = strings.TrimRight(rows[y], ".")
+	}
+
+	text, err = RecognizeLetters(rows)
+	assert.NoError(t, err)
+	assert.Equal(t, "RURECPBK", text)
+
+	_, err = RecognizeLetters(rows[:5])
+	assert.Error(t, err)
+
+	_, err = RecognizeLetters([]string{"####", "####", "####", "####", "####", "####"})
+	assert.Error(t, err)
+}
+
+func TestRenderLetters(t *testing.T) {
+	for letter := range letterFont {
+		rows, err := RenderLetters(string(letter))
+		assert.NoError(t, err)
+
+		text, err := RecognizeLetters(rows)
+		assert.NoError(t, err)
+		assert.Equal(t, string(letter), text)
+	}
+
+	rows, err := RenderLetters("ZAGJ")
+	assert.NoError(t, err)
+	assert.Len(t, rows[0], 4*LetterWidth+3*LetterSpacing)
+
+	text, err := RecognizeLetters(rows)
+	assert.NoError(t, err)
+	assert.Equal(t, "ZAGJ", text)
+
+	_, err = RenderLetters("Q")
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+type workersKey struct{}
+
+// WithWorkers sets how many goroutines ParallelMap uses under ctx. The -j flag
+// sets it for a day command.
+func WithWorkers(ctx context.Context, workers int) context.Context {
+	return context.WithValue(ctx, workersKey{}, workers)
+}
+
+// Workers is how many goroutines ParallelMap uses under ctx: one per CPU unless
+// WithWorkers says otherwise.
+func Workers(ctx context.Context) int {
+	if workers, ok := ctx.Value(workersKey{}).(int); ok && workers > 0 {
+		return workers
+	}
+
+	return runtime.GOMAXPROCS(0)
+}
+
+// ParallelMap calls f on every item from a pool of Workers(ctx) goroutines, and
+// returns the results in the same order as the items, however the work was split
+// up. f mustn't share anything it changes with the other calls. Once ctx is
+// cancelled no more items are started, and the reason is returned with the
+// results that were finished.
+func ParallelMap[T, R any](ctx context.Context, items []T, f func(T) R) ([]R, error) {
+	results := make([]R, len(items))
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < min(Workers(ctx), len(items)); w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indices {
+				// An item can be handed over just as ctx is cancelled.
+				if ctx.Err() == nil {
+					results[i] = f(items[i])
+				}
+			}
+		}()
+	}
+
+	func() {
+		defer close(indices)
+
+		for i := range items {
+			select {
+			case <-ctx.Done():
+				return
+			case indices <- i:
+			}
+		}
+	}()
+
+	wg.Wait()
+
+	return results, Interrupted(ctx)
+}
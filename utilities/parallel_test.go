@@ -0,0 +1,78 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"context"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelMap(t *testing.T) {
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i
+	}
+
+	for _, workers := range []int{1, 3, 200} {
+		ctx := WithWorkers(context.Background(), workers)
+
+		var running, most atomic.Int32
+
+		squares, err := ParallelMap(ctx, items, func(i int) int {
+			now := running.Add(1)
+			defer running.Add(-1)
+
+			for {
+				seen := most.Load()
+				if now <= seen || most.CompareAndSwap(seen, now) {
+					break
+				}
+			}
+
+			// Finish out of order.
+			time.Sleep(time.Duration(100-i) * time.Microsecond)
+
+			return i * i
+		})
+
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, int(most.Load()), workers)
+
+		for i, square := range squares {
+			assert.Equal(t, i*i, square)
+		}
+	}
+
+	empty, err := ParallelMap(context.Background(), []int{}, func(i int) int { return i })
+	assert.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestParallelMapCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(WithWorkers(context.Background(), 1))
+
+	results, err := ParallelMap(ctx, []int{1, 2, 3, 4}, func(i int) int {
+		if i == 2 {
+			cancel()
+		}
+
+		return i
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, results[0])
+	assert.Zero(t, results[3])
+}
+
+func TestWorkers(t *testing.T) {
+	assert.Equal(t, runtime.GOMAXPROCS(0), Workers(context.Background()))
+	assert.Equal(t, 4, Workers(WithWorkers(context.Background(), 4)))
+	assert.Equal(t, runtime.GOMAXPROCS(0), Workers(WithWorkers(context.Background(), 0)))
+}
@@ -5,6 +5,7 @@ Copyright © 2021-2024 Cameron Esfahani
 package utilities
 
 import (
+	"fmt"
 	"log"
 	"regexp"
 	"strconv"
@@ -46,3 +47,30 @@ func ParseIntListRemovingAllWhitespace(line string) []int {
 
 	return intList
 }
+
+// ParseDigitGrid parses rows of single digits into a rectangular grid indexed by
+// row, then column.
+func ParseDigitGrid(text string) ([][]int, error) {
+	grid := make([][]int, 0)
+
+	for y, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		line = strings.TrimSpace(line)
+		row := make([]int, 0, len(line))
+
+		for x, c := range line {
+			if c < '0' || c > '9' {
+				return nil, fmt.Errorf("invalid digit '%c' at %d,%d", c, x, y)
+			}
+
+			row = append(row, int(c-'0'))
+		}
+
+		if y > 0 && len(row) != len(grid[0]) {
+			return nil, fmt.Errorf("row %d has width %d, expected %d", y, len(row), len(grid[0]))
+		}
+
+		grid = append(grid, row)
+	}
+
+	return grid, nil
+}
@@ -61,3 +61,18 @@ func TestParseIntListNegative(t *testing.T) {
 		assert.Equal(t, test.expectedIntList, ParseIntList(strings.TrimPrefix(test.line, test.prefix)))
 	}
 }
+
+func TestParseDigitGrid(t *testing.T) {
+	grid, err := ParseDigitGrid(`
+2199
+3987
+9856`)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{2, 1, 9, 9}, {3, 9, 8, 7}, {9, 8, 5, 6}}, grid)
+
+	_, err = ParseDigitGrid("219\n39a")
+	assert.Error(t, err)
+
+	_, err = ParseDigitGrid("219\n3987")
+	assert.Error(t, err)
+}
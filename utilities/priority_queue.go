@@ -0,0 +1,72 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import "container/heap"
+
+type PriorityQueue[T any] struct {
+	Items []T
+	less  func(a, b T) bool
+}
+
+// NewPriorityQueue makes a queue that pops the item that sorts first under less.
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less}
+}
+
+func (pq *PriorityQueue[T]) Push(item T) {
+	heap.Push((*priorityQueueHeap[T])(pq), item)
+}
+
+func (pq *PriorityQueue[T]) Pop() T {
+	if len(pq.Items) == 0 {
+		panic("empty priority queue")
+	}
+
+	return heap.Pop((*priorityQueueHeap[T])(pq)).(T)
+}
+
+func (pq *PriorityQueue[T]) Peek() T {
+	if len(pq.Items) == 0 {
+		panic("empty priority queue")
+	}
+
+	return pq.Items[0]
+}
+
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.Items)
+}
+
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.Items) == 0
+}
+
+// priorityQueueHeap adapts PriorityQueue to container/heap without exposing the
+// heap.Interface methods on the queue itself.
+type priorityQueueHeap[T any] PriorityQueue[T]
+
+func (h *priorityQueueHeap[T]) Len() int {
+	return len(h.Items)
+}
+
+func (h *priorityQueueHeap[T]) Less(i, j int) bool {
+	return h.less(h.Items[i], h.Items[j])
+}
+
+func (h *priorityQueueHeap[T]) Swap(i, j int) {
+	h.Items[i], h.Items[j] = h.Items[j], h.Items[i]
+}
+
+func (h *priorityQueueHeap[T]) Push(x any) {
+	h.Items = append(h.Items, x.(T))
+}
+
+func (h *priorityQueueHeap[T]) Pop() any {
+	item := h.Items[len(h.Items)-1]
+	h.Items = h.Items[:len(h.Items)-1]
+
+	return item
+}
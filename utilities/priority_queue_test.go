@@ -0,0 +1,52 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityQueueMin(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+
+	for _, i := range []int{5, 1, 4, 2, 3} {
+		pq.Push(i)
+	}
+
+	assert.Equal(t, 5, pq.Len())
+	assert.Equal(t, 1, pq.Peek())
+
+	for i := 1; i <= 5; i++ {
+		assert.Equal(t, i, pq.Pop())
+	}
+
+	assert.True(t, pq.IsEmpty())
+}
+
+func TestPriorityQueueMax(t *testing.T) {
+	type item struct {
+		Name     string
+		Priority int
+	}
+
+	pq := NewPriorityQueue(func(a, b item) bool { return a.Priority > b.Priority })
+
+	pq.Push(item{"low", 1})
+	pq.Push(item{"high", 10})
+	pq.Push(item{"middle", 5})
+
+	assert.Equal(t, "high", pq.Pop().Name)
+	assert.Equal(t, "middle", pq.Pop().Name)
+	assert.Equal(t, "low", pq.Pop().Name)
+	assert.True(t, pq.IsEmpty())
+}
+
+func TestPriorityQueueEmptyPop(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+
+	assert.Panics(t, func() { pq.Pop() })
+}
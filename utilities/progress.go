@@ -0,0 +1,185 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ProgressInterval is how often a Progress reports while its count changes.
+// Starting and finishing a phase are always reported.
+const ProgressInterval = 250 * time.Millisecond
+
+// ProgressEvent is a snapshot of a long computation: how far through which phase
+// it is, how fast it's going and how long it should take to finish. Total is 0
+// when the end isn't known, and then so is ETA.
+type ProgressEvent struct {
+	Phase   string
+	Current int64
+	Total   int64
+	Rate    float64
+	Elapsed time.Duration
+	ETA     time.Duration
+	Done    bool
+}
+
+// ProgressReporter shows progress events to someone.
+type ProgressReporter interface {
+	Report(event ProgressEvent)
+}
+
+type silentProgress struct{}
+
+func (silentProgress) Report(ProgressEvent) {}
+
+// SilentProgress drops every event. It's the reporter until the root command picks
+// another, so tests stay quiet.
+var SilentProgress ProgressReporter = silentProgress{}
+
+type terminalProgress struct {
+	w io.Writer
+}
+
+// NewTerminalProgress draws progress as a single line on a terminal, redrawn in
+// place as it changes.
+func NewTerminalProgress(w io.Writer) ProgressReporter {
+	return &terminalProgress{w: w}
+}
+
+func (t *terminalProgress) Report(event ProgressEvent) {
+	line := fmt.Sprintf("%s: %d", event.Phase, event.Current)
+
+	if event.Total > 0 {
+		line += fmt.Sprintf("/%d (%.1f%%)", event.Total, 100*float64(event.Current)/float64(event.Total))
+	}
+
+	line += fmt.Sprintf(" %.0f/s", event.Rate)
+
+	if event.Done {
+		line += fmt.Sprintf(" in %s\n", event.Elapsed.Round(time.Millisecond))
+	} else if event.ETA > 0 {
+		line += fmt.Sprintf(" ETA %s", event.ETA.Round(time.Second))
+	}
+
+	// Return to the start of the line and clear it before drawing over it.
+	fmt.Fprintf(t.w, "\r\x1b[K%s", line)
+}
+
+type ndjsonProgress struct {
+	encoder *json.Encoder
+}
+
+// NewNDJSONProgress writes each event as a line of JSON, for scripts following
+// along.
+func NewNDJSONProgress(w io.Writer) ProgressReporter {
+	return &ndjsonProgress{encoder: json.NewEncoder(w)}
+}
+
+func (n *ndjsonProgress) Report(event ProgressEvent) {
+	type progressLine struct {
+		Event          string  `json:"event"`
+		Phase          string  `json:"phase"`
+		Current        int64   `json:"current"`
+		Total          int64   `json:"total,omitempty"`
+		Rate           float64 `json:"rate"`
+		ElapsedSeconds float64 `json:"elapsed_seconds"`
+		ETASeconds     float64 `json:"eta_seconds,omitempty"`
+		Done           bool    `json:"done,omitempty"`
+	}
+
+	n.encoder.Encode(progressLine{
+		Event:          "progress",
+		Phase:          event.Phase,
+		Current:        event.Current,
+		Total:          event.Total,
+		Rate:           event.Rate,
+		ElapsedSeconds: event.Elapsed.Seconds(),
+		ETASeconds:     event.ETA.Seconds(),
+		Done:           event.Done,
+	})
+}
+
+var progressReporter = SilentProgress
+
+// SetProgressReporter picks where new Progresses report, and returns the reporter
+// it replaces.
+func SetProgressReporter(reporter ProgressReporter) ProgressReporter {
+	previous := progressReporter
+	progressReporter = reporter
+
+	return previous
+}
+
+// Progress counts through the phases of a long computation, reporting at most
+// every ProgressInterval. It's cheap enough to update on every iteration of a loop
+// doing real work, but isn't safe to share between goroutines.
+type Progress struct {
+	reporter ProgressReporter
+	now      func() time.Time
+	phase    string
+	current  int64
+	total    int64
+	start    time.Time
+	reported time.Time
+}
+
+// NewProgress starts the first phase of a computation, which takes total steps, or
+// an unknown number when total is 0.
+func NewProgress(phase string, total int64) *Progress {
+	p := &Progress{reporter: progressReporter, now: time.Now}
+	p.Phase(phase, total)
+
+	return p
+}
+
+// Phase starts a new phase, counting from 0 again.
+func (p *Progress) Phase(phase string, total int64) {
+	p.phase = phase
+	p.current = 0
+	p.total = total
+	p.start = p.now()
+	p.reported = p.start
+
+	p.reporter.Report(p.Event())
+}
+
+// Set moves the count to current.
+func (p *Progress) Set(current int64) {
+	p.current = current
+
+	if now := p.now(); now.Sub(p.reported) >= ProgressInterval {
+		p.reported = now
+		p.reporter.Report(p.Event())
+	}
+}
+
+func (p *Progress) Add(steps int64) {
+	p.Set(p.current + steps)
+}
+
+// Done reports that the phase has finished.
+func (p *Progress) Done() {
+	event := p.Event()
+	event.Done = true
+
+	p.reporter.Report(event)
+}
+
+func (p *Progress) Event() ProgressEvent {
+	event := ProgressEvent{Phase: p.phase, Current: p.current, Total: p.total, Elapsed: p.now().Sub(p.start)}
+
+	if event.Elapsed > 0 {
+		event.Rate = float64(p.current) / event.Elapsed.Seconds()
+	}
+
+	if event.Total > 0 && event.Rate > 0 {
+		event.ETA = time.Duration(float64(event.Total-event.Current) / event.Rate * float64(time.Second))
+	}
+
+	return event
+}
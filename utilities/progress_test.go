@@ -0,0 +1,74 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package utilities
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordedProgress []ProgressEvent
+
+func (r *recordedProgress) Report(event ProgressEvent) {
+	*r = append(*r, event)
+}
+
+func TestProgress(t *testing.T) {
+	events := &recordedProgress{}
+	previous := SetProgressReporter(events)
+	defer SetProgressReporter(previous)
+
+	clock := time.Unix(0, 0)
+
+	p := NewProgress("cycles", 1000)
+	p.now = func() time.Time { return clock }
+
+	// Start again on the fake clock.
+	p.Phase("cycles", 1000)
+
+	// Updates between intervals aren't reported.
+	clock = clock.Add(ProgressInterval / 2)
+	p.Add(50)
+	clock = clock.Add(ProgressInterval / 2)
+	p.Add(50)
+
+	clock = clock.Add(ProgressInterval)
+	p.Set(250)
+	p.Done()
+
+	p.Phase("count", 0)
+	p.Add(1)
+
+	assert.Equal(t, []ProgressEvent{
+		{Phase: "cycles", Total: 1000},
+		{Phase: "cycles", Current: 100, Total: 1000, Rate: 400, Elapsed: ProgressInterval, ETA: 2250 * time.Millisecond},
+		{Phase: "cycles", Current: 250, Total: 1000, Rate: 500, Elapsed: 2 * ProgressInterval, ETA: 1500 * time.Millisecond},
+		{Phase: "cycles", Current: 250, Total: 1000, Rate: 500, Elapsed: 2 * ProgressInterval, ETA: 1500 * time.Millisecond, Done: true},
+		{Phase: "count"},
+	}, []ProgressEvent((*events)[1:]))
+}
+
+func TestProgressReporters(t *testing.T) {
+	event := ProgressEvent{Phase: "rocks", Current: 250, Total: 1000, Rate: 500, Elapsed: 500 * time.Millisecond, ETA: 1500 * time.Millisecond}
+
+	var terminal bytes.Buffer
+	NewTerminalProgress(&terminal).Report(event)
+	assert.Equal(t, "\r\x1b[Krocks: 250/1000 (25.0%) 500/s ETA 2s", terminal.String())
+
+	event.Done = true
+	terminal.Reset()
+	NewTerminalProgress(&terminal).Report(event)
+	assert.Equal(t, "\r\x1b[Krocks: 250/1000 (25.0%) 500/s in 500ms\n", terminal.String())
+
+	var ndjson bytes.Buffer
+	NewNDJSONProgress(&ndjson).Report(event)
+	assert.Equal(t, `{"event":"progress","phase":"rocks","current":250,"total":1000,"rate":500,"elapsed_seconds":0.5,"eta_seconds":1.5,"done":true}`+"\n", ndjson.String())
+
+	// Nothing to see, nothing to check beyond not panicking.
+	SilentProgress.Report(event)
+}
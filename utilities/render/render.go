@@ -0,0 +1,202 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+// Package render snapshots grid simulations into frames and writes them out as an
+// animated GIF or a sequence of PNG files.
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+)
+
+// Palette assigns a colour to each type of cell. Cells without a colour are drawn
+// in the background colour.
+type Palette[K comparable] struct {
+	Colors  color.Palette
+	indices map[K]uint8
+}
+
+func NewPalette[K comparable](background color.Color) *Palette[K] {
+	return &Palette[K]{Colors: color.Palette{background}, indices: make(map[K]uint8)}
+}
+
+// Set gives a cell type a colour, and returns the palette so calls can be chained.
+// A GIF frame holds at most 256 colours, including the background.
+func (p *Palette[K]) Set(cell K, c color.Color) *Palette[K] {
+	if i, ok := p.indices[cell]; ok {
+		p.Colors[i] = c
+		return p
+	}
+
+	if len(p.Colors) == 256 {
+		panic("palette is full")
+	}
+
+	p.indices[cell] = uint8(len(p.Colors))
+	p.Colors = append(p.Colors, c)
+
+	return p
+}
+
+func (p *Palette[K]) Index(cell K) uint8 {
+	return p.indices[cell]
+}
+
+// Recorder collects frames of a simulation. Every method is safe to call on a nil
+// Recorder and does nothing, so a simulation can record unconditionally and only
+// create a Recorder when an animation was asked for.
+type Recorder[K comparable] struct {
+	Palette *Palette[K]
+
+	// Scale is the width and height in pixels of each cell.
+	Scale int
+	// Delay is how long each frame is shown, in hundredths of a second.
+	Delay int
+	// Every keeps only one of every Every calls to Snapshot, for simulations with
+	// too many steps to show each one.
+	Every int
+
+	Frames []*image.Paletted
+
+	snapshots int
+}
+
+func NewRecorder[K comparable](palette *Palette[K]) *Recorder[K] {
+	return &Recorder[K]{Palette: palette, Scale: 4, Delay: 5, Every: 1}
+}
+
+// Snapshot records a frame of a bounds sized grid, asking cell for the type of
+// each position.
+func (r *Recorder[K]) Snapshot(bounds utilities.Size2D, cell func(position utilities.Point2D) K) {
+	if r == nil {
+		return
+	}
+
+	r.snapshots++
+	if r.Every > 1 && (r.snapshots-1)%r.Every != 0 {
+		return
+	}
+
+	r.Capture(bounds, cell)
+}
+
+// Capture records a frame even when Snapshot would skip it, such as the final
+// state of a simulation.
+func (r *Recorder[K]) Capture(bounds utilities.Size2D, cell func(position utilities.Point2D) K) {
+	if r == nil {
+		return
+	}
+
+	scale := max(r.Scale, 1)
+
+	frame := image.NewPaletted(image.Rect(0, 0, bounds.Width*scale, bounds.Height*scale), r.Palette.Colors)
+
+	for y := 0; y < bounds.Height; y++ {
+		for x := 0; x < bounds.Width; x++ {
+			index := r.Palette.Index(cell(utilities.NewPoint2D(x, y)))
+			if index == 0 {
+				continue
+			}
+
+			for dy := 0; dy < scale; dy++ {
+				row := frame.Pix[(y*scale+dy)*frame.Stride:]
+				for dx := 0; dx < scale; dx++ {
+					row[x*scale+dx] = index
+				}
+			}
+		}
+	}
+
+	r.Frames = append(r.Frames, frame)
+}
+
+func (r *Recorder[K]) FrameCount() int {
+	if r == nil {
+		return 0
+	}
+
+	return len(r.Frames)
+}
+
+// WriteGIF writes the frames as a looping animation. The last frame is held for a
+// couple of seconds so the final state can be seen before the loop restarts.
+func (r *Recorder[K]) WriteGIF(w io.Writer) error {
+	if r.FrameCount() == 0 {
+		return fmt.Errorf("no frames recorded")
+	}
+
+	animation := &gif.GIF{}
+
+	for i, frame := range r.Frames {
+		delay := r.Delay
+		if i == len(r.Frames)-1 {
+			delay = max(delay, 200)
+		}
+
+		animation.Image = append(animation.Image, frame)
+		animation.Delay = append(animation.Delay, delay)
+	}
+
+	return gif.EncodeAll(w, animation)
+}
+
+// WritePNGs writes each frame to its own file, named by formatting pattern with
+// the frame number.
+func (r *Recorder[K]) WritePNGs(pattern string) error {
+	if r.FrameCount() == 0 {
+		return fmt.Errorf("no frames recorded")
+	}
+
+	for i, frame := range r.Frames {
+		f, err := os.Create(fmt.Sprintf(pattern, i))
+		if err != nil {
+			return err
+		}
+
+		err = png.Encode(f, frame)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Save writes an animated GIF when path ends in .gif. When it ends in .png, the
+// frames are numbered PNG files instead, so out.png becomes out-0000.png,
+// out-0001.png and so on.
+func (r *Recorder[K]) Save(path string) error {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".gif":
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+
+		err = r.WriteGIF(f)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+
+		return err
+	case ".png":
+		return r.WritePNGs(strings.TrimSuffix(path, filepath.Ext(path)) + "-%04d.png")
+	}
+
+	return fmt.Errorf("can't animate to '%s', expected a .gif or .png file", path)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"image/gif"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	black = color.RGBA{0, 0, 0, 255}
+	red   = color.RGBA{255, 0, 0, 255}
+	green = color.RGBA{0, 255, 0, 255}
+)
+
+func diagonal(position utilities.Point2D) rune {
+	if position.X == position.Y {
+		return '#'
+	}
+
+	return '.'
+}
+
+func TestPalette(t *testing.T) {
+	palette := NewPalette[rune](black).Set('#', red).Set('O', green)
+
+	assert.Equal(t, color.Palette{black, red, green}, palette.Colors)
+	assert.Equal(t, uint8(0), palette.Index('.'))
+	assert.Equal(t, uint8(1), palette.Index('#'))
+	assert.Equal(t, uint8(2), palette.Index('O'))
+
+	palette.Set('#', green)
+	assert.Equal(t, color.Palette{black, green, green}, palette.Colors)
+
+	assert.Panics(t, func() {
+		full := NewPalette[int](black)
+		for i := 0; i < 256; i++ {
+			full.Set(i, red)
+		}
+	})
+}
+
+func TestSnapshot(t *testing.T) {
+	recorder := NewRecorder(NewPalette[rune](black).Set('#', red))
+	recorder.Scale = 2
+	recorder.Every = 3
+
+	bounds := utilities.NewSize2D(3, 2)
+
+	for i := 0; i < 7; i++ {
+		recorder.Snapshot(bounds, diagonal)
+	}
+
+	assert.Equal(t, 3, recorder.FrameCount())
+
+	recorder.Capture(bounds, diagonal)
+	assert.Equal(t, 4, recorder.FrameCount())
+
+	frame := recorder.Frames[0]
+	assert.Equal(t, 6, frame.Bounds().Dx())
+	assert.Equal(t, 4, frame.Bounds().Dy())
+	assert.Equal(t, uint8(1), frame.ColorIndexAt(1, 1))
+	assert.Equal(t, uint8(1), frame.ColorIndexAt(2, 3))
+	assert.Equal(t, uint8(0), frame.ColorIndexAt(4, 0))
+
+	var nilRecorder *Recorder[rune]
+	nilRecorder.Snapshot(bounds, diagonal)
+	nilRecorder.Capture(bounds, diagonal)
+	assert.Equal(t, 0, nilRecorder.FrameCount())
+}
+
+func TestWriteGIF(t *testing.T) {
+	recorder := NewRecorder(NewPalette[rune](black).Set('#', red))
+
+	var buffer bytes.Buffer
+	assert.Error(t, recorder.WriteGIF(&buffer))
+
+	recorder.Snapshot(utilities.NewSize2D(4, 4), diagonal)
+	recorder.Snapshot(utilities.NewSize2D(4, 4), func(utilities.Point2D) rune { return '#' })
+
+	assert.NoError(t, recorder.WriteGIF(&buffer))
+
+	animation, err := gif.DecodeAll(&buffer)
+	assert.NoError(t, err)
+	assert.Len(t, animation.Image, 2)
+	assert.Equal(t, []int{5, 200}, animation.Delay)
+
+	r, g, b, _ := animation.Image[0].At(0, 0).RGBA()
+	assert.Equal(t, []uint32{0xffff, 0, 0}, []uint32{r, g, b})
+
+	r, g, b, _ = animation.Image[0].At(4, 0).RGBA()
+	assert.Equal(t, []uint32{0, 0, 0}, []uint32{r, g, b})
+}
+
+func TestSave(t *testing.T) {
+	recorder := NewRecorder(NewPalette[rune](black).Set('#', red))
+	recorder.Snapshot(utilities.NewSize2D(2, 2), diagonal)
+	recorder.Snapshot(utilities.NewSize2D(2, 2), diagonal)
+
+	dir := t.TempDir()
+
+	assert.NoError(t, recorder.Save(filepath.Join(dir, "out.gif")))
+	_, err := os.Stat(filepath.Join(dir, "out.gif"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, recorder.Save(filepath.Join(dir, "out.png")))
+	for _, name := range []string{"out-0000.png", "out-0001.png"} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		assert.NoError(t, err)
+	}
+
+	assert.Error(t, recorder.Save(filepath.Join(dir, "out.txt")))
+}
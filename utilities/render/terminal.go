@@ -0,0 +1,103 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package render
+
+import (
+	"strings"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/fatih/color"
+)
+
+// distinctColors are foreground colours that are easy to tell apart, for things
+// like regions or file IDs where each one needs its own colour.
+var distinctColors = []color.Attribute{
+	color.FgRed,
+	color.FgGreen,
+	color.FgYellow,
+	color.FgBlue,
+	color.FgMagenta,
+	color.FgCyan,
+	color.FgHiRed,
+	color.FgHiGreen,
+	color.FgHiYellow,
+	color.FgHiBlue,
+	color.FgHiMagenta,
+	color.FgHiCyan,
+}
+
+// Distinct picks the i'th of a set of easily told apart colours, wrapping around
+// once they are used up.
+func Distinct(i int) color.Attribute {
+	return distinctColors[utilities.Abs(i)%len(distinctColors)]
+}
+
+// Terminal draws grids as text, colouring each cell by its kind. It is only
+// Enabled when stdout is a terminal and NO_COLOR isn't set, so piped output stays
+// plain text. Loggers take the colours back out of grids they're given unless
+// they're writing to a terminal too.
+type Terminal[K comparable] struct {
+	Enabled bool
+	styles  map[K]*color.Color
+}
+
+func NewTerminal[K comparable]() *Terminal[K] {
+	return &Terminal[K]{Enabled: !color.NoColor, styles: make(map[K]*color.Color)}
+}
+
+// Set gives a kind of cell a style, and returns the terminal so calls can be
+// chained. Cells without a style are drawn plainly.
+func (t *Terminal[K]) Set(kind K, attributes ...color.Attribute) *Terminal[K] {
+	t.styles[kind] = color.New(attributes...)
+
+	return t
+}
+
+// Draw lays out a bounds sized grid, asking glyph for the text of each position
+// and kind for how to colour it. Runs of the same kind share one escape sequence,
+// which keeps the output of large grids manageable.
+func (t *Terminal[K]) Draw(bounds utilities.Size2D, glyph func(position utilities.Point2D) string, kind func(position utilities.Point2D) K) string {
+	var sb strings.Builder
+	var run strings.Builder
+
+	flush := func(style *color.Color) {
+		if run.Len() == 0 {
+			return
+		}
+
+		if style != nil && t.Enabled {
+			style.EnableColor()
+			sb.WriteString(style.Sprint(run.String()))
+		} else {
+			sb.WriteString(run.String())
+		}
+
+		run.Reset()
+	}
+
+	for y := 0; y < bounds.Height; y++ {
+		if y > 0 {
+			sb.WriteString("\n")
+		}
+
+		var current *color.Color
+
+		for x := 0; x < bounds.Width; x++ {
+			p := utilities.NewPoint2D(x, y)
+			style := t.styles[kind(p)]
+
+			if style != current {
+				flush(current)
+				current = style
+			}
+
+			run.WriteString(glyph(p))
+		}
+
+		flush(current)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2021-2024 Cameron Esfahani
+*/
+
+package render
+
+import (
+	"testing"
+
+	"github.com/d1r7y/adventofcode/utilities"
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func glyph(position utilities.Point2D) string {
+	return string(diagonal(position))
+}
+
+func TestDistinct(t *testing.T) {
+	assert.Equal(t, color.FgRed, Distinct(0))
+	assert.Equal(t, color.FgGreen, Distinct(1))
+	assert.Equal(t, Distinct(0), Distinct(len(distinctColors)))
+	assert.NotEqual(t, Distinct(2), Distinct(3))
+}
+
+func TestTerminalDraw(t *testing.T) {
+	terminal := NewTerminal[rune]().Set('#', color.FgRed)
+
+	terminal.Enabled = false
+	assert.Equal(t, "#..\n.#.", terminal.Draw(utilities.NewSize2D(3, 2), glyph, diagonal))
+
+	terminal.Enabled = true
+	assert.Equal(t, "\x1b[31m#\x1b[0m..\n.\x1b[31m#\x1b[0m.", terminal.Draw(utilities.NewSize2D(3, 2), glyph, diagonal))
+
+	// Neighbouring cells of the same kind share an escape sequence.
+	all := func(utilities.Point2D) rune { return '#' }
+	assert.Equal(t, "\x1b[31m#..\x1b[0m", terminal.Draw(utilities.NewSize2D(3, 1), glyph, all))
+}